@@ -3,9 +3,6 @@ package v3
 import (
 	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,7 +11,6 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"os"
-	"sort"
 	"strings"
 	"time"
 
@@ -37,11 +33,88 @@ func ParseUUID(s string) (UUID, error) {
 	return UUID(id.String()), nil
 }
 
+// WaitOpt represents a function setting a Wait option.
+type WaitOpt func(*waitConfig)
+
+type waitConfig struct {
+	states    []OperationState
+	backoff   func(runTime time.Duration) time.Duration
+	timeout   time.Duration
+	maxErrors int
+	onPoll    func(op *Operation, elapsed time.Duration)
+}
+
+// WaitOptWithStates returns a WaitOpt returning an error if the final state does not match one of the given states.
+// The given states replace the default expected states, no state accepting any final state.
+func WaitOptWithStates(states ...OperationState) WaitOpt {
+	return func(w *waitConfig) {
+		w.states = states
+	}
+}
+
+// WaitOptWithBackoff returns a WaitOpt overriding the default polling interval,
+// computed from the current runtime of the operation. Intervals are at least minPollInterval.
+func WaitOptWithBackoff(f func(runTime time.Duration) time.Duration) WaitOpt {
+	return func(w *waitConfig) {
+		w.backoff = f
+	}
+}
+
+// WaitOptWithTimeout returns a WaitOpt overriding the Client wait timeout for a single call.
+func WaitOptWithTimeout(t time.Duration) WaitOpt {
+	return func(w *waitConfig) {
+		w.timeout = t
+	}
+}
+
+// WaitOptWithMaxErrors returns a WaitOpt overriding the number of subsequent polling errors (5 by default)
+// after which the wait is aborted.
+func WaitOptWithMaxErrors(n int) WaitOpt {
+	return func(w *waitConfig) {
+		w.maxErrors = n
+	}
+}
+
+// WaitOptWithOnPoll returns a WaitOpt calling f with the polled operation state and
+// the elapsed time, after every successful poll.
+func WaitOptWithOnPoll(f func(op *Operation, elapsed time.Duration)) WaitOpt {
+	return func(w *waitConfig) {
+		w.onPoll = f
+	}
+}
+
+// minPollInterval is the minimum interval between two polls of an operation.
+const minPollInterval = time.Millisecond
+
+// interval returns the polling interval at the given operation runtime.
+func (w waitConfig) interval(runTime time.Duration) time.Duration {
+	return max(w.backoff(runTime), minPollInterval)
+}
+
+func (c Client) newWaitConfig(opts ...WaitOpt) waitConfig {
+	cfg := waitConfig{
+		backoff:   pollInterval,
+		timeout:   c.waitTimeout,
+		maxErrors: 5,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
 // Wait is a helper that waits for async operation to reach the final state.
 // Final states are one of: failure, success, timeout.
 // If states argument are given, returns an error if the final state not match on of those.
 func (c Client) Wait(ctx context.Context, op *Operation, states ...OperationState) (*Operation, error) {
-	const abortErrorsCount = 5
+	return c.WaitWithOpts(ctx, op, WaitOptWithStates(states...))
+}
+
+// WaitWithOpts is a helper that waits for async operation to reach the final state,
+// like Wait with additional options.
+func (c Client) WaitWithOpts(ctx context.Context, op *Operation, opts ...WaitOpt) (*Operation, error) {
+	cfg := c.newWaitConfig(opts...)
 
 	if op == nil {
 		return nil, fmt.Errorf("operation is nil")
@@ -49,7 +122,7 @@ func (c Client) Wait(ctx context.Context, op *Operation, states ...OperationStat
 
 	startTime := time.Now()
 
-	ticker := time.NewTicker(pollInterval(0))
+	ticker := time.NewTicker(cfg.interval(0))
 	defer ticker.Stop()
 
 	if op.State != OperationStatePending {
@@ -64,23 +137,27 @@ polling:
 		case <-ticker.C:
 			runTime := time.Since(startTime)
 
-			if c.waitTimeout != 0 && runTime > c.waitTimeout {
-				return nil, fmt.Errorf("operation: %q: max wait timeout reached", op.ID)
+			if cfg.timeout != 0 && runTime > cfg.timeout {
+				return nil, fmt.Errorf("operation: %q: %w", op.ID, ErrWaitTimeout)
 			}
 
-			newInterval := pollInterval(runTime)
+			newInterval := cfg.interval(runTime)
 			ticker.Reset(newInterval)
 
 			o, err := c.GetOperation(ctx, op.ID)
 			if err != nil {
 				subsequentErrors++
-				if subsequentErrors >= abortErrorsCount {
+				if subsequentErrors >= cfg.maxErrors {
 					return nil, err
 				}
 				continue
 			}
 			subsequentErrors = 0
 
+			if cfg.onPoll != nil {
+				cfg.onPoll(o, time.Since(startTime))
+			}
+
 			if o.State == OperationStatePending {
 				continue
 			}
@@ -92,6 +169,11 @@ polling:
 		}
 	}
 
+	return checkOperationState(operation, cfg.states...)
+}
+
+// checkOperationState returns an error if the state of a final operation is not one of the given states.
+func checkOperationState(operation *Operation, states ...OperationState) (*Operation, error) {
 	if len(states) == 0 {
 		return operation, nil
 	}
@@ -102,19 +184,36 @@ polling:
 		}
 	}
 
-	var ref OperationReference
-	if operation.Reference != nil {
-		ref = *operation.Reference
+	return nil, &OperationError{Operation: operation}
+}
+
+// waitForReference waits for an async operation to succeed and returns the resource it references,
+// expected to be fetched by the given getter operation.
+func (c Client) waitForReference(
+	ctx context.Context,
+	op *Operation,
+	getterOperationID string,
+	opts ...WaitOpt,
+) (*OperationReference, error) {
+	op, err := c.WaitWithOpts(ctx, op, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Operations already final are returned as is by WaitWithOpts.
+	if op.State != OperationStateSuccess {
+		return nil, &OperationError{Operation: op}
 	}
 
-	return nil,
-		fmt.Errorf("operation: %q %v, state: %s, reason: %q, message: %q",
-			operation.ID,
-			ref,
-			operation.State,
-			operation.Reason,
-			operation.Message,
-		)
+	if op.Reference == nil || op.Reference.ID == "" {
+		return nil, fmt.Errorf("operation: %q: no resource reference", op.ID)
+	}
+
+	if op.Reference.Command != "" && op.Reference.Command != getterOperationID {
+		return nil, fmt.Errorf("operation: %q: unexpected resource reference %q", op.ID, op.Reference.Command)
+	}
+
+	return op.Reference, nil
 }
 
 func String(s string) *string {
@@ -206,83 +305,159 @@ func prepareJSONResponse(resp *http.Response, v any) error {
 	return nil
 }
 
-func (c Client) signRequest(req *http.Request) error {
-	var (
-		sigParts    []string
-		headerParts []string
-	)
+func prepareTextBody(body string) *strings.Reader {
+	return strings.NewReader(body)
+}
 
-	var expiration = time.Now().UTC().Add(time.Minute * 10)
+func prepareTextResponse(resp *http.Response) (string, error) {
+	defer resp.Body.Close()
 
-	// Request method/URL path
-	sigParts = append(sigParts, fmt.Sprintf("%s %s", req.Method, req.URL.EscapedPath()))
-	headerParts = append(headerParts, "EXO2-HMAC-SHA256 credential="+c.apiKey)
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(buf), nil
+}
+
+// operationIDKey is the context key of the operation ID of API requests.
+type operationIDKey struct{}
+
+// OperationIDFromContext returns the OpenAPI operation ID (e.g. "create-instance") of an API request,
+// from the context of the request as seen by the HTTP client transport.
+func OperationIDFromContext(ctx context.Context) (string, bool) {
+	operationID, ok := ctx.Value(operationIDKey{}).(string)
+	return operationID, ok
+}
+
+// do sends an API request and records its telemetry.
+// The request context carries the operation ID and span to the HTTP client transport, for every attempt.
+func (c Client) do(ctx context.Context, operationID string, req *http.Request) (*http.Response, error) {
+	ctx, span := c.startSpan(context.WithValue(ctx, operationIDKey{}, operationID), operationID, req)
+	req = req.WithContext(ctx)
+	start := time.Now()
+
+	response, attempts, err := c.send(operationID, req)
 
-	// Request body if present
-	body := ""
-	if req.Body != nil {
-		data, err := io.ReadAll(req.Body)
+	c.endSpan(ctx, span, operationID, req, start, response, attempts, err)
+
+	return response, err
+}
+
+// send signs and sends an API request, retrying it according to the client RetryPolicy if any.
+// Credentials are resolved for every attempt, and retrieved again once if the API rejects them.
+// It returns the number of attempts made along with the final response.
+func (c Client) send(operationID string, req *http.Request) (*http.Response, int, error) {
+	ctx := req.Context()
+
+	// Streamed bodies are buffered once for all the attempts, to be signed and retried.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		spool, err := spoolRequestBody(req, io.Discard)
 		if err != nil {
-			return err
+			return nil, 0, fmt.Errorf("buffer request body: %w", err)
+		}
+		defer spool.discard()
+	}
+
+	var reauthenticated bool
+	for attempt := 1; ; attempt++ {
+		r := req
+		if attempt > 1 {
+			r = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, attempt, fmt.Errorf("rewind request body: %w", err)
+				}
+				r.Body = body
+			}
+		}
+
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx, Endpoint(c.serverEndpoint), r.Method); err != nil {
+				return nil, attempt, fmt.Errorf("rate limiter: %w", err)
+			}
 		}
-		err = req.Body.Close()
+
+		creds, err := c.credentials.GetWithContext(ctx)
 		if err != nil {
-			return err
+			return nil, attempt, fmt.Errorf("get credentials: %w", err)
 		}
-		body = string(data)
-		req.Body = io.NopCloser(bytes.NewReader(data))
-	}
-	sigParts = append(sigParts, body)
 
-	// Request query string parameters
-	// Important: this is order-sensitive, we have to have to sort parameters alphabetically to ensure signed
-	// values match the names listed in the "signed-query-args=" signature pragma.
-	signedParams, paramsValues := extractRequestParameters(req)
-	sigParts = append(sigParts, paramsValues)
-	if len(signedParams) > 0 {
-		headerParts = append(headerParts, "signed-query-args="+strings.Join(signedParams, ";"))
-	}
+		if err := c.signer.Sign(r, creds); err != nil {
+			return nil, attempt, fmt.Errorf("sign request: %w", err)
+		}
 
-	// Request headers -- none at the moment
-	// Note: the same order-sensitive caution for query string parameters applies to headers.
-	sigParts = append(sigParts, "")
+		if c.trace {
+			dumpRequest(r, operationID)
+		}
+		c.logger.logRequest(ctx, operationID, attempt, r)
 
-	// Request expiration date (UNIX timestamp, no line return)
-	sigParts = append(sigParts, fmt.Sprint(expiration.Unix()))
-	headerParts = append(headerParts, "expires="+fmt.Sprint(expiration.Unix()))
+		start := time.Now()
+		response, err := c.requestHTTPClient().Do(r)
+		if err == nil && c.trace {
+			dumpResponse(response)
+		}
+		c.logger.logResponse(ctx, operationID, attempt, response, time.Since(start), err)
+
+		// Rejected credentials are retrieved again once, in case they have been rotated.
+		if err == nil && response.StatusCode == http.StatusUnauthorized && !reauthenticated {
+			reauthenticated = true
+			c.credentials.Expire()
+			_, _ = io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+			continue
+		}
 
-	h := hmac.New(sha256.New, []byte(c.apiSecret))
-	if _, err := h.Write([]byte(strings.Join(sigParts, "\n"))); err != nil {
-		return err
-	}
-	headerParts = append(headerParts, "signature="+base64.StdEncoding.EncodeToString(h.Sum(nil)))
+		if c.retryPolicy == nil {
+			return response, attempt, err
+		}
 
-	req.Header.Set("Authorization", strings.Join(headerParts, ","))
+		// The attempt whose credentials were rejected does not count toward the RetryPolicy attempts.
+		policyAttempt := attempt
+		if reauthenticated {
+			policyAttempt--
+		}
 
-	return nil
-}
+		wait, retry := c.retryPolicy.Retry(ctx, RetryAttempt{
+			OperationID: operationID,
+			Method:      req.Method,
+			Attempt:     policyAttempt,
+			Response:    response,
+			Err:         err,
+		})
+		if !retry {
+			if err != nil && attempt > 1 {
+				return nil, attempt, fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+			}
+			return response, attempt, err
+		}
 
-// extractRequestParameters returns the list of request URL parameters names
-// and a strings concatenating the values of the parameters.
-func extractRequestParameters(req *http.Request) ([]string, string) {
-	var (
-		names  []string
-		values string
-	)
-
-	for param, values := range req.URL.Query() {
-		// Keep only parameters that hold exactly 1 value (i.e. no empty or multi-valued parameters)
-		if len(values) == 1 {
-			names = append(names, param)
+		if response != nil {
+			_, _ = io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, attempt, ctx.Err()
 		}
 	}
-	sort.Strings(names)
+}
 
-	for _, param := range names {
-		values += req.URL.Query().Get(param)
+// requestHTTPClient returns the HTTP client sending the requests, limited to the Client timeout if any.
+func (c Client) requestHTTPClient() *http.Client {
+	if c.timeout <= 0 {
+		return c.httpClient
 	}
 
-	return names, values
+	hc := *c.httpClient
+	hc.Timeout = c.timeout
+
+	return &hc
 }
 
 func dumpRequest(req *http.Request, operationID string) {
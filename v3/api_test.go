@@ -1,8 +1,14 @@
 package v3
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
 )
 
 func TestPollInterval(t *testing.T) {
@@ -60,3 +66,130 @@ func TestPollInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitWithOpts(t *testing.T) {
+	var polls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		state := OperationStatePending
+		if polls == 3 {
+			state = OperationStateFailure
+		}
+		_, _ = w.Write([]byte(`{"id":"9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e","state":"` + state + `"}`))
+	})
+
+	op := &Operation{ID: "9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e", State: OperationStatePending}
+	backoff := func(time.Duration) time.Duration { return time.Millisecond }
+
+	var states []OperationState
+	_, err := client.WaitWithOpts(context.Background(), op,
+		WaitOptWithBackoff(backoff),
+		WaitOptWithStates(OperationStateSuccess),
+		WaitOptWithOnPoll(func(o *Operation, elapsed time.Duration) {
+			states = append(states, o.State)
+		}),
+	)
+	if err == nil || !strings.Contains(err.Error(), "state: failure") {
+		t.Errorf("expected a failure state error, got %v", err)
+	}
+	if len(states) != 3 || states[2] != OperationStateFailure {
+		t.Errorf("unexpected polled states %v", states)
+	}
+}
+
+func TestWaitFinalOperation(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("final operations must not be polled, got %s %s", r.Method, r.URL.Path)
+	})
+
+	op := &Operation{ID: "9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e", State: OperationStateFailure}
+	got, err := client.Wait(context.Background(), op, OperationStateSuccess)
+	if err != nil || got != op {
+		t.Errorf("final operations must be returned as is, got %+v: %v", got, err)
+	}
+}
+
+func TestWaitWithOptsZeroBackoff(t *testing.T) {
+	var polls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		state := OperationStatePending
+		if polls >= 2 {
+			state = OperationStateSuccess
+		}
+		_, _ = w.Write([]byte(`{"id":"9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e","state":"` + string(state) + `"}`))
+	})
+
+	op := &Operation{ID: "9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e", State: OperationStatePending}
+	if _, err := client.WaitWithOpts(context.Background(), op,
+		WaitOptWithBackoff(func(time.Duration) time.Duration { return -time.Second }),
+	); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WaitAllWithOpts(context.Background(), []*Operation{op},
+		WaitOptWithBackoff(func(time.Duration) time.Duration { return 0 }),
+	); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitWithOptsTimeout(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e","state":"pending"}`))
+	}, ClientOptWithWaitTimeout(time.Hour))
+
+	op := &Operation{ID: "9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e", State: OperationStatePending}
+	_, err := client.WaitWithOpts(context.Background(), op,
+		WaitOptWithBackoff(func(time.Duration) time.Duration { return time.Millisecond }),
+		WaitOptWithTimeout(20*time.Millisecond),
+	)
+	if err == nil || !strings.Contains(err.Error(), "max wait timeout reached") {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+}
+
+type rotatingProvider struct {
+	keys []string
+}
+
+func (p *rotatingProvider) Retrieve() (credentials.Value, error) {
+	key := p.keys[0]
+	if len(p.keys) > 1 {
+		p.keys = p.keys[1:]
+	}
+	return credentials.Value{APIKey: key, APISecret: "secret"}, nil
+}
+
+func (p *rotatingProvider) IsExpired() bool { return false }
+
+func TestCredentialsRotation(t *testing.T) {
+	var keys []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(strings.Split(r.Header.Get("Authorization"), ",")[0], "EXO2-HMAC-SHA256 credential=")
+		keys = append(keys, key)
+		if key != "EXOrotated" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	})
+
+	provider := &rotatingProvider{keys: []string{"EXOrevoked", "EXOrotated"}}
+	if err := ClientOptWithCredentials(credentials.NewCredentials(provider))(client); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.ListZones(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[1] != "EXOrotated" {
+		t.Errorf("expected a retry with rotated credentials, got %v", keys)
+	}
+
+	provider.keys = []string{"EXOrevoked"}
+	client.credentials.Expire()
+	keys = nil
+	if _, err := client.ListZones(context.Background()); !errors.Is(err, ErrUnauthorized) || len(keys) != 2 {
+		t.Errorf("expected ErrUnauthorized after a single retry, got %v after %d requests", err, len(keys))
+	}
+}
@@ -0,0 +1,24 @@
+package v3
+
+import (
+	"context"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+// NewAssumeRoleCredentials returns Credentials assuming the IAM role roleID with the credentials of client,
+// renewed ahead of the end of their TTL. It returns an error if the options are invalid, see credentials.NewAssumeRoleProvider.
+//
+//	creds, err := v3.NewAssumeRoleCredentials(client, roleID, credentials.AssumeRoleOptWithTTL(time.Hour))
+//	roleClient := client.WithCredentials(creds)
+func NewAssumeRoleCredentials(client *Client, roleID UUID, opts ...credentials.AssumeRoleOpt) (*credentials.Credentials, error) {
+	return credentials.NewAssumeRoleCredentials(func(ctx context.Context, ttl time.Duration) (credentials.Value, error) {
+		resp, err := client.AssumeIAMRole(ctx, roleID, AssumeIAMRoleRequest{Ttl: int64(ttl.Seconds())})
+		if err != nil {
+			return credentials.Value{}, err
+		}
+
+		return credentials.Value{APIKey: resp.Key, APISecret: resp.Secret}, nil
+	}, opts...)
+}
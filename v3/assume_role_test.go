@@ -0,0 +1,75 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func TestAssumeRoleCredentials(t *testing.T) {
+	const roleID = "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b"
+
+	var assumed int
+	var keys []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(strings.Split(r.Header.Get("Authorization"), ",")[0], "EXO2-HMAC-SHA256 credential=")
+
+		if r.URL.Path == "/iam-role/"+roleID+"/assume" {
+			if key != "EXOtest" {
+				t.Errorf("the role must be assumed with the base credentials, got %q", key)
+			}
+			var req AssumeIAMRoleRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Ttl != 60 {
+				t.Errorf("unexpected request %+v: %v", req, err)
+			}
+			assumed++
+			_, _ = fmt.Fprintf(w, `{"key":"EXOassumed%d","secret":"secret"}`, assumed)
+			return
+		}
+
+		keys = append(keys, key)
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	})
+
+	creds, err := NewAssumeRoleCredentials(client, roleID,
+		credentials.AssumeRoleOptWithTTL(time.Minute),
+		credentials.AssumeRoleOptWithExpiryWindow(time.Minute-time.Nanosecond),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roleClient := client.WithCredentials(creds)
+
+	ctx := context.Background()
+	for range 2 {
+		if _, err := roleClient.ListZones(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The expiry window covers almost the whole TTL, key pairs are renewed for every request.
+	if assumed != 2 || len(keys) != 2 || keys[0] != "EXOassumed1" || keys[1] != "EXOassumed2" {
+		t.Errorf("unexpected keys %v after %d role assumptions", keys, assumed)
+	}
+
+	assumed, keys = 0, nil
+	creds, err = NewAssumeRoleCredentials(client, roleID, credentials.AssumeRoleOptWithTTL(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	roleClient = client.WithCredentials(creds)
+	for range 2 {
+		if _, err := roleClient.ListZones(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if assumed != 1 || len(keys) != 2 || keys[1] != "EXOassumed1" {
+		t.Errorf("the assumed key pair must be cached, got keys %v after %d role assumptions", keys, assumed)
+	}
+}
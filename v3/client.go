@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"runtime"
 	"time"
@@ -15,6 +16,8 @@ import (
 	"github.com/exoscale/egoscale/v3/credentials"
 	"github.com/go-playground/validator/v10"
 	"github.com/hashicorp/go-retryablehttp"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Endpoint represents a zone endpoint.
@@ -31,6 +34,18 @@ const (
 	HrZag1 Endpoint = "https://api-hr-zag-1.exoscale.com/v2"
 )
 
+// defaultZoneEndpoints maps the zones known at generation time to their Endpoint.
+var defaultZoneEndpoints = map[ZoneName]Endpoint{
+	"ch-gva-2": CHGva2,
+	"ch-dk-2":  CHDk2,
+	"de-fra-1": DEFra1,
+	"de-muc-1": DEMuc1,
+	"at-vie-1": ATVie1,
+	"at-vie-2": ATVie2,
+	"bg-sof-1": BGSof1,
+	"hr-zag-1": HrZag1,
+}
+
 // defaultHTTPClient is HTTP client with retry logic.
 // Default retry configuration can be found in go-retryablehttp repo.
 var defaultHTTPClient = func() *http.Client {
@@ -54,30 +69,36 @@ func (c Client) GetZoneName(ctx context.Context, endpoint Endpoint) (ZoneName, e
 	return zone.Name, nil
 }
 
+// GetZoneAPIEndpoint returns the API Endpoint of a zone from the Client ZoneRegistry,
+// refreshed with ListZones if the zone is unknown or the registry is stale.
 func (c Client) GetZoneAPIEndpoint(ctx context.Context, zoneName ZoneName) (Endpoint, error) {
-	resp, err := c.ListZones(ctx)
-	if err != nil {
-		return "", fmt.Errorf("get zone api endpoint: list zones: %w", err)
-	}
-
-	zone, err := resp.FindZone(string(zoneName))
+	endpoint, err := c.zones.Endpoint(ctx, &c, zoneName)
 	if err != nil {
-		return "", fmt.Errorf("get zone api endpoint: find zone: %w", err)
+		return "", fmt.Errorf("get zone api endpoint: %w", err)
 	}
 
-	return zone.APIEndpoint, nil
+	return endpoint, nil
 }
 
 // Client represents an Exoscale API client.
 type Client struct {
-	apiKey         string
-	apiSecret      string
+	credentials    *credentials.Credentials
+	signer         *Signer
 	userAgent      string
 	serverEndpoint string
+	sosEndpoint    string
 	httpClient     *http.Client
+	timeout        time.Duration
 	waitTimeout    time.Duration
 	validate       *validator.Validate
+	validation     *validation
 	trace          bool
+	retryPolicy    RetryPolicy
+	rateLimiter    *RateLimiter
+	zones          *ZoneRegistry
+	tracer         trace.Tracer
+	metrics        *clientMetrics
+	logger         *requestLogger
 
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
@@ -94,6 +115,8 @@ var UserAgent = getDefaultUserAgent()
 type ClientOpt func(*Client) error
 
 // ClientOptWithTrace returns a ClientOpt enabling HTTP request/response tracing.
+// Note: traces are written in clear to stderr, including signature headers and secrets,
+// use ClientOptWithLogger for redacted logs instead.
 func ClientOptWithTrace() ClientOpt {
 	return func(c *Client) error {
 		c.trace = true
@@ -101,6 +124,15 @@ func ClientOptWithTrace() ClientOpt {
 	}
 }
 
+// ClientOptWithLogger returns a ClientOpt logging API requests and responses metadata at debug level.
+// Signature headers and secret fields are redacted from the logs.
+func ClientOptWithLogger(logger *slog.Logger, opts ...LoggerOpt) ClientOpt {
+	return func(c *Client) error {
+		c.logger = newRequestLogger(logger, opts...)
+		return nil
+	}
+}
+
 // ClientOptWithUserAgent returns a ClientOpt setting the user agent header.
 func ClientOptWithUserAgent(ua string) ClientOpt {
 	return func(c *Client) error {
@@ -117,6 +149,32 @@ func ClientOptWithValidator(validate *validator.Validate) ClientOpt {
 	}
 }
 
+// ClientOptWithRequestValidation returns a ClientOpt validating request bodies before sending them,
+// and optionally response bodies (see ValidationOptWithResponses), with the Client validator (see ClientOptWithValidator).
+// Invalid bodies are reported with a ValidationError, without requests being sent for invalid requests.
+func ClientOptWithRequestValidation(opts ...ValidationOpt) ClientOpt {
+	return func(c *Client) error {
+		c.validation = newValidation(opts...)
+		return nil
+	}
+}
+
+// ClientOptWithCredentials returns a ClientOpt signing requests with the given Credentials.
+func ClientOptWithCredentials(creds *credentials.Credentials) ClientOpt {
+	return func(c *Client) error {
+		c.credentials = creds
+		return nil
+	}
+}
+
+// ClientOptWithSigner returns a ClientOpt signing requests with the given Signer.
+func ClientOptWithSigner(s *Signer) ClientOpt {
+	return func(c *Client) error {
+		c.signer = s
+		return nil
+	}
+}
+
 // ClientOptWithEndpoint returns a ClientOpt With a given zone Endpoint.
 func ClientOptWithEndpoint(endpoint Endpoint) ClientOpt {
 	return func(c *Client) error {
@@ -125,6 +183,48 @@ func ClientOptWithEndpoint(endpoint Endpoint) ClientOpt {
 	}
 }
 
+// ClientOptWithSOSEndpoint returns a ClientOpt setting the endpoint of the SOS object storage,
+// returned by SOSEndpoint for use with an S3 client.
+func ClientOptWithSOSEndpoint(endpoint string) ClientOpt {
+	return func(c *Client) error {
+		c.sosEndpoint = endpoint
+		return nil
+	}
+}
+
+// ClientOptWithZone returns a ClientOpt setting the Endpoint of the given zone.
+// The zone is resolved from the Client ZoneRegistry without network requests,
+// use WithZone to resolve zones unknown to the registry.
+func ClientOptWithZone(zone ZoneName) ClientOpt {
+	return func(c *Client) error {
+		endpoint, ok := c.zones.lookup(zone)
+		if !ok {
+			return fmt.Errorf("zone %q: %w", zone, ErrNotFound)
+		}
+		c.serverEndpoint = string(endpoint)
+		return nil
+	}
+}
+
+// ClientOptWithZoneRegistry returns a ClientOpt resolving zones with the given ZoneRegistry.
+// The ZoneRegistry is shared with every Client derived from this one.
+// This option must be set before ClientOptWithZone.
+func ClientOptWithZoneRegistry(r *ZoneRegistry) ClientOpt {
+	return func(c *Client) error {
+		c.zones = r
+		return nil
+	}
+}
+
+// ClientOptWithTimeout returns a ClientOpt setting the time limit of every HTTP request attempt
+// made by the Client, including reading the response body (see http.Client Timeout).
+func ClientOptWithTimeout(t time.Duration) ClientOpt {
+	return func(c *Client) error {
+		c.timeout = t
+		return nil
+	}
+}
+
 // ClientOptWithWaitTimeout returns a ClientOpt With a given wait timeout.
 func ClientOptWithWaitTimeout(t time.Duration) ClientOpt {
 	return func(c *Client) error {
@@ -141,6 +241,49 @@ func ClientOptWithRequestInterceptors(f ...RequestInterceptorFn) ClientOpt {
 	}
 }
 
+// ClientOptWithRetryPolicy returns a ClientOpt retrying failed requests according to the given RetryPolicy.
+// It replaces the retry logic of the default http.Client, restored by a nil RetryPolicy.
+func ClientOptWithRetryPolicy(p RetryPolicy) ClientOpt {
+	return func(c *Client) error {
+		c.retryPolicy = p
+		if p != nil {
+			c.httpClient = noRetryHTTPClient(c.httpClient)
+		} else {
+			c.httpClient = retryHTTPClient(c.httpClient)
+		}
+		return nil
+	}
+}
+
+// ClientOptWithRateLimiter returns a ClientOpt limiting the rate of requests with the given RateLimiter.
+// The RateLimiter is shared with every Client derived from this one (e.g. using WithEndpoint).
+func ClientOptWithRateLimiter(l *RateLimiter) ClientOpt {
+	return func(c *Client) error {
+		c.rateLimiter = l
+		return nil
+	}
+}
+
+// ClientOptWithTracerProvider returns a ClientOpt creating an OpenTelemetry span for every API operation.
+func ClientOptWithTracerProvider(tp trace.TracerProvider) ClientOpt {
+	return func(c *Client) error {
+		c.tracer = tp.Tracer(instrumentationName, trace.WithInstrumentationVersion(Version))
+		return nil
+	}
+}
+
+// ClientOptWithMeterProvider returns a ClientOpt recording OpenTelemetry metrics for every API operation.
+func ClientOptWithMeterProvider(mp metric.MeterProvider) ClientOpt {
+	return func(c *Client) error {
+		m, err := newClientMetrics(mp)
+		if err != nil {
+			return err
+		}
+		c.metrics = m
+		return nil
+	}
+}
+
 // ClientOptWithHTTPClient returns a ClientOpt overriding the default http.Client.
 // Note: the Exoscale API client will chain additional middleware
 // (http.RoundTripper) on the HTTP client internally, which can alter the HTTP
@@ -165,19 +308,20 @@ func getDefaultUserAgent() string {
 }
 
 // NewClient returns a new Exoscale API client.
+// Credentials are retrieved again by the client when expired, or when the API rejects them.
 func NewClient(credentials *credentials.Credentials, opts ...ClientOpt) (*Client, error) {
-	values, err := credentials.Get()
-	if err != nil {
+	if _, err := credentials.Get(); err != nil {
 		return nil, err
 	}
 
 	client := &Client{
-		apiKey:         values.APIKey,
-		apiSecret:      values.APISecret,
+		credentials:    credentials,
+		signer:         NewSigner(),
 		serverEndpoint: string(CHGva2),
 		httpClient:     defaultHTTPClient,
 		validate:       validator.New(),
 		userAgent:      getDefaultUserAgent(),
+		zones:          NewZoneRegistry(),
 	}
 
 	for _, opt := range opts {
@@ -213,6 +357,60 @@ func (c *Client) WithEndpoint(endpoint Endpoint) *Client {
 	return clone
 }
 
+// WithCredentials returns a copy of Client with new Credentials.
+func (c *Client) WithCredentials(creds *credentials.Credentials) *Client {
+	clone := cloneClient(c)
+
+	clone.credentials = creds
+
+	return clone
+}
+
+// WithSigner returns a copy of Client with new Signer.
+func (c *Client) WithSigner(s *Signer) *Client {
+	clone := cloneClient(c)
+
+	clone.signer = s
+
+	return clone
+}
+
+// WithZone returns a copy of Client with the Endpoint of the given zone.
+// The zone is resolved from the Client ZoneRegistry, refreshed with ListZones
+// if the zone is unknown or the registry is stale.
+func (c *Client) WithZone(ctx context.Context, zone ZoneName) (*Client, error) {
+	endpoint, err := c.zones.Endpoint(ctx, c, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.WithEndpoint(endpoint), nil
+}
+
+// WithZoneRegistry returns a copy of Client with new ZoneRegistry.
+func (c *Client) WithZoneRegistry(r *ZoneRegistry) *Client {
+	clone := cloneClient(c)
+
+	clone.zones = r
+
+	return clone
+}
+
+// SOSEndpoint returns the endpoint of the SOS object storage set with ClientOptWithSOSEndpoint,
+// empty if not set.
+func (c *Client) SOSEndpoint() string {
+	return c.sosEndpoint
+}
+
+// WithTimeout returns a copy of Client with new HTTP request timeout.
+func (c *Client) WithTimeout(t time.Duration) *Client {
+	clone := cloneClient(c)
+
+	clone.timeout = t
+
+	return clone
+}
+
 // WithWaitTimeout returns a copy of Client with new wait timeout.
 func (c *Client) WithWaitTimeout(t time.Duration) *Client {
 	clone := cloneClient(c)
@@ -240,6 +438,15 @@ func (c *Client) WithTrace() *Client {
 	return clone
 }
 
+// WithLogger returns a copy of Client logging API requests and responses with the given logger.
+func (c *Client) WithLogger(logger *slog.Logger, opts ...LoggerOpt) *Client {
+	clone := cloneClient(c)
+
+	clone.logger = newRequestLogger(logger, opts...)
+
+	return clone
+}
+
 // WithHttpClient returns a copy of Client with new http.Client.
 // Deprecated: use WithHTTPClient instead.
 func (c *Client) WithHttpClient(client *http.Client) *Client {
@@ -259,6 +466,30 @@ func (c *Client) WithHTTPClient(client *http.Client) *Client {
 	return clone
 }
 
+// WithRetryPolicy returns a copy of Client with new RetryPolicy.
+// A nil RetryPolicy restores the retry logic of the default http.Client.
+func (c *Client) WithRetryPolicy(p RetryPolicy) *Client {
+	clone := cloneClient(c)
+
+	clone.retryPolicy = p
+	if p != nil {
+		clone.httpClient = noRetryHTTPClient(clone.httpClient)
+	} else {
+		clone.httpClient = retryHTTPClient(clone.httpClient)
+	}
+
+	return clone
+}
+
+// WithRateLimiter returns a copy of Client with new RateLimiter.
+func (c *Client) WithRateLimiter(l *RateLimiter) *Client {
+	clone := cloneClient(c)
+
+	clone.rateLimiter = l
+
+	return clone
+}
+
 // WithRequestInterceptor returns a copy of Client with new RequestInterceptors.
 func (c *Client) WithRequestInterceptor(f ...RequestInterceptorFn) *Client {
 	clone := cloneClient(c)
@@ -280,14 +511,23 @@ func (c *Client) executeRequestInterceptors(ctx context.Context, req *http.Reque
 
 func cloneClient(c *Client) *Client {
 	return &Client{
-		apiKey:              c.apiKey,
-		apiSecret:           c.apiSecret,
+		credentials:         c.credentials,
+		signer:              c.signer,
 		userAgent:           c.userAgent,
 		serverEndpoint:      c.serverEndpoint,
+		sosEndpoint:         c.sosEndpoint,
 		httpClient:          c.httpClient,
+		timeout:             c.timeout,
 		requestInterceptors: c.requestInterceptors,
 		waitTimeout:         c.waitTimeout,
 		trace:               c.trace,
+		retryPolicy:         c.retryPolicy,
+		rateLimiter:         c.rateLimiter,
+		zones:               c.zones,
+		tracer:              c.tracer,
+		metrics:             c.metrics,
+		logger:              c.logger,
 		validate:            c.validate,
+		validation:          c.validation,
 	}
 }
@@ -0,0 +1,503 @@
+// Package v3 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/egoscale/v3/generator version v0.0.1 DO NOT EDIT.
+package v3
+
+import (
+	"context"
+	"iter"
+)
+
+// ComputeAPI is the interface of the compute instances, storage, networking and related resources operations of Client.
+type ComputeAPI interface {
+	ListAntiAffinityGroups(ctx context.Context) (*ListAntiAffinityGroupsResponse, error)
+	AllAntiAffinityGroups(ctx context.Context) iter.Seq2[AntiAffinityGroup, error]
+	CreateAntiAffinityGroup(ctx context.Context, req CreateAntiAffinityGroupRequest) (*Operation, error)
+	CreateAntiAffinityGroupAndWait(ctx context.Context, req CreateAntiAffinityGroupRequest, opts ...WaitOpt) (*AntiAffinityGroup, error)
+	DeleteAntiAffinityGroup(ctx context.Context, id UUID) (*Operation, error)
+	GetAntiAffinityGroup(ctx context.Context, id UUID) (*AntiAffinityGroup, error)
+	ListBlockStorageVolumes(ctx context.Context, opts ...ListBlockStorageVolumesOpt) (*ListBlockStorageVolumesResponse, error)
+	AllBlockStorageVolumes(ctx context.Context, opts ...ListBlockStorageVolumesOpt) iter.Seq2[BlockStorageVolume, error]
+	CreateBlockStorageVolume(ctx context.Context, req CreateBlockStorageVolumeRequest) (*Operation, error)
+	CreateBlockStorageVolumeAndWait(ctx context.Context, req CreateBlockStorageVolumeRequest, opts ...WaitOpt) (*BlockStorageVolume, error)
+	ListBlockStorageSnapshots(ctx context.Context) (*ListBlockStorageSnapshotsResponse, error)
+	AllBlockStorageSnapshots(ctx context.Context) iter.Seq2[BlockStorageSnapshot, error]
+	DeleteBlockStorageSnapshot(ctx context.Context, id UUID) (*Operation, error)
+	GetBlockStorageSnapshot(ctx context.Context, id UUID) (*BlockStorageSnapshot, error)
+	UpdateBlockStorageSnapshot(ctx context.Context, id UUID, req UpdateBlockStorageSnapshotRequest) (*Operation, error)
+	UpdateBlockStorageSnapshotAndWait(ctx context.Context, id UUID, req UpdateBlockStorageSnapshotRequest, opts ...WaitOpt) (*BlockStorageSnapshot, error)
+	DeleteBlockStorageVolume(ctx context.Context, id UUID) (*Operation, error)
+	GetBlockStorageVolume(ctx context.Context, id UUID) (*BlockStorageVolume, error)
+	UpdateBlockStorageVolume(ctx context.Context, id UUID, req UpdateBlockStorageVolumeRequest) (*Operation, error)
+	UpdateBlockStorageVolumeAndWait(ctx context.Context, id UUID, req UpdateBlockStorageVolumeRequest, opts ...WaitOpt) (*BlockStorageVolume, error)
+	AttachBlockStorageVolumeToInstance(ctx context.Context, id UUID, req AttachBlockStorageVolumeToInstanceRequest) (*Operation, error)
+	AttachBlockStorageVolumeToInstanceAndWait(ctx context.Context, id UUID, req AttachBlockStorageVolumeToInstanceRequest, opts ...WaitOpt) (*BlockStorageVolume, error)
+	CreateBlockStorageSnapshot(ctx context.Context, id UUID, req CreateBlockStorageSnapshotRequest) (*Operation, error)
+	DetachBlockStorageVolume(ctx context.Context, id UUID) (*Operation, error)
+	DetachBlockStorageVolumeAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*BlockStorageVolume, error)
+	ResizeBlockStorageVolume(ctx context.Context, id UUID, req ResizeBlockStorageVolumeRequest) (*BlockStorageVolume, error)
+	GetConsoleProxyURL(ctx context.Context, id UUID) (*GetConsoleProxyURLResponse, error)
+	ListDeployTargets(ctx context.Context) (*ListDeployTargetsResponse, error)
+	AllDeployTargets(ctx context.Context) iter.Seq2[DeployTarget, error]
+	GetDeployTarget(ctx context.Context, id UUID) (*DeployTarget, error)
+	ListElasticIPS(ctx context.Context) (*ListElasticIPSResponse, error)
+	AllElasticIPS(ctx context.Context) iter.Seq2[ElasticIP, error]
+	CreateElasticIP(ctx context.Context, req CreateElasticIPRequest) (*Operation, error)
+	CreateElasticIPAndWait(ctx context.Context, req CreateElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error)
+	DeleteElasticIP(ctx context.Context, id UUID) (*Operation, error)
+	GetElasticIP(ctx context.Context, id UUID) (*ElasticIP, error)
+	UpdateElasticIP(ctx context.Context, id UUID, req UpdateElasticIPRequest) (*Operation, error)
+	UpdateElasticIPAndWait(ctx context.Context, id UUID, req UpdateElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error)
+	ResetElasticIPField(ctx context.Context, id UUID, field ResetElasticIPFieldField) (*Operation, error)
+	AttachInstanceToElasticIP(ctx context.Context, id UUID, req AttachInstanceToElasticIPRequest) (*Operation, error)
+	AttachInstanceToElasticIPAndWait(ctx context.Context, id UUID, req AttachInstanceToElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error)
+	DetachInstanceFromElasticIP(ctx context.Context, id UUID, req DetachInstanceFromElasticIPRequest) (*Operation, error)
+	DetachInstanceFromElasticIPAndWait(ctx context.Context, id UUID, req DetachInstanceFromElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error)
+	ListInstances(ctx context.Context, opts ...ListInstancesOpt) (*ListInstancesResponse, error)
+	AllInstances(ctx context.Context, opts ...ListInstancesOpt) iter.Seq2[ListInstancesResponseInstances, error]
+	CreateInstance(ctx context.Context, req CreateInstanceRequest) (*Operation, error)
+	CreateInstanceAndWait(ctx context.Context, req CreateInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	ListInstancePools(ctx context.Context) (*ListInstancePoolsResponse, error)
+	AllInstancePools(ctx context.Context) iter.Seq2[InstancePool, error]
+	CreateInstancePool(ctx context.Context, req CreateInstancePoolRequest) (*Operation, error)
+	CreateInstancePoolAndWait(ctx context.Context, req CreateInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error)
+	DeleteInstancePool(ctx context.Context, id UUID) (*Operation, error)
+	GetInstancePool(ctx context.Context, id UUID) (*InstancePool, error)
+	UpdateInstancePool(ctx context.Context, id UUID, req UpdateInstancePoolRequest) (*Operation, error)
+	UpdateInstancePoolAndWait(ctx context.Context, id UUID, req UpdateInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error)
+	ResetInstancePoolField(ctx context.Context, id UUID, field ResetInstancePoolFieldField) (*Operation, error)
+	EvictInstancePoolMembers(ctx context.Context, id UUID, req EvictInstancePoolMembersRequest) (*Operation, error)
+	EvictInstancePoolMembersAndWait(ctx context.Context, id UUID, req EvictInstancePoolMembersRequest, opts ...WaitOpt) (*InstancePool, error)
+	ScaleInstancePool(ctx context.Context, id UUID, req ScaleInstancePoolRequest) (*Operation, error)
+	ScaleInstancePoolAndWait(ctx context.Context, id UUID, req ScaleInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error)
+	ListInstanceTypes(ctx context.Context) (*ListInstanceTypesResponse, error)
+	AllInstanceTypes(ctx context.Context) iter.Seq2[InstanceType, error]
+	GetInstanceType(ctx context.Context, id UUID) (*InstanceType, error)
+	DeleteInstance(ctx context.Context, id UUID) (*Operation, error)
+	GetInstance(ctx context.Context, id UUID) (*Instance, error)
+	UpdateInstance(ctx context.Context, id UUID, req UpdateInstanceRequest) (*Operation, error)
+	UpdateInstanceAndWait(ctx context.Context, id UUID, req UpdateInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	ResetInstanceField(ctx context.Context, id UUID, field ResetInstanceFieldField) (*Operation, error)
+	AddInstanceProtection(ctx context.Context, id UUID) (*Operation, error)
+	AddInstanceProtectionAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	CreateSnapshot(ctx context.Context, id UUID) (*Operation, error)
+	EnableTpm(ctx context.Context, id UUID) (*Operation, error)
+	EnableTpmAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	RevealInstancePassword(ctx context.Context, id UUID) (*InstancePassword, error)
+	RebootInstance(ctx context.Context, id UUID) (*Operation, error)
+	RebootInstanceAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	RemoveInstanceProtection(ctx context.Context, id UUID) (*Operation, error)
+	RemoveInstanceProtectionAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	ResetInstance(ctx context.Context, id UUID, req ResetInstanceRequest) (*Operation, error)
+	ResetInstanceAndWait(ctx context.Context, id UUID, req ResetInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	ResetInstancePassword(ctx context.Context, id UUID) (*Operation, error)
+	ResetInstancePasswordAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	ResizeInstanceDisk(ctx context.Context, id UUID, req ResizeInstanceDiskRequest) (*Operation, error)
+	ResizeInstanceDiskAndWait(ctx context.Context, id UUID, req ResizeInstanceDiskRequest, opts ...WaitOpt) (*Instance, error)
+	ScaleInstance(ctx context.Context, id UUID, req ScaleInstanceRequest) (*Operation, error)
+	ScaleInstanceAndWait(ctx context.Context, id UUID, req ScaleInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	StartInstance(ctx context.Context, id UUID, req StartInstanceRequest) (*Operation, error)
+	StartInstanceAndWait(ctx context.Context, id UUID, req StartInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	StopInstance(ctx context.Context, id UUID) (*Operation, error)
+	StopInstanceAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	RevertInstanceToSnapshot(ctx context.Context, instanceID UUID, req RevertInstanceToSnapshotRequest) (*Operation, error)
+	RevertInstanceToSnapshotAndWait(ctx context.Context, instanceID UUID, req RevertInstanceToSnapshotRequest, opts ...WaitOpt) (*Instance, error)
+	ListLoadBalancers(ctx context.Context) (*ListLoadBalancersResponse, error)
+	AllLoadBalancers(ctx context.Context) iter.Seq2[LoadBalancer, error]
+	CreateLoadBalancer(ctx context.Context, req CreateLoadBalancerRequest) (*Operation, error)
+	CreateLoadBalancerAndWait(ctx context.Context, req CreateLoadBalancerRequest, opts ...WaitOpt) (*LoadBalancer, error)
+	DeleteLoadBalancer(ctx context.Context, id UUID) (*Operation, error)
+	GetLoadBalancer(ctx context.Context, id UUID) (*LoadBalancer, error)
+	UpdateLoadBalancer(ctx context.Context, id UUID, req UpdateLoadBalancerRequest) (*Operation, error)
+	UpdateLoadBalancerAndWait(ctx context.Context, id UUID, req UpdateLoadBalancerRequest, opts ...WaitOpt) (*LoadBalancer, error)
+	AddServiceToLoadBalancer(ctx context.Context, id UUID, req AddServiceToLoadBalancerRequest) (*Operation, error)
+	DeleteLoadBalancerService(ctx context.Context, id UUID, serviceID UUID) (*Operation, error)
+	GetLoadBalancerService(ctx context.Context, id UUID, serviceID UUID) (*LoadBalancerService, error)
+	UpdateLoadBalancerService(ctx context.Context, id UUID, serviceID UUID, req UpdateLoadBalancerServiceRequest) (*Operation, error)
+	ResetLoadBalancerServiceField(ctx context.Context, id UUID, serviceID UUID, field ResetLoadBalancerServiceFieldField) (*Operation, error)
+	ResetLoadBalancerField(ctx context.Context, id UUID, field ResetLoadBalancerFieldField) (*Operation, error)
+	ListPrivateNetworks(ctx context.Context) (*ListPrivateNetworksResponse, error)
+	AllPrivateNetworks(ctx context.Context) iter.Seq2[PrivateNetwork, error]
+	CreatePrivateNetwork(ctx context.Context, req CreatePrivateNetworkRequest) (*Operation, error)
+	CreatePrivateNetworkAndWait(ctx context.Context, req CreatePrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	DeletePrivateNetwork(ctx context.Context, id UUID) (*Operation, error)
+	GetPrivateNetwork(ctx context.Context, id UUID) (*PrivateNetwork, error)
+	UpdatePrivateNetwork(ctx context.Context, id UUID, req UpdatePrivateNetworkRequest) (*Operation, error)
+	UpdatePrivateNetworkAndWait(ctx context.Context, id UUID, req UpdatePrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	ResetPrivateNetworkField(ctx context.Context, id UUID, field ResetPrivateNetworkFieldField) (*Operation, error)
+	AttachInstanceToPrivateNetwork(ctx context.Context, id UUID, req AttachInstanceToPrivateNetworkRequest) (*Operation, error)
+	AttachInstanceToPrivateNetworkAndWait(ctx context.Context, id UUID, req AttachInstanceToPrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	DetachInstanceFromPrivateNetwork(ctx context.Context, id UUID, req DetachInstanceFromPrivateNetworkRequest) (*Operation, error)
+	DetachInstanceFromPrivateNetworkAndWait(ctx context.Context, id UUID, req DetachInstanceFromPrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	UpdatePrivateNetworkInstanceIP(ctx context.Context, id UUID, req UpdatePrivateNetworkInstanceIPRequest) (*Operation, error)
+	UpdatePrivateNetworkInstanceIPAndWait(ctx context.Context, id UUID, req UpdatePrivateNetworkInstanceIPRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	DeleteReverseDNSElasticIP(ctx context.Context, id UUID) (*Operation, error)
+	GetReverseDNSElasticIP(ctx context.Context, id UUID) (*ReverseDNSRecord, error)
+	UpdateReverseDNSElasticIP(ctx context.Context, id UUID, req UpdateReverseDNSElasticIPRequest) (*Operation, error)
+	DeleteReverseDNSInstance(ctx context.Context, id UUID) (*Operation, error)
+	GetReverseDNSInstance(ctx context.Context, id UUID) (*ReverseDNSRecord, error)
+	UpdateReverseDNSInstance(ctx context.Context, id UUID, req UpdateReverseDNSInstanceRequest) (*Operation, error)
+	ListSecurityGroups(ctx context.Context, opts ...ListSecurityGroupsOpt) (*ListSecurityGroupsResponse, error)
+	AllSecurityGroups(ctx context.Context, opts ...ListSecurityGroupsOpt) iter.Seq2[SecurityGroup, error]
+	CreateSecurityGroup(ctx context.Context, req CreateSecurityGroupRequest) (*Operation, error)
+	CreateSecurityGroupAndWait(ctx context.Context, req CreateSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	DeleteSecurityGroup(ctx context.Context, id UUID) (*Operation, error)
+	GetSecurityGroup(ctx context.Context, id UUID) (*SecurityGroup, error)
+	AddRuleToSecurityGroup(ctx context.Context, id UUID, req AddRuleToSecurityGroupRequest) (*Operation, error)
+	DeleteRuleFromSecurityGroup(ctx context.Context, id UUID, ruleID UUID) (*Operation, error)
+	AddExternalSourceToSecurityGroup(ctx context.Context, id UUID, req AddExternalSourceToSecurityGroupRequest) (*Operation, error)
+	AddExternalSourceToSecurityGroupAndWait(ctx context.Context, id UUID, req AddExternalSourceToSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	AttachInstanceToSecurityGroup(ctx context.Context, id UUID, req AttachInstanceToSecurityGroupRequest) (*Operation, error)
+	AttachInstanceToSecurityGroupAndWait(ctx context.Context, id UUID, req AttachInstanceToSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	DetachInstanceFromSecurityGroup(ctx context.Context, id UUID, req DetachInstanceFromSecurityGroupRequest) (*Operation, error)
+	DetachInstanceFromSecurityGroupAndWait(ctx context.Context, id UUID, req DetachInstanceFromSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	RemoveExternalSourceFromSecurityGroup(ctx context.Context, id UUID, req RemoveExternalSourceFromSecurityGroupRequest) (*Operation, error)
+	RemoveExternalSourceFromSecurityGroupAndWait(ctx context.Context, id UUID, req RemoveExternalSourceFromSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	ListSnapshots(ctx context.Context) (*ListSnapshotsResponse, error)
+	AllSnapshots(ctx context.Context) iter.Seq2[Snapshot, error]
+	DeleteSnapshot(ctx context.Context, id UUID) (*Operation, error)
+	GetSnapshot(ctx context.Context, id UUID) (*Snapshot, error)
+	ExportSnapshot(ctx context.Context, id UUID) (*Operation, error)
+	ExportSnapshotAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Snapshot, error)
+	PromoteSnapshotToTemplate(ctx context.Context, id UUID, req PromoteSnapshotToTemplateRequest) (*Operation, error)
+	ListSSHKeys(ctx context.Context) (*ListSSHKeysResponse, error)
+	AllSSHKeys(ctx context.Context) iter.Seq2[SSHKey, error]
+	RegisterSSHKey(ctx context.Context, req RegisterSSHKeyRequest) (*Operation, error)
+	DeleteSSHKey(ctx context.Context, name string) (*Operation, error)
+	GetSSHKey(ctx context.Context, name string) (*SSHKey, error)
+	ListTemplates(ctx context.Context, opts ...ListTemplatesOpt) (*ListTemplatesResponse, error)
+	AllTemplates(ctx context.Context, opts ...ListTemplatesOpt) iter.Seq2[Template, error]
+	RegisterTemplate(ctx context.Context, req RegisterTemplateRequest) (*Operation, error)
+	RegisterTemplateAndWait(ctx context.Context, req RegisterTemplateRequest, opts ...WaitOpt) (*Template, error)
+	DeleteTemplate(ctx context.Context, id UUID) (*Operation, error)
+	GetTemplate(ctx context.Context, id UUID) (*Template, error)
+	CopyTemplate(ctx context.Context, id UUID, req CopyTemplateRequest) (*Operation, error)
+	UpdateTemplate(ctx context.Context, id UUID, req UpdateTemplateRequest) (*Operation, error)
+	UpdateTemplateAndWait(ctx context.Context, id UUID, req UpdateTemplateRequest, opts ...WaitOpt) (*Template, error)
+}
+
+// DBAASAPI is the interface of the managed databases operations of Client.
+type DBAASAPI interface {
+	GetDBAASCACertificate(ctx context.Context) (*GetDBAASCACertificateResponse, error)
+	DeleteDBAASExternalEndpointDatadog(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointDatadog(ctx context.Context, endpointID UUID) (*DBAASExternalEndpointDatadogOutput, error)
+	UpdateDBAASExternalEndpointDatadog(ctx context.Context, endpointID UUID, req DBAASEndpointDatadogInputUpdate) (*Operation, error)
+	UpdateDBAASExternalEndpointDatadogAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointDatadogInputUpdate, opts ...WaitOpt) (*DBAASExternalEndpointDatadogOutput, error)
+	CreateDBAASExternalEndpointDatadog(ctx context.Context, name string, req DBAASEndpointDatadogInputCreate) (*Operation, error)
+	CreateDBAASExternalEndpointDatadogAndWait(ctx context.Context, name string, req DBAASEndpointDatadogInputCreate, opts ...WaitOpt) (*DBAASExternalEndpointDatadogOutput, error)
+	DeleteDBAASExternalEndpointElasticsearch(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointElasticsearch(ctx context.Context, endpointID UUID) (*DBAASEndpointElasticsearchOutput, error)
+	UpdateDBAASExternalEndpointElasticsearch(ctx context.Context, endpointID UUID, req DBAASEndpointElasticsearchInputUpdate) (*Operation, error)
+	UpdateDBAASExternalEndpointElasticsearchAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointElasticsearchInputUpdate, opts ...WaitOpt) (*DBAASEndpointElasticsearchOutput, error)
+	CreateDBAASExternalEndpointElasticsearch(ctx context.Context, name string, req DBAASEndpointElasticsearchInputCreate) (*Operation, error)
+	CreateDBAASExternalEndpointElasticsearchAndWait(ctx context.Context, name string, req DBAASEndpointElasticsearchInputCreate, opts ...WaitOpt) (*DBAASEndpointElasticsearchOutput, error)
+	DeleteDBAASExternalEndpointOpensearch(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointOpensearch(ctx context.Context, endpointID UUID) (*DBAASEndpointOpensearchOutput, error)
+	UpdateDBAASExternalEndpointOpensearch(ctx context.Context, endpointID UUID, req DBAASEndpointOpensearchInputUpdate) (*Operation, error)
+	UpdateDBAASExternalEndpointOpensearchAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointOpensearchInputUpdate, opts ...WaitOpt) (*DBAASEndpointOpensearchOutput, error)
+	CreateDBAASExternalEndpointOpensearch(ctx context.Context, name string, req DBAASEndpointOpensearchInputCreate) (*Operation, error)
+	CreateDBAASExternalEndpointOpensearchAndWait(ctx context.Context, name string, req DBAASEndpointOpensearchInputCreate, opts ...WaitOpt) (*DBAASEndpointOpensearchOutput, error)
+	DeleteDBAASExternalEndpointPrometheus(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointPrometheus(ctx context.Context, endpointID UUID) (*DBAASEndpointExternalPrometheusOutput, error)
+	UpdateDBAASExternalEndpointPrometheus(ctx context.Context, endpointID UUID, req DBAASEndpointPrometheusPayload) (*Operation, error)
+	UpdateDBAASExternalEndpointPrometheusAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointPrometheusPayload, opts ...WaitOpt) (*DBAASEndpointExternalPrometheusOutput, error)
+	CreateDBAASExternalEndpointPrometheus(ctx context.Context, name string, req DBAASEndpointPrometheusPayload) (*Operation, error)
+	CreateDBAASExternalEndpointPrometheusAndWait(ctx context.Context, name string, req DBAASEndpointPrometheusPayload, opts ...WaitOpt) (*DBAASEndpointExternalPrometheusOutput, error)
+	DeleteDBAASExternalEndpointRsyslog(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointRsyslog(ctx context.Context, endpointID UUID) (*DBAASExternalEndpointRsyslogOutput, error)
+	UpdateDBAASExternalEndpointRsyslog(ctx context.Context, endpointID UUID, req DBAASEndpointRsyslogInputUpdate) (*Operation, error)
+	UpdateDBAASExternalEndpointRsyslogAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointRsyslogInputUpdate, opts ...WaitOpt) (*DBAASExternalEndpointRsyslogOutput, error)
+	CreateDBAASExternalEndpointRsyslog(ctx context.Context, name string, req DBAASEndpointRsyslogInputCreate) (*Operation, error)
+	CreateDBAASExternalEndpointRsyslogAndWait(ctx context.Context, name string, req DBAASEndpointRsyslogInputCreate, opts ...WaitOpt) (*DBAASExternalEndpointRsyslogOutput, error)
+	ListDBAASExternalEndpointTypes(ctx context.Context) (*ListDBAASExternalEndpointTypesResponse, error)
+	AllDBAASExternalEndpointTypes(ctx context.Context) iter.Seq2[ListDBAASExternalEndpointTypesResponseEndpointTypes, error]
+	AttachDBAASServiceToEndpoint(ctx context.Context, sourceServiceName string, req AttachDBAASServiceToEndpointRequest) (*Operation, error)
+	DetachDBAASServiceFromEndpoint(ctx context.Context, sourceServiceName string, req DetachDBAASServiceFromEndpointRequest) (*Operation, error)
+	ListDBAASExternalEndpoints(ctx context.Context) (*ListDBAASExternalEndpointsResponse, error)
+	AllDBAASExternalEndpoints(ctx context.Context) iter.Seq2[DBAASExternalEndpoint, error]
+	GetDBAASExternalIntegrationSettingsDatadog(ctx context.Context, integrationID UUID) (*GetDBAASExternalIntegrationSettingsDatadogResponse, error)
+	UpdateDBAASExternalIntegrationSettingsDatadog(ctx context.Context, integrationID UUID, req UpdateDBAASExternalIntegrationSettingsDatadogRequest) (*Operation, error)
+	UpdateDBAASExternalIntegrationSettingsDatadogAndWait(ctx context.Context, integrationID UUID, req UpdateDBAASExternalIntegrationSettingsDatadogRequest, opts ...WaitOpt) (*GetDBAASExternalIntegrationSettingsDatadogResponse, error)
+	GetDBAASExternalIntegration(ctx context.Context, integrationID UUID) (*DBAASExternalIntegration, error)
+	ListDBAASExternalIntegrations(ctx context.Context, serviceName string) (*ListDBAASExternalIntegrationsResponse, error)
+	AllDBAASExternalIntegrations(ctx context.Context, serviceName string) iter.Seq2[DBAASExternalIntegration, error]
+	DeleteDBAASServiceGrafana(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceGrafana(ctx context.Context, name string) (*DBAASServiceGrafana, error)
+	CreateDBAASServiceGrafana(ctx context.Context, name string, req CreateDBAASServiceGrafanaRequest) (*Operation, error)
+	UpdateDBAASServiceGrafana(ctx context.Context, name string, req UpdateDBAASServiceGrafanaRequest) (*Operation, error)
+	StartDBAASGrafanaMaintenance(ctx context.Context, name string) (*Operation, error)
+	ResetDBAASGrafanaUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASGrafanaUserPasswordRequest) (*Operation, error)
+	RevealDBAASGrafanaUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserGrafanaSecrets, error)
+	CreateDBAASIntegration(ctx context.Context, req CreateDBAASIntegrationRequest) (*Operation, error)
+	CreateDBAASIntegrationAndWait(ctx context.Context, req CreateDBAASIntegrationRequest, opts ...WaitOpt) (*DBAASIntegration, error)
+	ListDBAASIntegrationSettings(ctx context.Context, integrationType string, sourceType string, destType string) (*ListDBAASIntegrationSettingsResponse, error)
+	ListDBAASIntegrationTypes(ctx context.Context) (*ListDBAASIntegrationTypesResponse, error)
+	AllDBAASIntegrationTypes(ctx context.Context) iter.Seq2[DBAASIntegrationType, error]
+	DeleteDBAASIntegration(ctx context.Context, id UUID) (*Operation, error)
+	GetDBAASIntegration(ctx context.Context, id UUID) (*DBAASIntegration, error)
+	UpdateDBAASIntegration(ctx context.Context, id UUID, req UpdateDBAASIntegrationRequest) (*Operation, error)
+	UpdateDBAASIntegrationAndWait(ctx context.Context, id UUID, req UpdateDBAASIntegrationRequest, opts ...WaitOpt) (*DBAASIntegration, error)
+	DeleteDBAASServiceKafka(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceKafka(ctx context.Context, name string) (*DBAASServiceKafka, error)
+	CreateDBAASServiceKafka(ctx context.Context, name string, req CreateDBAASServiceKafkaRequest) (*Operation, error)
+	UpdateDBAASServiceKafka(ctx context.Context, name string, req UpdateDBAASServiceKafkaRequest) (*Operation, error)
+	GetDBAASKafkaAclConfig(ctx context.Context, name string) (*DBAASKafkaAcls, error)
+	StartDBAASKafkaMaintenance(ctx context.Context, name string) (*Operation, error)
+	CreateDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, name string, req DBAASKafkaSchemaRegistryAclEntry) (*Operation, error)
+	DeleteDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, name string, aclID string) (*Operation, error)
+	CreateDBAASKafkaTopicAclConfig(ctx context.Context, name string, req DBAASKafkaTopicAclEntry) (*Operation, error)
+	DeleteDBAASKafkaTopicAclConfig(ctx context.Context, name string, aclID string) (*Operation, error)
+	RevealDBAASKafkaConnectPassword(ctx context.Context, serviceName string) (*DBAASUserKafkaConnectSecrets, error)
+	CreateDBAASKafkaUser(ctx context.Context, serviceName string, req CreateDBAASKafkaUserRequest) (*Operation, error)
+	DeleteDBAASKafkaUser(ctx context.Context, serviceName string, username string) (*Operation, error)
+	ResetDBAASKafkaUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASKafkaUserPasswordRequest) (*Operation, error)
+	RevealDBAASKafkaUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserKafkaSecrets, error)
+	GetDBAASMigrationStatus(ctx context.Context, name string) (*DBAASMigrationStatus, error)
+	DeleteDBAASServiceMysql(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceMysql(ctx context.Context, name string) (*DBAASServiceMysql, error)
+	CreateDBAASServiceMysql(ctx context.Context, name string, req CreateDBAASServiceMysqlRequest) (*Operation, error)
+	UpdateDBAASServiceMysql(ctx context.Context, name string, req UpdateDBAASServiceMysqlRequest) (*Operation, error)
+	EnableDBAASMysqlWrites(ctx context.Context, name string) (*Operation, error)
+	StartDBAASMysqlMaintenance(ctx context.Context, name string) (*Operation, error)
+	StopDBAASMysqlMigration(ctx context.Context, name string) (*Operation, error)
+	CreateDBAASMysqlDatabase(ctx context.Context, serviceName string, req CreateDBAASMysqlDatabaseRequest) (*Operation, error)
+	DeleteDBAASMysqlDatabase(ctx context.Context, serviceName string, databaseName string) (*Operation, error)
+	CreateDBAASMysqlUser(ctx context.Context, serviceName string, req CreateDBAASMysqlUserRequest) (*Operation, error)
+	DeleteDBAASMysqlUser(ctx context.Context, serviceName string, username string) (*Operation, error)
+	ResetDBAASMysqlUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASMysqlUserPasswordRequest) (*Operation, error)
+	RevealDBAASMysqlUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserMysqlSecrets, error)
+	DeleteDBAASServiceOpensearch(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceOpensearch(ctx context.Context, name string) (*DBAASServiceOpensearch, error)
+	CreateDBAASServiceOpensearch(ctx context.Context, name string, req CreateDBAASServiceOpensearchRequest) (*Operation, error)
+	UpdateDBAASServiceOpensearch(ctx context.Context, name string, req UpdateDBAASServiceOpensearchRequest) (*Operation, error)
+	GetDBAASOpensearchAclConfig(ctx context.Context, name string) (*DBAASOpensearchAclConfig, error)
+	UpdateDBAASOpensearchAclConfig(ctx context.Context, name string, req DBAASOpensearchAclConfig) (*Operation, error)
+	StartDBAASOpensearchMaintenance(ctx context.Context, name string) (*Operation, error)
+	CreateDBAASOpensearchUser(ctx context.Context, serviceName string, req CreateDBAASOpensearchUserRequest) (*Operation, error)
+	DeleteDBAASOpensearchUser(ctx context.Context, serviceName string, username string) (*Operation, error)
+	ResetDBAASOpensearchUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASOpensearchUserPasswordRequest) (*Operation, error)
+	RevealDBAASOpensearchUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserOpensearchSecrets, error)
+	DeleteDBAASServicePG(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServicePG(ctx context.Context, name string) (*DBAASServicePG, error)
+	CreateDBAASServicePG(ctx context.Context, name string, req CreateDBAASServicePGRequest) (*Operation, error)
+	UpdateDBAASServicePG(ctx context.Context, name string, req UpdateDBAASServicePGRequest) (*Operation, error)
+	StartDBAASPGMaintenance(ctx context.Context, name string) (*Operation, error)
+	StopDBAASPGMigration(ctx context.Context, name string) (*Operation, error)
+	CreateDBAASPGConnectionPool(ctx context.Context, serviceName string, req CreateDBAASPGConnectionPoolRequest) (*Operation, error)
+	DeleteDBAASPGConnectionPool(ctx context.Context, serviceName string, connectionPoolName string) (*Operation, error)
+	UpdateDBAASPGConnectionPool(ctx context.Context, serviceName string, connectionPoolName string, req UpdateDBAASPGConnectionPoolRequest) (*Operation, error)
+	CreateDBAASPGDatabase(ctx context.Context, serviceName string, req CreateDBAASPGDatabaseRequest) (*Operation, error)
+	DeleteDBAASPGDatabase(ctx context.Context, serviceName string, databaseName string) (*Operation, error)
+	CreateDBAASPostgresUser(ctx context.Context, serviceName string, req CreateDBAASPostgresUserRequest) (*Operation, error)
+	DeleteDBAASPostgresUser(ctx context.Context, serviceName string, username string) (*Operation, error)
+	UpdateDBAASPostgresAllowReplication(ctx context.Context, serviceName string, username string, req UpdateDBAASPostgresAllowReplicationRequest) (*DBAASPostgresUsers, error)
+	ResetDBAASPostgresUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASPostgresUserPasswordRequest) (*Operation, error)
+	RevealDBAASPostgresUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserPostgresSecrets, error)
+	CreateDBAASPGUpgradeCheck(ctx context.Context, service string, req CreateDBAASPGUpgradeCheckRequest) (*DBAASTask, error)
+	ListDBAASServices(ctx context.Context) (*ListDBAASServicesResponse, error)
+	AllDBAASServices(ctx context.Context) iter.Seq2[DBAASServiceCommon, error]
+	GetDBAASServiceLogs(ctx context.Context, serviceName string, req GetDBAASServiceLogsRequest) (*DBAASServiceLogs, error)
+	GetDBAASServiceMetrics(ctx context.Context, serviceName string, req GetDBAASServiceMetricsRequest) (*GetDBAASServiceMetricsResponse, error)
+	ListDBAASServiceTypes(ctx context.Context) (*ListDBAASServiceTypesResponse, error)
+	AllDBAASServiceTypes(ctx context.Context) iter.Seq2[DBAASServiceType, error]
+	GetDBAASServiceType(ctx context.Context, serviceTypeName string) (*DBAASServiceType, error)
+	DeleteDBAASService(ctx context.Context, name string) (*Operation, error)
+	GetDBAASSettingsGrafana(ctx context.Context) (*GetDBAASSettingsGrafanaResponse, error)
+	GetDBAASSettingsKafka(ctx context.Context) (*GetDBAASSettingsKafkaResponse, error)
+	GetDBAASSettingsMysql(ctx context.Context) (*GetDBAASSettingsMysqlResponse, error)
+	GetDBAASSettingsOpensearch(ctx context.Context) (*GetDBAASSettingsOpensearchResponse, error)
+	GetDBAASSettingsPG(ctx context.Context) (*GetDBAASSettingsPGResponse, error)
+	GetDBAASSettingsThanos(ctx context.Context) (*GetDBAASSettingsThanosResponse, error)
+	GetDBAASSettingsValkey(ctx context.Context) (*GetDBAASSettingsValkeyResponse, error)
+	CreateDBAASTaskMigrationCheck(ctx context.Context, service string, req CreateDBAASTaskMigrationCheckRequest) (*Operation, error)
+	GetDBAASTask(ctx context.Context, service string, id UUID) (*DBAASTask, error)
+	DeleteDBAASServiceThanos(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceThanos(ctx context.Context, name string) (*DBAASServiceThanos, error)
+	CreateDBAASServiceThanos(ctx context.Context, name string, req CreateDBAASServiceThanosRequest) (*Operation, error)
+	UpdateDBAASServiceThanos(ctx context.Context, name string, req UpdateDBAASServiceThanosRequest) (*Operation, error)
+	StartDBAASThanosMaintenance(ctx context.Context, name string) (*Operation, error)
+	RevealDBAASThanosUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserThanosSecrets, error)
+	DeleteDBAASServiceValkey(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceValkey(ctx context.Context, name string) (*DBAASServiceValkey, error)
+	CreateDBAASServiceValkey(ctx context.Context, name string, req CreateDBAASServiceValkeyRequest) (*Operation, error)
+	UpdateDBAASServiceValkey(ctx context.Context, name string, req UpdateDBAASServiceValkeyRequest) (*Operation, error)
+	StartDBAASValkeyMaintenance(ctx context.Context, name string) (*Operation, error)
+	StopDBAASValkeyMigration(ctx context.Context, name string) (*Operation, error)
+	ListDBAASValkeyUsers(ctx context.Context, serviceName string) (*DBAASValkeyUsers, error)
+	AllDBAASValkeyUsers(ctx context.Context, serviceName string) iter.Seq2[DBAASValkeyUser, error]
+	CreateDBAASValkeyUser(ctx context.Context, serviceName string, req CreateDBAASValkeyUserRequest) (*Operation, error)
+	DeleteDBAASValkeyUser(ctx context.Context, serviceName string, username string) (*Operation, error)
+	UpdateDBAASValkeyUserAccessControl(ctx context.Context, serviceName string, username string, req UpdateDBAASValkeyUserAccessControlRequest) (*Operation, error)
+	ResetDBAASValkeyUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASValkeyUserPasswordRequest) (*Operation, error)
+	RevealDBAASValkeyUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserValkeySecrets, error)
+}
+
+// DNSAPI is the interface of the DNS domains and records operations of Client.
+type DNSAPI interface {
+	ListDNSDomains(ctx context.Context) (*ListDNSDomainsResponse, error)
+	AllDNSDomains(ctx context.Context) iter.Seq2[DNSDomain, error]
+	CreateDNSDomain(ctx context.Context, req CreateDNSDomainRequest) (*Operation, error)
+	CreateDNSDomainAndWait(ctx context.Context, req CreateDNSDomainRequest, opts ...WaitOpt) (*DNSDomain, error)
+	ListDNSDomainRecords(ctx context.Context, domainID UUID) (*ListDNSDomainRecordsResponse, error)
+	AllDNSDomainRecords(ctx context.Context, domainID UUID) iter.Seq2[DNSDomainRecord, error]
+	CreateDNSDomainRecord(ctx context.Context, domainID UUID, req CreateDNSDomainRecordRequest) (*Operation, error)
+	DeleteDNSDomainRecord(ctx context.Context, domainID UUID, recordID UUID) (*Operation, error)
+	GetDNSDomainRecord(ctx context.Context, domainID UUID, recordID UUID) (*DNSDomainRecord, error)
+	UpdateDNSDomainRecord(ctx context.Context, domainID UUID, recordID UUID, req UpdateDNSDomainRecordRequest) (*Operation, error)
+	DeleteDNSDomain(ctx context.Context, id UUID) (*Operation, error)
+	GetDNSDomain(ctx context.Context, id UUID) (*DNSDomain, error)
+	GetDNSDomainZoneFile(ctx context.Context, id UUID) (*GetDNSDomainZoneFileResponse, error)
+}
+
+// IAMAPI is the interface of the identity and access management operations of Client.
+type IAMAPI interface {
+	ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error)
+	AllAPIKeys(ctx context.Context) iter.Seq2[IAMAPIKey, error]
+	CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*IAMAPIKeyCreated, error)
+	DeleteAPIKey(ctx context.Context, id string) (*Operation, error)
+	GetAPIKey(ctx context.Context, id string) (*IAMAPIKey, error)
+	GetIAMOrganizationPolicy(ctx context.Context) (*IAMPolicy, error)
+	UpdateIAMOrganizationPolicy(ctx context.Context, req IAMPolicy) (*Operation, error)
+	ResetIAMOrganizationPolicy(ctx context.Context) (*Operation, error)
+	ListIAMRoles(ctx context.Context) (*ListIAMRolesResponse, error)
+	AllIAMRoles(ctx context.Context) iter.Seq2[IAMRole, error]
+	CreateIAMRole(ctx context.Context, req CreateIAMRoleRequest) (*Operation, error)
+	CreateIAMRoleAndWait(ctx context.Context, req CreateIAMRoleRequest, opts ...WaitOpt) (*IAMRole, error)
+	DeleteIAMRole(ctx context.Context, id UUID) (*Operation, error)
+	GetIAMRole(ctx context.Context, id UUID) (*IAMRole, error)
+	UpdateIAMRole(ctx context.Context, id UUID, req UpdateIAMRoleRequest) (*Operation, error)
+	UpdateIAMRoleAndWait(ctx context.Context, id UUID, req UpdateIAMRoleRequest, opts ...WaitOpt) (*IAMRole, error)
+	UpdateIAMRoleAssumePolicy(ctx context.Context, id UUID, req IAMPolicy) (*Operation, error)
+	UpdateIAMRoleAssumePolicyAndWait(ctx context.Context, id UUID, req IAMPolicy, opts ...WaitOpt) (*IAMRole, error)
+	UpdateIAMRolePolicy(ctx context.Context, id UUID, req IAMPolicy) (*Operation, error)
+	UpdateIAMRolePolicyAndWait(ctx context.Context, id UUID, req IAMPolicy, opts ...WaitOpt) (*IAMRole, error)
+	AssumeIAMRole(ctx context.Context, targetRoleID UUID, req AssumeIAMRoleRequest) (*AssumeIAMRoleResponse, error)
+	ListUsers(ctx context.Context) (*ListUsersResponse, error)
+	AllUsers(ctx context.Context) iter.Seq2[User, error]
+	CreateUser(ctx context.Context, req CreateUserRequest) (*Operation, error)
+	DeleteUser(ctx context.Context, id UUID) (*Operation, error)
+	UpdateUserRole(ctx context.Context, id UUID, req UpdateUserRoleRequest) (*Operation, error)
+}
+
+// KMSAPI is the interface of the key management service operations of Client.
+type KMSAPI interface {
+	ListKmsKeys(ctx context.Context) (*ListKmsKeysResponse, error)
+	AllKmsKeys(ctx context.Context) iter.Seq2[ListKmsKeysResponseEntry, error]
+	CreateKmsKey(ctx context.Context, req CreateKmsKeyRequest) (*CreateKmsKeyResponse, error)
+	GetKmsKey(ctx context.Context, id UUID) (*GetKmsKeyResponse, error)
+	CancelKmsKeyDeletion(ctx context.Context, id UUID) (*SuccessResponse, error)
+	Decrypt(ctx context.Context, id UUID, req DecryptRequest) (*DecryptResponse, error)
+	DisableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, error)
+	DisableKmsKeyRotation(ctx context.Context, id UUID) (*DisableKmsKeyRotationResponse, error)
+	EnableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, error)
+	EnableKmsKeyRotation(ctx context.Context, id UUID, req EnableKmsKeyRotationRequest) (*EnableKmsKeyRotationResponse, error)
+	Encrypt(ctx context.Context, id UUID, req EncryptRequest) (*EncryptResponse, error)
+	GenerateDataKey(ctx context.Context, id UUID, req GenerateDataKeyRequest) (*GenerateDataKeyResponse, error)
+	ListKmsKeyRotations(ctx context.Context, id UUID) (*ListKmsKeyRotationsResponse, error)
+	AllKmsKeyRotations(ctx context.Context, id UUID) iter.Seq2[ListKmsKeyRotationsResponseEntry, error]
+	ReEncrypt(ctx context.Context, id UUID, req ReEncryptRequest) (*ReEncryptResponse, error)
+	ReplicateKmsKey(ctx context.Context, id UUID, req ReplicateKmsKeyRequest) (*SuccessResponse, error)
+	RotateKmsKey(ctx context.Context, id UUID) (*RotateKmsKeyResponse, error)
+	ScheduleKmsKeyDeletion(ctx context.Context, id UUID, req ScheduleKmsKeyDeletionRequest) (*SuccessResponse, error)
+}
+
+// SKSAPI is the interface of the scalable Kubernetes service operations of Client.
+type SKSAPI interface {
+	ListSKSClusters(ctx context.Context) (*ListSKSClustersResponse, error)
+	AllSKSClusters(ctx context.Context) iter.Seq2[SKSCluster, error]
+	CreateSKSCluster(ctx context.Context, req CreateSKSClusterRequest) (*Operation, error)
+	CreateSKSClusterAndWait(ctx context.Context, req CreateSKSClusterRequest, opts ...WaitOpt) (*SKSCluster, error)
+	ListSKSClusterDeprecatedResources(ctx context.Context, id UUID) ([]SKSClusterDeprecatedResource, error)
+	AllSKSClusterDeprecatedResources(ctx context.Context, id UUID) iter.Seq2[SKSClusterDeprecatedResource, error]
+	GenerateSKSClusterKubeconfig(ctx context.Context, id UUID, req SKSKubeconfigRequest) (*GenerateSKSClusterKubeconfigResponse, error)
+	ListSKSClusterVersions(ctx context.Context, opts ...ListSKSClusterVersionsOpt) (*ListSKSClusterVersionsResponse, error)
+	AllSKSClusterVersions(ctx context.Context, opts ...ListSKSClusterVersionsOpt) iter.Seq2[string, error]
+	DeleteSKSCluster(ctx context.Context, id UUID) (*Operation, error)
+	GetSKSCluster(ctx context.Context, id UUID) (*SKSCluster, error)
+	UpdateSKSCluster(ctx context.Context, id UUID, req UpdateSKSClusterRequest) (*Operation, error)
+	UpdateSKSClusterAndWait(ctx context.Context, id UUID, req UpdateSKSClusterRequest, opts ...WaitOpt) (*SKSCluster, error)
+	GetSKSClusterAuthorityCert(ctx context.Context, id UUID, authority GetSKSClusterAuthorityCertAuthority) (*GetSKSClusterAuthorityCertResponse, error)
+	GetSKSClusterInspection(ctx context.Context, id UUID) (*GetSKSClusterInspectionResponse, error)
+	CreateSKSNodepool(ctx context.Context, id UUID, req CreateSKSNodepoolRequest) (*Operation, error)
+	DeleteSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID) (*Operation, error)
+	GetSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID) (*SKSNodepool, error)
+	UpdateSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID, req UpdateSKSNodepoolRequest) (*Operation, error)
+	EvictSKSNodepoolMembers(ctx context.Context, id UUID, sksNodepoolID UUID, req EvictSKSNodepoolMembersRequest) (*Operation, error)
+	ScaleSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID, req ScaleSKSNodepoolRequest) (*Operation, error)
+	RotateSKSCcmCredentials(ctx context.Context, id UUID) (*Operation, error)
+	RotateSKSCsiCredentials(ctx context.Context, id UUID) (*Operation, error)
+	RotateSKSKarpenterCredentials(ctx context.Context, id UUID) (*Operation, error)
+	RotateSKSOperatorsCA(ctx context.Context, id UUID) (*Operation, error)
+	UpgradeSKSCluster(ctx context.Context, id UUID, req UpgradeSKSClusterRequest) (*Operation, error)
+	UpgradeSKSClusterServiceLevel(ctx context.Context, id UUID) (*Operation, error)
+	GetActiveNodepoolTemplate(ctx context.Context, kubeVersion string, variant GetActiveNodepoolTemplateVariant) (*GetActiveNodepoolTemplateResponse, error)
+}
+
+// AIAPI is the interface of the dedicated inference operations of Client.
+type AIAPI interface {
+	ListAIAPIKeys(ctx context.Context) (*ListAIAPIKeysResponse, error)
+	AllAIAPIKeys(ctx context.Context) iter.Seq2[AIAPIKey, error]
+	CreateAIAPIKey(ctx context.Context, req CreateAIAPIKeyRequest) (*AIAPIKeyWithValue, error)
+	DeleteAIAPIKey(ctx context.Context, id UUID) (*DeleteAIAPIKeyResponse, error)
+	GetAIAPIKey(ctx context.Context, id UUID) (*AIAPIKey, error)
+	UpdateAIAPIKey(ctx context.Context, id UUID, req UpdateAIAPIKeyRequest) (*AIAPIKey, error)
+	RotateAIAPIKey(ctx context.Context, id UUID) (*AIAPIKeyWithValue, error)
+	ListDeployments(ctx context.Context, opts ...ListDeploymentsOpt) (*ListDeploymentsResponse, error)
+	AllDeployments(ctx context.Context, opts ...ListDeploymentsOpt) iter.Seq2[ListDeploymentsResponseEntry, error]
+	CreateDeployment(ctx context.Context, req CreateDeploymentRequest) (*Operation, error)
+	DeleteDeployment(ctx context.Context, id UUID) (*Operation, error)
+	GetDeployment(ctx context.Context, id UUID) (*GetDeploymentResponse, error)
+	UpdateDeployment(ctx context.Context, id UUID, req UpdateDeploymentRequest) (*Operation, error)
+	RevealDeploymentAPIKey(ctx context.Context, id UUID) (*RevealDeploymentAPIKeyResponse, error)
+	GetDeploymentLogs(ctx context.Context, id UUID, opts ...GetDeploymentLogsOpt) (*GetDeploymentLogsResponse, error)
+	ScaleDeployment(ctx context.Context, id UUID, req ScaleDeploymentRequest) (*Operation, error)
+	GetInferenceEngineHelp(ctx context.Context, opts ...GetInferenceEngineHelpOpt) (*GetInferenceEngineHelpResponse, error)
+	ListAIInstanceTypes(ctx context.Context) (*ListAIInstanceTypesResponse, error)
+	AllAIInstanceTypes(ctx context.Context) iter.Seq2[InstanceTypeEntry, error]
+	ListModels(ctx context.Context, opts ...ListModelsOpt) (*ListModelsResponse, error)
+	AllModels(ctx context.Context, opts ...ListModelsOpt) iter.Seq2[ListModelsResponseEntry, error]
+	CreateModel(ctx context.Context, req CreateModelRequest) (*Operation, error)
+	DeleteModel(ctx context.Context, id UUID) (*Operation, error)
+	GetModel(ctx context.Context, id UUID) (*GetModelResponse, error)
+}
+
+// PlatformAPI is the interface of the organization, zones, operations and other platform-wide resources operations of Client.
+type PlatformAPI interface {
+	GetEnvImpact(ctx context.Context, period string) (*EnvImpactReport, error)
+	ListEvents(ctx context.Context, opts ...ListEventsOpt) ([]Event, error)
+	AllEvents(ctx context.Context, opts ...ListEventsOpt) iter.Seq2[Event, error]
+	GetOperation(ctx context.Context, id UUID) (*Operation, error)
+	GetOrganization(ctx context.Context) (*Organization, error)
+	ListQuotas(ctx context.Context) (*ListQuotasResponse, error)
+	AllQuotas(ctx context.Context) iter.Seq2[Quota, error]
+	GetQuota(ctx context.Context, entity string) (*Quota, error)
+	ListSOSBucketsUsage(ctx context.Context) (*ListSOSBucketsUsageResponse, error)
+	AllSOSBucketsUsage(ctx context.Context) iter.Seq2[SOSBucketUsage, error]
+	GetSOSPresignedURL(ctx context.Context, bucket string, opts ...GetSOSPresignedURLOpt) (*GetSOSPresignedURLResponse, error)
+	GetUsageReport(ctx context.Context, opts ...GetUsageReportOpt) (*GetUsageReportResponse, error)
+	ListZones(ctx context.Context) (*ListZonesResponse, error)
+	AllZones(ctx context.Context) iter.Seq2[Zone, error]
+}
+
+// ClientAPI is the interface implemented by Client, e.g. to substitute a MockClient in tests.
+type ClientAPI interface {
+	ComputeAPI
+	DBAASAPI
+	DNSAPI
+	IAMAPI
+	KMSAPI
+	SKSAPI
+	AIAPI
+	PlatformAPI
+
+	Wait(ctx context.Context, op *Operation, states ...OperationState) (*Operation, error)
+	WaitWithOpts(ctx context.Context, op *Operation, opts ...WaitOpt) (*Operation, error)
+	WaitAll(ctx context.Context, ops ...*Operation) ([]WaitResult, error)
+	WaitAllWithOpts(ctx context.Context, ops []*Operation, opts ...WaitOpt) ([]WaitResult, error)
+	WaitAny(ctx context.Context, ops ...*Operation) (int, *Operation, error)
+	WaitAnyWithOpts(ctx context.Context, ops []*Operation, opts ...WaitOpt) (int, *Operation, error)
+	GetZoneName(ctx context.Context, endpoint Endpoint) (ZoneName, error)
+	GetZoneAPIEndpoint(ctx context.Context, zoneName ZoneName) (Endpoint, error)
+}
+
+var _ ClientAPI = Client{}
@@ -0,0 +1,26 @@
+package v3
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockClient(t *testing.T) {
+	var client ClientAPI = &MockClient{
+		ListZonesFunc: func(ctx context.Context) (*ListZonesResponse, error) {
+			return &ListZonesResponse{Zones: []Zone{{Name: ZoneNameCHGva2}}}, nil
+		},
+	}
+
+	resp, err := client.ListZones(context.Background())
+	if err != nil || len(resp.Zones) != 1 || resp.Zones[0].Name != ZoneNameCHGva2 {
+		t.Errorf("unexpected ListZones response %+v: %v", resp, err)
+	}
+
+	defer func() {
+		if r := recover(); r != "MockClient: GetInstance is not implemented" {
+			t.Errorf("unexpected panic %v", r)
+		}
+	}()
+	_, _ = client.GetInstance(context.Background(), UUID("0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b"))
+}
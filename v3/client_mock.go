@@ -0,0 +1,4039 @@
+// Package v3 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/egoscale/v3/generator version v0.0.1 DO NOT EDIT.
+package v3
+
+import (
+	"context"
+	"iter"
+)
+
+// MockClient is a ClientAPI implementation calling the function of the matching field,
+// e.g. GetInstanceFunc for GetInstance. Methods with a nil function panic.
+type MockClient struct {
+	ListAntiAffinityGroupsFunc                               func(ctx context.Context) (*ListAntiAffinityGroupsResponse, error)
+	AllAntiAffinityGroupsFunc                                func(ctx context.Context) iter.Seq2[AntiAffinityGroup, error]
+	CreateAntiAffinityGroupFunc                              func(ctx context.Context, req CreateAntiAffinityGroupRequest) (*Operation, error)
+	CreateAntiAffinityGroupAndWaitFunc                       func(ctx context.Context, req CreateAntiAffinityGroupRequest, opts ...WaitOpt) (*AntiAffinityGroup, error)
+	DeleteAntiAffinityGroupFunc                              func(ctx context.Context, id UUID) (*Operation, error)
+	GetAntiAffinityGroupFunc                                 func(ctx context.Context, id UUID) (*AntiAffinityGroup, error)
+	ListBlockStorageVolumesFunc                              func(ctx context.Context, opts ...ListBlockStorageVolumesOpt) (*ListBlockStorageVolumesResponse, error)
+	AllBlockStorageVolumesFunc                               func(ctx context.Context, opts ...ListBlockStorageVolumesOpt) iter.Seq2[BlockStorageVolume, error]
+	CreateBlockStorageVolumeFunc                             func(ctx context.Context, req CreateBlockStorageVolumeRequest) (*Operation, error)
+	CreateBlockStorageVolumeAndWaitFunc                      func(ctx context.Context, req CreateBlockStorageVolumeRequest, opts ...WaitOpt) (*BlockStorageVolume, error)
+	ListBlockStorageSnapshotsFunc                            func(ctx context.Context) (*ListBlockStorageSnapshotsResponse, error)
+	AllBlockStorageSnapshotsFunc                             func(ctx context.Context) iter.Seq2[BlockStorageSnapshot, error]
+	DeleteBlockStorageSnapshotFunc                           func(ctx context.Context, id UUID) (*Operation, error)
+	GetBlockStorageSnapshotFunc                              func(ctx context.Context, id UUID) (*BlockStorageSnapshot, error)
+	UpdateBlockStorageSnapshotFunc                           func(ctx context.Context, id UUID, req UpdateBlockStorageSnapshotRequest) (*Operation, error)
+	UpdateBlockStorageSnapshotAndWaitFunc                    func(ctx context.Context, id UUID, req UpdateBlockStorageSnapshotRequest, opts ...WaitOpt) (*BlockStorageSnapshot, error)
+	DeleteBlockStorageVolumeFunc                             func(ctx context.Context, id UUID) (*Operation, error)
+	GetBlockStorageVolumeFunc                                func(ctx context.Context, id UUID) (*BlockStorageVolume, error)
+	UpdateBlockStorageVolumeFunc                             func(ctx context.Context, id UUID, req UpdateBlockStorageVolumeRequest) (*Operation, error)
+	UpdateBlockStorageVolumeAndWaitFunc                      func(ctx context.Context, id UUID, req UpdateBlockStorageVolumeRequest, opts ...WaitOpt) (*BlockStorageVolume, error)
+	AttachBlockStorageVolumeToInstanceFunc                   func(ctx context.Context, id UUID, req AttachBlockStorageVolumeToInstanceRequest) (*Operation, error)
+	AttachBlockStorageVolumeToInstanceAndWaitFunc            func(ctx context.Context, id UUID, req AttachBlockStorageVolumeToInstanceRequest, opts ...WaitOpt) (*BlockStorageVolume, error)
+	CreateBlockStorageSnapshotFunc                           func(ctx context.Context, id UUID, req CreateBlockStorageSnapshotRequest) (*Operation, error)
+	DetachBlockStorageVolumeFunc                             func(ctx context.Context, id UUID) (*Operation, error)
+	DetachBlockStorageVolumeAndWaitFunc                      func(ctx context.Context, id UUID, opts ...WaitOpt) (*BlockStorageVolume, error)
+	ResizeBlockStorageVolumeFunc                             func(ctx context.Context, id UUID, req ResizeBlockStorageVolumeRequest) (*BlockStorageVolume, error)
+	GetConsoleProxyURLFunc                                   func(ctx context.Context, id UUID) (*GetConsoleProxyURLResponse, error)
+	ListDeployTargetsFunc                                    func(ctx context.Context) (*ListDeployTargetsResponse, error)
+	AllDeployTargetsFunc                                     func(ctx context.Context) iter.Seq2[DeployTarget, error]
+	GetDeployTargetFunc                                      func(ctx context.Context, id UUID) (*DeployTarget, error)
+	ListElasticIPSFunc                                       func(ctx context.Context) (*ListElasticIPSResponse, error)
+	AllElasticIPSFunc                                        func(ctx context.Context) iter.Seq2[ElasticIP, error]
+	CreateElasticIPFunc                                      func(ctx context.Context, req CreateElasticIPRequest) (*Operation, error)
+	CreateElasticIPAndWaitFunc                               func(ctx context.Context, req CreateElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error)
+	DeleteElasticIPFunc                                      func(ctx context.Context, id UUID) (*Operation, error)
+	GetElasticIPFunc                                         func(ctx context.Context, id UUID) (*ElasticIP, error)
+	UpdateElasticIPFunc                                      func(ctx context.Context, id UUID, req UpdateElasticIPRequest) (*Operation, error)
+	UpdateElasticIPAndWaitFunc                               func(ctx context.Context, id UUID, req UpdateElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error)
+	ResetElasticIPFieldFunc                                  func(ctx context.Context, id UUID, field ResetElasticIPFieldField) (*Operation, error)
+	AttachInstanceToElasticIPFunc                            func(ctx context.Context, id UUID, req AttachInstanceToElasticIPRequest) (*Operation, error)
+	AttachInstanceToElasticIPAndWaitFunc                     func(ctx context.Context, id UUID, req AttachInstanceToElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error)
+	DetachInstanceFromElasticIPFunc                          func(ctx context.Context, id UUID, req DetachInstanceFromElasticIPRequest) (*Operation, error)
+	DetachInstanceFromElasticIPAndWaitFunc                   func(ctx context.Context, id UUID, req DetachInstanceFromElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error)
+	ListInstancesFunc                                        func(ctx context.Context, opts ...ListInstancesOpt) (*ListInstancesResponse, error)
+	AllInstancesFunc                                         func(ctx context.Context, opts ...ListInstancesOpt) iter.Seq2[ListInstancesResponseInstances, error]
+	CreateInstanceFunc                                       func(ctx context.Context, req CreateInstanceRequest) (*Operation, error)
+	CreateInstanceAndWaitFunc                                func(ctx context.Context, req CreateInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	ListInstancePoolsFunc                                    func(ctx context.Context) (*ListInstancePoolsResponse, error)
+	AllInstancePoolsFunc                                     func(ctx context.Context) iter.Seq2[InstancePool, error]
+	CreateInstancePoolFunc                                   func(ctx context.Context, req CreateInstancePoolRequest) (*Operation, error)
+	CreateInstancePoolAndWaitFunc                            func(ctx context.Context, req CreateInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error)
+	DeleteInstancePoolFunc                                   func(ctx context.Context, id UUID) (*Operation, error)
+	GetInstancePoolFunc                                      func(ctx context.Context, id UUID) (*InstancePool, error)
+	UpdateInstancePoolFunc                                   func(ctx context.Context, id UUID, req UpdateInstancePoolRequest) (*Operation, error)
+	UpdateInstancePoolAndWaitFunc                            func(ctx context.Context, id UUID, req UpdateInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error)
+	ResetInstancePoolFieldFunc                               func(ctx context.Context, id UUID, field ResetInstancePoolFieldField) (*Operation, error)
+	EvictInstancePoolMembersFunc                             func(ctx context.Context, id UUID, req EvictInstancePoolMembersRequest) (*Operation, error)
+	EvictInstancePoolMembersAndWaitFunc                      func(ctx context.Context, id UUID, req EvictInstancePoolMembersRequest, opts ...WaitOpt) (*InstancePool, error)
+	ScaleInstancePoolFunc                                    func(ctx context.Context, id UUID, req ScaleInstancePoolRequest) (*Operation, error)
+	ScaleInstancePoolAndWaitFunc                             func(ctx context.Context, id UUID, req ScaleInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error)
+	ListInstanceTypesFunc                                    func(ctx context.Context) (*ListInstanceTypesResponse, error)
+	AllInstanceTypesFunc                                     func(ctx context.Context) iter.Seq2[InstanceType, error]
+	GetInstanceTypeFunc                                      func(ctx context.Context, id UUID) (*InstanceType, error)
+	DeleteInstanceFunc                                       func(ctx context.Context, id UUID) (*Operation, error)
+	GetInstanceFunc                                          func(ctx context.Context, id UUID) (*Instance, error)
+	UpdateInstanceFunc                                       func(ctx context.Context, id UUID, req UpdateInstanceRequest) (*Operation, error)
+	UpdateInstanceAndWaitFunc                                func(ctx context.Context, id UUID, req UpdateInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	ResetInstanceFieldFunc                                   func(ctx context.Context, id UUID, field ResetInstanceFieldField) (*Operation, error)
+	AddInstanceProtectionFunc                                func(ctx context.Context, id UUID) (*Operation, error)
+	AddInstanceProtectionAndWaitFunc                         func(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	CreateSnapshotFunc                                       func(ctx context.Context, id UUID) (*Operation, error)
+	EnableTpmFunc                                            func(ctx context.Context, id UUID) (*Operation, error)
+	EnableTpmAndWaitFunc                                     func(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	RevealInstancePasswordFunc                               func(ctx context.Context, id UUID) (*InstancePassword, error)
+	RebootInstanceFunc                                       func(ctx context.Context, id UUID) (*Operation, error)
+	RebootInstanceAndWaitFunc                                func(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	RemoveInstanceProtectionFunc                             func(ctx context.Context, id UUID) (*Operation, error)
+	RemoveInstanceProtectionAndWaitFunc                      func(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	ResetInstanceFunc                                        func(ctx context.Context, id UUID, req ResetInstanceRequest) (*Operation, error)
+	ResetInstanceAndWaitFunc                                 func(ctx context.Context, id UUID, req ResetInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	ResetInstancePasswordFunc                                func(ctx context.Context, id UUID) (*Operation, error)
+	ResetInstancePasswordAndWaitFunc                         func(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	ResizeInstanceDiskFunc                                   func(ctx context.Context, id UUID, req ResizeInstanceDiskRequest) (*Operation, error)
+	ResizeInstanceDiskAndWaitFunc                            func(ctx context.Context, id UUID, req ResizeInstanceDiskRequest, opts ...WaitOpt) (*Instance, error)
+	ScaleInstanceFunc                                        func(ctx context.Context, id UUID, req ScaleInstanceRequest) (*Operation, error)
+	ScaleInstanceAndWaitFunc                                 func(ctx context.Context, id UUID, req ScaleInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	StartInstanceFunc                                        func(ctx context.Context, id UUID, req StartInstanceRequest) (*Operation, error)
+	StartInstanceAndWaitFunc                                 func(ctx context.Context, id UUID, req StartInstanceRequest, opts ...WaitOpt) (*Instance, error)
+	StopInstanceFunc                                         func(ctx context.Context, id UUID) (*Operation, error)
+	StopInstanceAndWaitFunc                                  func(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error)
+	RevertInstanceToSnapshotFunc                             func(ctx context.Context, instanceID UUID, req RevertInstanceToSnapshotRequest) (*Operation, error)
+	RevertInstanceToSnapshotAndWaitFunc                      func(ctx context.Context, instanceID UUID, req RevertInstanceToSnapshotRequest, opts ...WaitOpt) (*Instance, error)
+	ListLoadBalancersFunc                                    func(ctx context.Context) (*ListLoadBalancersResponse, error)
+	AllLoadBalancersFunc                                     func(ctx context.Context) iter.Seq2[LoadBalancer, error]
+	CreateLoadBalancerFunc                                   func(ctx context.Context, req CreateLoadBalancerRequest) (*Operation, error)
+	CreateLoadBalancerAndWaitFunc                            func(ctx context.Context, req CreateLoadBalancerRequest, opts ...WaitOpt) (*LoadBalancer, error)
+	DeleteLoadBalancerFunc                                   func(ctx context.Context, id UUID) (*Operation, error)
+	GetLoadBalancerFunc                                      func(ctx context.Context, id UUID) (*LoadBalancer, error)
+	UpdateLoadBalancerFunc                                   func(ctx context.Context, id UUID, req UpdateLoadBalancerRequest) (*Operation, error)
+	UpdateLoadBalancerAndWaitFunc                            func(ctx context.Context, id UUID, req UpdateLoadBalancerRequest, opts ...WaitOpt) (*LoadBalancer, error)
+	AddServiceToLoadBalancerFunc                             func(ctx context.Context, id UUID, req AddServiceToLoadBalancerRequest) (*Operation, error)
+	DeleteLoadBalancerServiceFunc                            func(ctx context.Context, id UUID, serviceID UUID) (*Operation, error)
+	GetLoadBalancerServiceFunc                               func(ctx context.Context, id UUID, serviceID UUID) (*LoadBalancerService, error)
+	UpdateLoadBalancerServiceFunc                            func(ctx context.Context, id UUID, serviceID UUID, req UpdateLoadBalancerServiceRequest) (*Operation, error)
+	ResetLoadBalancerServiceFieldFunc                        func(ctx context.Context, id UUID, serviceID UUID, field ResetLoadBalancerServiceFieldField) (*Operation, error)
+	ResetLoadBalancerFieldFunc                               func(ctx context.Context, id UUID, field ResetLoadBalancerFieldField) (*Operation, error)
+	ListPrivateNetworksFunc                                  func(ctx context.Context) (*ListPrivateNetworksResponse, error)
+	AllPrivateNetworksFunc                                   func(ctx context.Context) iter.Seq2[PrivateNetwork, error]
+	CreatePrivateNetworkFunc                                 func(ctx context.Context, req CreatePrivateNetworkRequest) (*Operation, error)
+	CreatePrivateNetworkAndWaitFunc                          func(ctx context.Context, req CreatePrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	DeletePrivateNetworkFunc                                 func(ctx context.Context, id UUID) (*Operation, error)
+	GetPrivateNetworkFunc                                    func(ctx context.Context, id UUID) (*PrivateNetwork, error)
+	UpdatePrivateNetworkFunc                                 func(ctx context.Context, id UUID, req UpdatePrivateNetworkRequest) (*Operation, error)
+	UpdatePrivateNetworkAndWaitFunc                          func(ctx context.Context, id UUID, req UpdatePrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	ResetPrivateNetworkFieldFunc                             func(ctx context.Context, id UUID, field ResetPrivateNetworkFieldField) (*Operation, error)
+	AttachInstanceToPrivateNetworkFunc                       func(ctx context.Context, id UUID, req AttachInstanceToPrivateNetworkRequest) (*Operation, error)
+	AttachInstanceToPrivateNetworkAndWaitFunc                func(ctx context.Context, id UUID, req AttachInstanceToPrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	DetachInstanceFromPrivateNetworkFunc                     func(ctx context.Context, id UUID, req DetachInstanceFromPrivateNetworkRequest) (*Operation, error)
+	DetachInstanceFromPrivateNetworkAndWaitFunc              func(ctx context.Context, id UUID, req DetachInstanceFromPrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	UpdatePrivateNetworkInstanceIPFunc                       func(ctx context.Context, id UUID, req UpdatePrivateNetworkInstanceIPRequest) (*Operation, error)
+	UpdatePrivateNetworkInstanceIPAndWaitFunc                func(ctx context.Context, id UUID, req UpdatePrivateNetworkInstanceIPRequest, opts ...WaitOpt) (*PrivateNetwork, error)
+	DeleteReverseDNSElasticIPFunc                            func(ctx context.Context, id UUID) (*Operation, error)
+	GetReverseDNSElasticIPFunc                               func(ctx context.Context, id UUID) (*ReverseDNSRecord, error)
+	UpdateReverseDNSElasticIPFunc                            func(ctx context.Context, id UUID, req UpdateReverseDNSElasticIPRequest) (*Operation, error)
+	DeleteReverseDNSInstanceFunc                             func(ctx context.Context, id UUID) (*Operation, error)
+	GetReverseDNSInstanceFunc                                func(ctx context.Context, id UUID) (*ReverseDNSRecord, error)
+	UpdateReverseDNSInstanceFunc                             func(ctx context.Context, id UUID, req UpdateReverseDNSInstanceRequest) (*Operation, error)
+	ListSecurityGroupsFunc                                   func(ctx context.Context, opts ...ListSecurityGroupsOpt) (*ListSecurityGroupsResponse, error)
+	AllSecurityGroupsFunc                                    func(ctx context.Context, opts ...ListSecurityGroupsOpt) iter.Seq2[SecurityGroup, error]
+	CreateSecurityGroupFunc                                  func(ctx context.Context, req CreateSecurityGroupRequest) (*Operation, error)
+	CreateSecurityGroupAndWaitFunc                           func(ctx context.Context, req CreateSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	DeleteSecurityGroupFunc                                  func(ctx context.Context, id UUID) (*Operation, error)
+	GetSecurityGroupFunc                                     func(ctx context.Context, id UUID) (*SecurityGroup, error)
+	AddRuleToSecurityGroupFunc                               func(ctx context.Context, id UUID, req AddRuleToSecurityGroupRequest) (*Operation, error)
+	DeleteRuleFromSecurityGroupFunc                          func(ctx context.Context, id UUID, ruleID UUID) (*Operation, error)
+	AddExternalSourceToSecurityGroupFunc                     func(ctx context.Context, id UUID, req AddExternalSourceToSecurityGroupRequest) (*Operation, error)
+	AddExternalSourceToSecurityGroupAndWaitFunc              func(ctx context.Context, id UUID, req AddExternalSourceToSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	AttachInstanceToSecurityGroupFunc                        func(ctx context.Context, id UUID, req AttachInstanceToSecurityGroupRequest) (*Operation, error)
+	AttachInstanceToSecurityGroupAndWaitFunc                 func(ctx context.Context, id UUID, req AttachInstanceToSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	DetachInstanceFromSecurityGroupFunc                      func(ctx context.Context, id UUID, req DetachInstanceFromSecurityGroupRequest) (*Operation, error)
+	DetachInstanceFromSecurityGroupAndWaitFunc               func(ctx context.Context, id UUID, req DetachInstanceFromSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	RemoveExternalSourceFromSecurityGroupFunc                func(ctx context.Context, id UUID, req RemoveExternalSourceFromSecurityGroupRequest) (*Operation, error)
+	RemoveExternalSourceFromSecurityGroupAndWaitFunc         func(ctx context.Context, id UUID, req RemoveExternalSourceFromSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error)
+	ListSnapshotsFunc                                        func(ctx context.Context) (*ListSnapshotsResponse, error)
+	AllSnapshotsFunc                                         func(ctx context.Context) iter.Seq2[Snapshot, error]
+	DeleteSnapshotFunc                                       func(ctx context.Context, id UUID) (*Operation, error)
+	GetSnapshotFunc                                          func(ctx context.Context, id UUID) (*Snapshot, error)
+	ExportSnapshotFunc                                       func(ctx context.Context, id UUID) (*Operation, error)
+	ExportSnapshotAndWaitFunc                                func(ctx context.Context, id UUID, opts ...WaitOpt) (*Snapshot, error)
+	PromoteSnapshotToTemplateFunc                            func(ctx context.Context, id UUID, req PromoteSnapshotToTemplateRequest) (*Operation, error)
+	ListSSHKeysFunc                                          func(ctx context.Context) (*ListSSHKeysResponse, error)
+	AllSSHKeysFunc                                           func(ctx context.Context) iter.Seq2[SSHKey, error]
+	RegisterSSHKeyFunc                                       func(ctx context.Context, req RegisterSSHKeyRequest) (*Operation, error)
+	DeleteSSHKeyFunc                                         func(ctx context.Context, name string) (*Operation, error)
+	GetSSHKeyFunc                                            func(ctx context.Context, name string) (*SSHKey, error)
+	ListTemplatesFunc                                        func(ctx context.Context, opts ...ListTemplatesOpt) (*ListTemplatesResponse, error)
+	AllTemplatesFunc                                         func(ctx context.Context, opts ...ListTemplatesOpt) iter.Seq2[Template, error]
+	RegisterTemplateFunc                                     func(ctx context.Context, req RegisterTemplateRequest) (*Operation, error)
+	RegisterTemplateAndWaitFunc                              func(ctx context.Context, req RegisterTemplateRequest, opts ...WaitOpt) (*Template, error)
+	DeleteTemplateFunc                                       func(ctx context.Context, id UUID) (*Operation, error)
+	GetTemplateFunc                                          func(ctx context.Context, id UUID) (*Template, error)
+	CopyTemplateFunc                                         func(ctx context.Context, id UUID, req CopyTemplateRequest) (*Operation, error)
+	UpdateTemplateFunc                                       func(ctx context.Context, id UUID, req UpdateTemplateRequest) (*Operation, error)
+	UpdateTemplateAndWaitFunc                                func(ctx context.Context, id UUID, req UpdateTemplateRequest, opts ...WaitOpt) (*Template, error)
+	GetDBAASCACertificateFunc                                func(ctx context.Context) (*GetDBAASCACertificateResponse, error)
+	DeleteDBAASExternalEndpointDatadogFunc                   func(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointDatadogFunc                      func(ctx context.Context, endpointID UUID) (*DBAASExternalEndpointDatadogOutput, error)
+	UpdateDBAASExternalEndpointDatadogFunc                   func(ctx context.Context, endpointID UUID, req DBAASEndpointDatadogInputUpdate) (*Operation, error)
+	UpdateDBAASExternalEndpointDatadogAndWaitFunc            func(ctx context.Context, endpointID UUID, req DBAASEndpointDatadogInputUpdate, opts ...WaitOpt) (*DBAASExternalEndpointDatadogOutput, error)
+	CreateDBAASExternalEndpointDatadogFunc                   func(ctx context.Context, name string, req DBAASEndpointDatadogInputCreate) (*Operation, error)
+	CreateDBAASExternalEndpointDatadogAndWaitFunc            func(ctx context.Context, name string, req DBAASEndpointDatadogInputCreate, opts ...WaitOpt) (*DBAASExternalEndpointDatadogOutput, error)
+	DeleteDBAASExternalEndpointElasticsearchFunc             func(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointElasticsearchFunc                func(ctx context.Context, endpointID UUID) (*DBAASEndpointElasticsearchOutput, error)
+	UpdateDBAASExternalEndpointElasticsearchFunc             func(ctx context.Context, endpointID UUID, req DBAASEndpointElasticsearchInputUpdate) (*Operation, error)
+	UpdateDBAASExternalEndpointElasticsearchAndWaitFunc      func(ctx context.Context, endpointID UUID, req DBAASEndpointElasticsearchInputUpdate, opts ...WaitOpt) (*DBAASEndpointElasticsearchOutput, error)
+	CreateDBAASExternalEndpointElasticsearchFunc             func(ctx context.Context, name string, req DBAASEndpointElasticsearchInputCreate) (*Operation, error)
+	CreateDBAASExternalEndpointElasticsearchAndWaitFunc      func(ctx context.Context, name string, req DBAASEndpointElasticsearchInputCreate, opts ...WaitOpt) (*DBAASEndpointElasticsearchOutput, error)
+	DeleteDBAASExternalEndpointOpensearchFunc                func(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointOpensearchFunc                   func(ctx context.Context, endpointID UUID) (*DBAASEndpointOpensearchOutput, error)
+	UpdateDBAASExternalEndpointOpensearchFunc                func(ctx context.Context, endpointID UUID, req DBAASEndpointOpensearchInputUpdate) (*Operation, error)
+	UpdateDBAASExternalEndpointOpensearchAndWaitFunc         func(ctx context.Context, endpointID UUID, req DBAASEndpointOpensearchInputUpdate, opts ...WaitOpt) (*DBAASEndpointOpensearchOutput, error)
+	CreateDBAASExternalEndpointOpensearchFunc                func(ctx context.Context, name string, req DBAASEndpointOpensearchInputCreate) (*Operation, error)
+	CreateDBAASExternalEndpointOpensearchAndWaitFunc         func(ctx context.Context, name string, req DBAASEndpointOpensearchInputCreate, opts ...WaitOpt) (*DBAASEndpointOpensearchOutput, error)
+	DeleteDBAASExternalEndpointPrometheusFunc                func(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointPrometheusFunc                   func(ctx context.Context, endpointID UUID) (*DBAASEndpointExternalPrometheusOutput, error)
+	UpdateDBAASExternalEndpointPrometheusFunc                func(ctx context.Context, endpointID UUID, req DBAASEndpointPrometheusPayload) (*Operation, error)
+	UpdateDBAASExternalEndpointPrometheusAndWaitFunc         func(ctx context.Context, endpointID UUID, req DBAASEndpointPrometheusPayload, opts ...WaitOpt) (*DBAASEndpointExternalPrometheusOutput, error)
+	CreateDBAASExternalEndpointPrometheusFunc                func(ctx context.Context, name string, req DBAASEndpointPrometheusPayload) (*Operation, error)
+	CreateDBAASExternalEndpointPrometheusAndWaitFunc         func(ctx context.Context, name string, req DBAASEndpointPrometheusPayload, opts ...WaitOpt) (*DBAASEndpointExternalPrometheusOutput, error)
+	DeleteDBAASExternalEndpointRsyslogFunc                   func(ctx context.Context, endpointID UUID) (*Operation, error)
+	GetDBAASExternalEndpointRsyslogFunc                      func(ctx context.Context, endpointID UUID) (*DBAASExternalEndpointRsyslogOutput, error)
+	UpdateDBAASExternalEndpointRsyslogFunc                   func(ctx context.Context, endpointID UUID, req DBAASEndpointRsyslogInputUpdate) (*Operation, error)
+	UpdateDBAASExternalEndpointRsyslogAndWaitFunc            func(ctx context.Context, endpointID UUID, req DBAASEndpointRsyslogInputUpdate, opts ...WaitOpt) (*DBAASExternalEndpointRsyslogOutput, error)
+	CreateDBAASExternalEndpointRsyslogFunc                   func(ctx context.Context, name string, req DBAASEndpointRsyslogInputCreate) (*Operation, error)
+	CreateDBAASExternalEndpointRsyslogAndWaitFunc            func(ctx context.Context, name string, req DBAASEndpointRsyslogInputCreate, opts ...WaitOpt) (*DBAASExternalEndpointRsyslogOutput, error)
+	ListDBAASExternalEndpointTypesFunc                       func(ctx context.Context) (*ListDBAASExternalEndpointTypesResponse, error)
+	AllDBAASExternalEndpointTypesFunc                        func(ctx context.Context) iter.Seq2[ListDBAASExternalEndpointTypesResponseEndpointTypes, error]
+	AttachDBAASServiceToEndpointFunc                         func(ctx context.Context, sourceServiceName string, req AttachDBAASServiceToEndpointRequest) (*Operation, error)
+	DetachDBAASServiceFromEndpointFunc                       func(ctx context.Context, sourceServiceName string, req DetachDBAASServiceFromEndpointRequest) (*Operation, error)
+	ListDBAASExternalEndpointsFunc                           func(ctx context.Context) (*ListDBAASExternalEndpointsResponse, error)
+	AllDBAASExternalEndpointsFunc                            func(ctx context.Context) iter.Seq2[DBAASExternalEndpoint, error]
+	GetDBAASExternalIntegrationSettingsDatadogFunc           func(ctx context.Context, integrationID UUID) (*GetDBAASExternalIntegrationSettingsDatadogResponse, error)
+	UpdateDBAASExternalIntegrationSettingsDatadogFunc        func(ctx context.Context, integrationID UUID, req UpdateDBAASExternalIntegrationSettingsDatadogRequest) (*Operation, error)
+	UpdateDBAASExternalIntegrationSettingsDatadogAndWaitFunc func(ctx context.Context, integrationID UUID, req UpdateDBAASExternalIntegrationSettingsDatadogRequest, opts ...WaitOpt) (*GetDBAASExternalIntegrationSettingsDatadogResponse, error)
+	GetDBAASExternalIntegrationFunc                          func(ctx context.Context, integrationID UUID) (*DBAASExternalIntegration, error)
+	ListDBAASExternalIntegrationsFunc                        func(ctx context.Context, serviceName string) (*ListDBAASExternalIntegrationsResponse, error)
+	AllDBAASExternalIntegrationsFunc                         func(ctx context.Context, serviceName string) iter.Seq2[DBAASExternalIntegration, error]
+	DeleteDBAASServiceGrafanaFunc                            func(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceGrafanaFunc                               func(ctx context.Context, name string) (*DBAASServiceGrafana, error)
+	CreateDBAASServiceGrafanaFunc                            func(ctx context.Context, name string, req CreateDBAASServiceGrafanaRequest) (*Operation, error)
+	UpdateDBAASServiceGrafanaFunc                            func(ctx context.Context, name string, req UpdateDBAASServiceGrafanaRequest) (*Operation, error)
+	StartDBAASGrafanaMaintenanceFunc                         func(ctx context.Context, name string) (*Operation, error)
+	ResetDBAASGrafanaUserPasswordFunc                        func(ctx context.Context, serviceName string, username string, req ResetDBAASGrafanaUserPasswordRequest) (*Operation, error)
+	RevealDBAASGrafanaUserPasswordFunc                       func(ctx context.Context, serviceName string, username string) (*DBAASUserGrafanaSecrets, error)
+	CreateDBAASIntegrationFunc                               func(ctx context.Context, req CreateDBAASIntegrationRequest) (*Operation, error)
+	CreateDBAASIntegrationAndWaitFunc                        func(ctx context.Context, req CreateDBAASIntegrationRequest, opts ...WaitOpt) (*DBAASIntegration, error)
+	ListDBAASIntegrationSettingsFunc                         func(ctx context.Context, integrationType string, sourceType string, destType string) (*ListDBAASIntegrationSettingsResponse, error)
+	ListDBAASIntegrationTypesFunc                            func(ctx context.Context) (*ListDBAASIntegrationTypesResponse, error)
+	AllDBAASIntegrationTypesFunc                             func(ctx context.Context) iter.Seq2[DBAASIntegrationType, error]
+	DeleteDBAASIntegrationFunc                               func(ctx context.Context, id UUID) (*Operation, error)
+	GetDBAASIntegrationFunc                                  func(ctx context.Context, id UUID) (*DBAASIntegration, error)
+	UpdateDBAASIntegrationFunc                               func(ctx context.Context, id UUID, req UpdateDBAASIntegrationRequest) (*Operation, error)
+	UpdateDBAASIntegrationAndWaitFunc                        func(ctx context.Context, id UUID, req UpdateDBAASIntegrationRequest, opts ...WaitOpt) (*DBAASIntegration, error)
+	DeleteDBAASServiceKafkaFunc                              func(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceKafkaFunc                                 func(ctx context.Context, name string) (*DBAASServiceKafka, error)
+	CreateDBAASServiceKafkaFunc                              func(ctx context.Context, name string, req CreateDBAASServiceKafkaRequest) (*Operation, error)
+	UpdateDBAASServiceKafkaFunc                              func(ctx context.Context, name string, req UpdateDBAASServiceKafkaRequest) (*Operation, error)
+	GetDBAASKafkaAclConfigFunc                               func(ctx context.Context, name string) (*DBAASKafkaAcls, error)
+	StartDBAASKafkaMaintenanceFunc                           func(ctx context.Context, name string) (*Operation, error)
+	CreateDBAASKafkaSchemaRegistryAclConfigFunc              func(ctx context.Context, name string, req DBAASKafkaSchemaRegistryAclEntry) (*Operation, error)
+	DeleteDBAASKafkaSchemaRegistryAclConfigFunc              func(ctx context.Context, name string, aclID string) (*Operation, error)
+	CreateDBAASKafkaTopicAclConfigFunc                       func(ctx context.Context, name string, req DBAASKafkaTopicAclEntry) (*Operation, error)
+	DeleteDBAASKafkaTopicAclConfigFunc                       func(ctx context.Context, name string, aclID string) (*Operation, error)
+	RevealDBAASKafkaConnectPasswordFunc                      func(ctx context.Context, serviceName string) (*DBAASUserKafkaConnectSecrets, error)
+	CreateDBAASKafkaUserFunc                                 func(ctx context.Context, serviceName string, req CreateDBAASKafkaUserRequest) (*Operation, error)
+	DeleteDBAASKafkaUserFunc                                 func(ctx context.Context, serviceName string, username string) (*Operation, error)
+	ResetDBAASKafkaUserPasswordFunc                          func(ctx context.Context, serviceName string, username string, req ResetDBAASKafkaUserPasswordRequest) (*Operation, error)
+	RevealDBAASKafkaUserPasswordFunc                         func(ctx context.Context, serviceName string, username string) (*DBAASUserKafkaSecrets, error)
+	GetDBAASMigrationStatusFunc                              func(ctx context.Context, name string) (*DBAASMigrationStatus, error)
+	DeleteDBAASServiceMysqlFunc                              func(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceMysqlFunc                                 func(ctx context.Context, name string) (*DBAASServiceMysql, error)
+	CreateDBAASServiceMysqlFunc                              func(ctx context.Context, name string, req CreateDBAASServiceMysqlRequest) (*Operation, error)
+	UpdateDBAASServiceMysqlFunc                              func(ctx context.Context, name string, req UpdateDBAASServiceMysqlRequest) (*Operation, error)
+	EnableDBAASMysqlWritesFunc                               func(ctx context.Context, name string) (*Operation, error)
+	StartDBAASMysqlMaintenanceFunc                           func(ctx context.Context, name string) (*Operation, error)
+	StopDBAASMysqlMigrationFunc                              func(ctx context.Context, name string) (*Operation, error)
+	CreateDBAASMysqlDatabaseFunc                             func(ctx context.Context, serviceName string, req CreateDBAASMysqlDatabaseRequest) (*Operation, error)
+	DeleteDBAASMysqlDatabaseFunc                             func(ctx context.Context, serviceName string, databaseName string) (*Operation, error)
+	CreateDBAASMysqlUserFunc                                 func(ctx context.Context, serviceName string, req CreateDBAASMysqlUserRequest) (*Operation, error)
+	DeleteDBAASMysqlUserFunc                                 func(ctx context.Context, serviceName string, username string) (*Operation, error)
+	ResetDBAASMysqlUserPasswordFunc                          func(ctx context.Context, serviceName string, username string, req ResetDBAASMysqlUserPasswordRequest) (*Operation, error)
+	RevealDBAASMysqlUserPasswordFunc                         func(ctx context.Context, serviceName string, username string) (*DBAASUserMysqlSecrets, error)
+	DeleteDBAASServiceOpensearchFunc                         func(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceOpensearchFunc                            func(ctx context.Context, name string) (*DBAASServiceOpensearch, error)
+	CreateDBAASServiceOpensearchFunc                         func(ctx context.Context, name string, req CreateDBAASServiceOpensearchRequest) (*Operation, error)
+	UpdateDBAASServiceOpensearchFunc                         func(ctx context.Context, name string, req UpdateDBAASServiceOpensearchRequest) (*Operation, error)
+	GetDBAASOpensearchAclConfigFunc                          func(ctx context.Context, name string) (*DBAASOpensearchAclConfig, error)
+	UpdateDBAASOpensearchAclConfigFunc                       func(ctx context.Context, name string, req DBAASOpensearchAclConfig) (*Operation, error)
+	StartDBAASOpensearchMaintenanceFunc                      func(ctx context.Context, name string) (*Operation, error)
+	CreateDBAASOpensearchUserFunc                            func(ctx context.Context, serviceName string, req CreateDBAASOpensearchUserRequest) (*Operation, error)
+	DeleteDBAASOpensearchUserFunc                            func(ctx context.Context, serviceName string, username string) (*Operation, error)
+	ResetDBAASOpensearchUserPasswordFunc                     func(ctx context.Context, serviceName string, username string, req ResetDBAASOpensearchUserPasswordRequest) (*Operation, error)
+	RevealDBAASOpensearchUserPasswordFunc                    func(ctx context.Context, serviceName string, username string) (*DBAASUserOpensearchSecrets, error)
+	DeleteDBAASServicePGFunc                                 func(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServicePGFunc                                    func(ctx context.Context, name string) (*DBAASServicePG, error)
+	CreateDBAASServicePGFunc                                 func(ctx context.Context, name string, req CreateDBAASServicePGRequest) (*Operation, error)
+	UpdateDBAASServicePGFunc                                 func(ctx context.Context, name string, req UpdateDBAASServicePGRequest) (*Operation, error)
+	StartDBAASPGMaintenanceFunc                              func(ctx context.Context, name string) (*Operation, error)
+	StopDBAASPGMigrationFunc                                 func(ctx context.Context, name string) (*Operation, error)
+	CreateDBAASPGConnectionPoolFunc                          func(ctx context.Context, serviceName string, req CreateDBAASPGConnectionPoolRequest) (*Operation, error)
+	DeleteDBAASPGConnectionPoolFunc                          func(ctx context.Context, serviceName string, connectionPoolName string) (*Operation, error)
+	UpdateDBAASPGConnectionPoolFunc                          func(ctx context.Context, serviceName string, connectionPoolName string, req UpdateDBAASPGConnectionPoolRequest) (*Operation, error)
+	CreateDBAASPGDatabaseFunc                                func(ctx context.Context, serviceName string, req CreateDBAASPGDatabaseRequest) (*Operation, error)
+	DeleteDBAASPGDatabaseFunc                                func(ctx context.Context, serviceName string, databaseName string) (*Operation, error)
+	CreateDBAASPostgresUserFunc                              func(ctx context.Context, serviceName string, req CreateDBAASPostgresUserRequest) (*Operation, error)
+	DeleteDBAASPostgresUserFunc                              func(ctx context.Context, serviceName string, username string) (*Operation, error)
+	UpdateDBAASPostgresAllowReplicationFunc                  func(ctx context.Context, serviceName string, username string, req UpdateDBAASPostgresAllowReplicationRequest) (*DBAASPostgresUsers, error)
+	ResetDBAASPostgresUserPasswordFunc                       func(ctx context.Context, serviceName string, username string, req ResetDBAASPostgresUserPasswordRequest) (*Operation, error)
+	RevealDBAASPostgresUserPasswordFunc                      func(ctx context.Context, serviceName string, username string) (*DBAASUserPostgresSecrets, error)
+	CreateDBAASPGUpgradeCheckFunc                            func(ctx context.Context, service string, req CreateDBAASPGUpgradeCheckRequest) (*DBAASTask, error)
+	ListDBAASServicesFunc                                    func(ctx context.Context) (*ListDBAASServicesResponse, error)
+	AllDBAASServicesFunc                                     func(ctx context.Context) iter.Seq2[DBAASServiceCommon, error]
+	GetDBAASServiceLogsFunc                                  func(ctx context.Context, serviceName string, req GetDBAASServiceLogsRequest) (*DBAASServiceLogs, error)
+	GetDBAASServiceMetricsFunc                               func(ctx context.Context, serviceName string, req GetDBAASServiceMetricsRequest) (*GetDBAASServiceMetricsResponse, error)
+	ListDBAASServiceTypesFunc                                func(ctx context.Context) (*ListDBAASServiceTypesResponse, error)
+	AllDBAASServiceTypesFunc                                 func(ctx context.Context) iter.Seq2[DBAASServiceType, error]
+	GetDBAASServiceTypeFunc                                  func(ctx context.Context, serviceTypeName string) (*DBAASServiceType, error)
+	DeleteDBAASServiceFunc                                   func(ctx context.Context, name string) (*Operation, error)
+	GetDBAASSettingsGrafanaFunc                              func(ctx context.Context) (*GetDBAASSettingsGrafanaResponse, error)
+	GetDBAASSettingsKafkaFunc                                func(ctx context.Context) (*GetDBAASSettingsKafkaResponse, error)
+	GetDBAASSettingsMysqlFunc                                func(ctx context.Context) (*GetDBAASSettingsMysqlResponse, error)
+	GetDBAASSettingsOpensearchFunc                           func(ctx context.Context) (*GetDBAASSettingsOpensearchResponse, error)
+	GetDBAASSettingsPGFunc                                   func(ctx context.Context) (*GetDBAASSettingsPGResponse, error)
+	GetDBAASSettingsThanosFunc                               func(ctx context.Context) (*GetDBAASSettingsThanosResponse, error)
+	GetDBAASSettingsValkeyFunc                               func(ctx context.Context) (*GetDBAASSettingsValkeyResponse, error)
+	CreateDBAASTaskMigrationCheckFunc                        func(ctx context.Context, service string, req CreateDBAASTaskMigrationCheckRequest) (*Operation, error)
+	GetDBAASTaskFunc                                         func(ctx context.Context, service string, id UUID) (*DBAASTask, error)
+	DeleteDBAASServiceThanosFunc                             func(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceThanosFunc                                func(ctx context.Context, name string) (*DBAASServiceThanos, error)
+	CreateDBAASServiceThanosFunc                             func(ctx context.Context, name string, req CreateDBAASServiceThanosRequest) (*Operation, error)
+	UpdateDBAASServiceThanosFunc                             func(ctx context.Context, name string, req UpdateDBAASServiceThanosRequest) (*Operation, error)
+	StartDBAASThanosMaintenanceFunc                          func(ctx context.Context, name string) (*Operation, error)
+	RevealDBAASThanosUserPasswordFunc                        func(ctx context.Context, serviceName string, username string) (*DBAASUserThanosSecrets, error)
+	DeleteDBAASServiceValkeyFunc                             func(ctx context.Context, name string) (*Operation, error)
+	GetDBAASServiceValkeyFunc                                func(ctx context.Context, name string) (*DBAASServiceValkey, error)
+	CreateDBAASServiceValkeyFunc                             func(ctx context.Context, name string, req CreateDBAASServiceValkeyRequest) (*Operation, error)
+	UpdateDBAASServiceValkeyFunc                             func(ctx context.Context, name string, req UpdateDBAASServiceValkeyRequest) (*Operation, error)
+	StartDBAASValkeyMaintenanceFunc                          func(ctx context.Context, name string) (*Operation, error)
+	StopDBAASValkeyMigrationFunc                             func(ctx context.Context, name string) (*Operation, error)
+	ListDBAASValkeyUsersFunc                                 func(ctx context.Context, serviceName string) (*DBAASValkeyUsers, error)
+	AllDBAASValkeyUsersFunc                                  func(ctx context.Context, serviceName string) iter.Seq2[DBAASValkeyUser, error]
+	CreateDBAASValkeyUserFunc                                func(ctx context.Context, serviceName string, req CreateDBAASValkeyUserRequest) (*Operation, error)
+	DeleteDBAASValkeyUserFunc                                func(ctx context.Context, serviceName string, username string) (*Operation, error)
+	UpdateDBAASValkeyUserAccessControlFunc                   func(ctx context.Context, serviceName string, username string, req UpdateDBAASValkeyUserAccessControlRequest) (*Operation, error)
+	ResetDBAASValkeyUserPasswordFunc                         func(ctx context.Context, serviceName string, username string, req ResetDBAASValkeyUserPasswordRequest) (*Operation, error)
+	RevealDBAASValkeyUserPasswordFunc                        func(ctx context.Context, serviceName string, username string) (*DBAASUserValkeySecrets, error)
+	ListDNSDomainsFunc                                       func(ctx context.Context) (*ListDNSDomainsResponse, error)
+	AllDNSDomainsFunc                                        func(ctx context.Context) iter.Seq2[DNSDomain, error]
+	CreateDNSDomainFunc                                      func(ctx context.Context, req CreateDNSDomainRequest) (*Operation, error)
+	CreateDNSDomainAndWaitFunc                               func(ctx context.Context, req CreateDNSDomainRequest, opts ...WaitOpt) (*DNSDomain, error)
+	ListDNSDomainRecordsFunc                                 func(ctx context.Context, domainID UUID) (*ListDNSDomainRecordsResponse, error)
+	AllDNSDomainRecordsFunc                                  func(ctx context.Context, domainID UUID) iter.Seq2[DNSDomainRecord, error]
+	CreateDNSDomainRecordFunc                                func(ctx context.Context, domainID UUID, req CreateDNSDomainRecordRequest) (*Operation, error)
+	DeleteDNSDomainRecordFunc                                func(ctx context.Context, domainID UUID, recordID UUID) (*Operation, error)
+	GetDNSDomainRecordFunc                                   func(ctx context.Context, domainID UUID, recordID UUID) (*DNSDomainRecord, error)
+	UpdateDNSDomainRecordFunc                                func(ctx context.Context, domainID UUID, recordID UUID, req UpdateDNSDomainRecordRequest) (*Operation, error)
+	DeleteDNSDomainFunc                                      func(ctx context.Context, id UUID) (*Operation, error)
+	GetDNSDomainFunc                                         func(ctx context.Context, id UUID) (*DNSDomain, error)
+	GetDNSDomainZoneFileFunc                                 func(ctx context.Context, id UUID) (*GetDNSDomainZoneFileResponse, error)
+	ListAPIKeysFunc                                          func(ctx context.Context) (*ListAPIKeysResponse, error)
+	AllAPIKeysFunc                                           func(ctx context.Context) iter.Seq2[IAMAPIKey, error]
+	CreateAPIKeyFunc                                         func(ctx context.Context, req CreateAPIKeyRequest) (*IAMAPIKeyCreated, error)
+	DeleteAPIKeyFunc                                         func(ctx context.Context, id string) (*Operation, error)
+	GetAPIKeyFunc                                            func(ctx context.Context, id string) (*IAMAPIKey, error)
+	GetIAMOrganizationPolicyFunc                             func(ctx context.Context) (*IAMPolicy, error)
+	UpdateIAMOrganizationPolicyFunc                          func(ctx context.Context, req IAMPolicy) (*Operation, error)
+	ResetIAMOrganizationPolicyFunc                           func(ctx context.Context) (*Operation, error)
+	ListIAMRolesFunc                                         func(ctx context.Context) (*ListIAMRolesResponse, error)
+	AllIAMRolesFunc                                          func(ctx context.Context) iter.Seq2[IAMRole, error]
+	CreateIAMRoleFunc                                        func(ctx context.Context, req CreateIAMRoleRequest) (*Operation, error)
+	CreateIAMRoleAndWaitFunc                                 func(ctx context.Context, req CreateIAMRoleRequest, opts ...WaitOpt) (*IAMRole, error)
+	DeleteIAMRoleFunc                                        func(ctx context.Context, id UUID) (*Operation, error)
+	GetIAMRoleFunc                                           func(ctx context.Context, id UUID) (*IAMRole, error)
+	UpdateIAMRoleFunc                                        func(ctx context.Context, id UUID, req UpdateIAMRoleRequest) (*Operation, error)
+	UpdateIAMRoleAndWaitFunc                                 func(ctx context.Context, id UUID, req UpdateIAMRoleRequest, opts ...WaitOpt) (*IAMRole, error)
+	UpdateIAMRoleAssumePolicyFunc                            func(ctx context.Context, id UUID, req IAMPolicy) (*Operation, error)
+	UpdateIAMRoleAssumePolicyAndWaitFunc                     func(ctx context.Context, id UUID, req IAMPolicy, opts ...WaitOpt) (*IAMRole, error)
+	UpdateIAMRolePolicyFunc                                  func(ctx context.Context, id UUID, req IAMPolicy) (*Operation, error)
+	UpdateIAMRolePolicyAndWaitFunc                           func(ctx context.Context, id UUID, req IAMPolicy, opts ...WaitOpt) (*IAMRole, error)
+	AssumeIAMRoleFunc                                        func(ctx context.Context, targetRoleID UUID, req AssumeIAMRoleRequest) (*AssumeIAMRoleResponse, error)
+	ListUsersFunc                                            func(ctx context.Context) (*ListUsersResponse, error)
+	AllUsersFunc                                             func(ctx context.Context) iter.Seq2[User, error]
+	CreateUserFunc                                           func(ctx context.Context, req CreateUserRequest) (*Operation, error)
+	DeleteUserFunc                                           func(ctx context.Context, id UUID) (*Operation, error)
+	UpdateUserRoleFunc                                       func(ctx context.Context, id UUID, req UpdateUserRoleRequest) (*Operation, error)
+	ListKmsKeysFunc                                          func(ctx context.Context) (*ListKmsKeysResponse, error)
+	AllKmsKeysFunc                                           func(ctx context.Context) iter.Seq2[ListKmsKeysResponseEntry, error]
+	CreateKmsKeyFunc                                         func(ctx context.Context, req CreateKmsKeyRequest) (*CreateKmsKeyResponse, error)
+	GetKmsKeyFunc                                            func(ctx context.Context, id UUID) (*GetKmsKeyResponse, error)
+	CancelKmsKeyDeletionFunc                                 func(ctx context.Context, id UUID) (*SuccessResponse, error)
+	DecryptFunc                                              func(ctx context.Context, id UUID, req DecryptRequest) (*DecryptResponse, error)
+	DisableKmsKeyFunc                                        func(ctx context.Context, id UUID) (*SuccessResponse, error)
+	DisableKmsKeyRotationFunc                                func(ctx context.Context, id UUID) (*DisableKmsKeyRotationResponse, error)
+	EnableKmsKeyFunc                                         func(ctx context.Context, id UUID) (*SuccessResponse, error)
+	EnableKmsKeyRotationFunc                                 func(ctx context.Context, id UUID, req EnableKmsKeyRotationRequest) (*EnableKmsKeyRotationResponse, error)
+	EncryptFunc                                              func(ctx context.Context, id UUID, req EncryptRequest) (*EncryptResponse, error)
+	GenerateDataKeyFunc                                      func(ctx context.Context, id UUID, req GenerateDataKeyRequest) (*GenerateDataKeyResponse, error)
+	ListKmsKeyRotationsFunc                                  func(ctx context.Context, id UUID) (*ListKmsKeyRotationsResponse, error)
+	AllKmsKeyRotationsFunc                                   func(ctx context.Context, id UUID) iter.Seq2[ListKmsKeyRotationsResponseEntry, error]
+	ReEncryptFunc                                            func(ctx context.Context, id UUID, req ReEncryptRequest) (*ReEncryptResponse, error)
+	ReplicateKmsKeyFunc                                      func(ctx context.Context, id UUID, req ReplicateKmsKeyRequest) (*SuccessResponse, error)
+	RotateKmsKeyFunc                                         func(ctx context.Context, id UUID) (*RotateKmsKeyResponse, error)
+	ScheduleKmsKeyDeletionFunc                               func(ctx context.Context, id UUID, req ScheduleKmsKeyDeletionRequest) (*SuccessResponse, error)
+	ListSKSClustersFunc                                      func(ctx context.Context) (*ListSKSClustersResponse, error)
+	AllSKSClustersFunc                                       func(ctx context.Context) iter.Seq2[SKSCluster, error]
+	CreateSKSClusterFunc                                     func(ctx context.Context, req CreateSKSClusterRequest) (*Operation, error)
+	CreateSKSClusterAndWaitFunc                              func(ctx context.Context, req CreateSKSClusterRequest, opts ...WaitOpt) (*SKSCluster, error)
+	ListSKSClusterDeprecatedResourcesFunc                    func(ctx context.Context, id UUID) ([]SKSClusterDeprecatedResource, error)
+	AllSKSClusterDeprecatedResourcesFunc                     func(ctx context.Context, id UUID) iter.Seq2[SKSClusterDeprecatedResource, error]
+	GenerateSKSClusterKubeconfigFunc                         func(ctx context.Context, id UUID, req SKSKubeconfigRequest) (*GenerateSKSClusterKubeconfigResponse, error)
+	ListSKSClusterVersionsFunc                               func(ctx context.Context, opts ...ListSKSClusterVersionsOpt) (*ListSKSClusterVersionsResponse, error)
+	AllSKSClusterVersionsFunc                                func(ctx context.Context, opts ...ListSKSClusterVersionsOpt) iter.Seq2[string, error]
+	DeleteSKSClusterFunc                                     func(ctx context.Context, id UUID) (*Operation, error)
+	GetSKSClusterFunc                                        func(ctx context.Context, id UUID) (*SKSCluster, error)
+	UpdateSKSClusterFunc                                     func(ctx context.Context, id UUID, req UpdateSKSClusterRequest) (*Operation, error)
+	UpdateSKSClusterAndWaitFunc                              func(ctx context.Context, id UUID, req UpdateSKSClusterRequest, opts ...WaitOpt) (*SKSCluster, error)
+	GetSKSClusterAuthorityCertFunc                           func(ctx context.Context, id UUID, authority GetSKSClusterAuthorityCertAuthority) (*GetSKSClusterAuthorityCertResponse, error)
+	GetSKSClusterInspectionFunc                              func(ctx context.Context, id UUID) (*GetSKSClusterInspectionResponse, error)
+	CreateSKSNodepoolFunc                                    func(ctx context.Context, id UUID, req CreateSKSNodepoolRequest) (*Operation, error)
+	DeleteSKSNodepoolFunc                                    func(ctx context.Context, id UUID, sksNodepoolID UUID) (*Operation, error)
+	GetSKSNodepoolFunc                                       func(ctx context.Context, id UUID, sksNodepoolID UUID) (*SKSNodepool, error)
+	UpdateSKSNodepoolFunc                                    func(ctx context.Context, id UUID, sksNodepoolID UUID, req UpdateSKSNodepoolRequest) (*Operation, error)
+	EvictSKSNodepoolMembersFunc                              func(ctx context.Context, id UUID, sksNodepoolID UUID, req EvictSKSNodepoolMembersRequest) (*Operation, error)
+	ScaleSKSNodepoolFunc                                     func(ctx context.Context, id UUID, sksNodepoolID UUID, req ScaleSKSNodepoolRequest) (*Operation, error)
+	RotateSKSCcmCredentialsFunc                              func(ctx context.Context, id UUID) (*Operation, error)
+	RotateSKSCsiCredentialsFunc                              func(ctx context.Context, id UUID) (*Operation, error)
+	RotateSKSKarpenterCredentialsFunc                        func(ctx context.Context, id UUID) (*Operation, error)
+	RotateSKSOperatorsCAFunc                                 func(ctx context.Context, id UUID) (*Operation, error)
+	UpgradeSKSClusterFunc                                    func(ctx context.Context, id UUID, req UpgradeSKSClusterRequest) (*Operation, error)
+	UpgradeSKSClusterServiceLevelFunc                        func(ctx context.Context, id UUID) (*Operation, error)
+	GetActiveNodepoolTemplateFunc                            func(ctx context.Context, kubeVersion string, variant GetActiveNodepoolTemplateVariant) (*GetActiveNodepoolTemplateResponse, error)
+	ListAIAPIKeysFunc                                        func(ctx context.Context) (*ListAIAPIKeysResponse, error)
+	AllAIAPIKeysFunc                                         func(ctx context.Context) iter.Seq2[AIAPIKey, error]
+	CreateAIAPIKeyFunc                                       func(ctx context.Context, req CreateAIAPIKeyRequest) (*AIAPIKeyWithValue, error)
+	DeleteAIAPIKeyFunc                                       func(ctx context.Context, id UUID) (*DeleteAIAPIKeyResponse, error)
+	GetAIAPIKeyFunc                                          func(ctx context.Context, id UUID) (*AIAPIKey, error)
+	UpdateAIAPIKeyFunc                                       func(ctx context.Context, id UUID, req UpdateAIAPIKeyRequest) (*AIAPIKey, error)
+	RotateAIAPIKeyFunc                                       func(ctx context.Context, id UUID) (*AIAPIKeyWithValue, error)
+	ListDeploymentsFunc                                      func(ctx context.Context, opts ...ListDeploymentsOpt) (*ListDeploymentsResponse, error)
+	AllDeploymentsFunc                                       func(ctx context.Context, opts ...ListDeploymentsOpt) iter.Seq2[ListDeploymentsResponseEntry, error]
+	CreateDeploymentFunc                                     func(ctx context.Context, req CreateDeploymentRequest) (*Operation, error)
+	DeleteDeploymentFunc                                     func(ctx context.Context, id UUID) (*Operation, error)
+	GetDeploymentFunc                                        func(ctx context.Context, id UUID) (*GetDeploymentResponse, error)
+	UpdateDeploymentFunc                                     func(ctx context.Context, id UUID, req UpdateDeploymentRequest) (*Operation, error)
+	RevealDeploymentAPIKeyFunc                               func(ctx context.Context, id UUID) (*RevealDeploymentAPIKeyResponse, error)
+	GetDeploymentLogsFunc                                    func(ctx context.Context, id UUID, opts ...GetDeploymentLogsOpt) (*GetDeploymentLogsResponse, error)
+	ScaleDeploymentFunc                                      func(ctx context.Context, id UUID, req ScaleDeploymentRequest) (*Operation, error)
+	GetInferenceEngineHelpFunc                               func(ctx context.Context, opts ...GetInferenceEngineHelpOpt) (*GetInferenceEngineHelpResponse, error)
+	ListAIInstanceTypesFunc                                  func(ctx context.Context) (*ListAIInstanceTypesResponse, error)
+	AllAIInstanceTypesFunc                                   func(ctx context.Context) iter.Seq2[InstanceTypeEntry, error]
+	ListModelsFunc                                           func(ctx context.Context, opts ...ListModelsOpt) (*ListModelsResponse, error)
+	AllModelsFunc                                            func(ctx context.Context, opts ...ListModelsOpt) iter.Seq2[ListModelsResponseEntry, error]
+	CreateModelFunc                                          func(ctx context.Context, req CreateModelRequest) (*Operation, error)
+	DeleteModelFunc                                          func(ctx context.Context, id UUID) (*Operation, error)
+	GetModelFunc                                             func(ctx context.Context, id UUID) (*GetModelResponse, error)
+	GetEnvImpactFunc                                         func(ctx context.Context, period string) (*EnvImpactReport, error)
+	ListEventsFunc                                           func(ctx context.Context, opts ...ListEventsOpt) ([]Event, error)
+	AllEventsFunc                                            func(ctx context.Context, opts ...ListEventsOpt) iter.Seq2[Event, error]
+	GetOperationFunc                                         func(ctx context.Context, id UUID) (*Operation, error)
+	GetOrganizationFunc                                      func(ctx context.Context) (*Organization, error)
+	ListQuotasFunc                                           func(ctx context.Context) (*ListQuotasResponse, error)
+	AllQuotasFunc                                            func(ctx context.Context) iter.Seq2[Quota, error]
+	GetQuotaFunc                                             func(ctx context.Context, entity string) (*Quota, error)
+	ListSOSBucketsUsageFunc                                  func(ctx context.Context) (*ListSOSBucketsUsageResponse, error)
+	AllSOSBucketsUsageFunc                                   func(ctx context.Context) iter.Seq2[SOSBucketUsage, error]
+	GetSOSPresignedURLFunc                                   func(ctx context.Context, bucket string, opts ...GetSOSPresignedURLOpt) (*GetSOSPresignedURLResponse, error)
+	GetUsageReportFunc                                       func(ctx context.Context, opts ...GetUsageReportOpt) (*GetUsageReportResponse, error)
+	ListZonesFunc                                            func(ctx context.Context) (*ListZonesResponse, error)
+	AllZonesFunc                                             func(ctx context.Context) iter.Seq2[Zone, error]
+	WaitFunc                                                 func(ctx context.Context, op *Operation, states ...OperationState) (*Operation, error)
+	WaitWithOptsFunc                                         func(ctx context.Context, op *Operation, opts ...WaitOpt) (*Operation, error)
+	WaitAllFunc                                              func(ctx context.Context, ops ...*Operation) ([]WaitResult, error)
+	WaitAllWithOptsFunc                                      func(ctx context.Context, ops []*Operation, opts ...WaitOpt) ([]WaitResult, error)
+	WaitAnyFunc                                              func(ctx context.Context, ops ...*Operation) (int, *Operation, error)
+	WaitAnyWithOptsFunc                                      func(ctx context.Context, ops []*Operation, opts ...WaitOpt) (int, *Operation, error)
+	GetZoneNameFunc                                          func(ctx context.Context, endpoint Endpoint) (ZoneName, error)
+	GetZoneAPIEndpointFunc                                   func(ctx context.Context, zoneName ZoneName) (Endpoint, error)
+}
+
+var _ ClientAPI = (*MockClient)(nil)
+
+func (mc *MockClient) ListAntiAffinityGroups(ctx context.Context) (*ListAntiAffinityGroupsResponse, error) {
+	if mc.ListAntiAffinityGroupsFunc == nil {
+		panic("MockClient: ListAntiAffinityGroups is not implemented")
+	}
+
+	return mc.ListAntiAffinityGroupsFunc(ctx)
+}
+
+func (mc *MockClient) AllAntiAffinityGroups(ctx context.Context) iter.Seq2[AntiAffinityGroup, error] {
+	if mc.AllAntiAffinityGroupsFunc == nil {
+		panic("MockClient: AllAntiAffinityGroups is not implemented")
+	}
+
+	return mc.AllAntiAffinityGroupsFunc(ctx)
+}
+
+func (mc *MockClient) CreateAntiAffinityGroup(ctx context.Context, req CreateAntiAffinityGroupRequest) (*Operation, error) {
+	if mc.CreateAntiAffinityGroupFunc == nil {
+		panic("MockClient: CreateAntiAffinityGroup is not implemented")
+	}
+
+	return mc.CreateAntiAffinityGroupFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateAntiAffinityGroupAndWait(ctx context.Context, req CreateAntiAffinityGroupRequest, opts ...WaitOpt) (*AntiAffinityGroup, error) {
+	if mc.CreateAntiAffinityGroupAndWaitFunc == nil {
+		panic("MockClient: CreateAntiAffinityGroupAndWait is not implemented")
+	}
+
+	return mc.CreateAntiAffinityGroupAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) DeleteAntiAffinityGroup(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteAntiAffinityGroupFunc == nil {
+		panic("MockClient: DeleteAntiAffinityGroup is not implemented")
+	}
+
+	return mc.DeleteAntiAffinityGroupFunc(ctx, id)
+}
+
+func (mc *MockClient) GetAntiAffinityGroup(ctx context.Context, id UUID) (*AntiAffinityGroup, error) {
+	if mc.GetAntiAffinityGroupFunc == nil {
+		panic("MockClient: GetAntiAffinityGroup is not implemented")
+	}
+
+	return mc.GetAntiAffinityGroupFunc(ctx, id)
+}
+
+func (mc *MockClient) ListBlockStorageVolumes(ctx context.Context, opts ...ListBlockStorageVolumesOpt) (*ListBlockStorageVolumesResponse, error) {
+	if mc.ListBlockStorageVolumesFunc == nil {
+		panic("MockClient: ListBlockStorageVolumes is not implemented")
+	}
+
+	return mc.ListBlockStorageVolumesFunc(ctx, opts...)
+}
+
+func (mc *MockClient) AllBlockStorageVolumes(ctx context.Context, opts ...ListBlockStorageVolumesOpt) iter.Seq2[BlockStorageVolume, error] {
+	if mc.AllBlockStorageVolumesFunc == nil {
+		panic("MockClient: AllBlockStorageVolumes is not implemented")
+	}
+
+	return mc.AllBlockStorageVolumesFunc(ctx, opts...)
+}
+
+func (mc *MockClient) CreateBlockStorageVolume(ctx context.Context, req CreateBlockStorageVolumeRequest) (*Operation, error) {
+	if mc.CreateBlockStorageVolumeFunc == nil {
+		panic("MockClient: CreateBlockStorageVolume is not implemented")
+	}
+
+	return mc.CreateBlockStorageVolumeFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateBlockStorageVolumeAndWait(ctx context.Context, req CreateBlockStorageVolumeRequest, opts ...WaitOpt) (*BlockStorageVolume, error) {
+	if mc.CreateBlockStorageVolumeAndWaitFunc == nil {
+		panic("MockClient: CreateBlockStorageVolumeAndWait is not implemented")
+	}
+
+	return mc.CreateBlockStorageVolumeAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) ListBlockStorageSnapshots(ctx context.Context) (*ListBlockStorageSnapshotsResponse, error) {
+	if mc.ListBlockStorageSnapshotsFunc == nil {
+		panic("MockClient: ListBlockStorageSnapshots is not implemented")
+	}
+
+	return mc.ListBlockStorageSnapshotsFunc(ctx)
+}
+
+func (mc *MockClient) AllBlockStorageSnapshots(ctx context.Context) iter.Seq2[BlockStorageSnapshot, error] {
+	if mc.AllBlockStorageSnapshotsFunc == nil {
+		panic("MockClient: AllBlockStorageSnapshots is not implemented")
+	}
+
+	return mc.AllBlockStorageSnapshotsFunc(ctx)
+}
+
+func (mc *MockClient) DeleteBlockStorageSnapshot(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteBlockStorageSnapshotFunc == nil {
+		panic("MockClient: DeleteBlockStorageSnapshot is not implemented")
+	}
+
+	return mc.DeleteBlockStorageSnapshotFunc(ctx, id)
+}
+
+func (mc *MockClient) GetBlockStorageSnapshot(ctx context.Context, id UUID) (*BlockStorageSnapshot, error) {
+	if mc.GetBlockStorageSnapshotFunc == nil {
+		panic("MockClient: GetBlockStorageSnapshot is not implemented")
+	}
+
+	return mc.GetBlockStorageSnapshotFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateBlockStorageSnapshot(ctx context.Context, id UUID, req UpdateBlockStorageSnapshotRequest) (*Operation, error) {
+	if mc.UpdateBlockStorageSnapshotFunc == nil {
+		panic("MockClient: UpdateBlockStorageSnapshot is not implemented")
+	}
+
+	return mc.UpdateBlockStorageSnapshotFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateBlockStorageSnapshotAndWait(ctx context.Context, id UUID, req UpdateBlockStorageSnapshotRequest, opts ...WaitOpt) (*BlockStorageSnapshot, error) {
+	if mc.UpdateBlockStorageSnapshotAndWaitFunc == nil {
+		panic("MockClient: UpdateBlockStorageSnapshotAndWait is not implemented")
+	}
+
+	return mc.UpdateBlockStorageSnapshotAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) DeleteBlockStorageVolume(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteBlockStorageVolumeFunc == nil {
+		panic("MockClient: DeleteBlockStorageVolume is not implemented")
+	}
+
+	return mc.DeleteBlockStorageVolumeFunc(ctx, id)
+}
+
+func (mc *MockClient) GetBlockStorageVolume(ctx context.Context, id UUID) (*BlockStorageVolume, error) {
+	if mc.GetBlockStorageVolumeFunc == nil {
+		panic("MockClient: GetBlockStorageVolume is not implemented")
+	}
+
+	return mc.GetBlockStorageVolumeFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateBlockStorageVolume(ctx context.Context, id UUID, req UpdateBlockStorageVolumeRequest) (*Operation, error) {
+	if mc.UpdateBlockStorageVolumeFunc == nil {
+		panic("MockClient: UpdateBlockStorageVolume is not implemented")
+	}
+
+	return mc.UpdateBlockStorageVolumeFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateBlockStorageVolumeAndWait(ctx context.Context, id UUID, req UpdateBlockStorageVolumeRequest, opts ...WaitOpt) (*BlockStorageVolume, error) {
+	if mc.UpdateBlockStorageVolumeAndWaitFunc == nil {
+		panic("MockClient: UpdateBlockStorageVolumeAndWait is not implemented")
+	}
+
+	return mc.UpdateBlockStorageVolumeAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) AttachBlockStorageVolumeToInstance(ctx context.Context, id UUID, req AttachBlockStorageVolumeToInstanceRequest) (*Operation, error) {
+	if mc.AttachBlockStorageVolumeToInstanceFunc == nil {
+		panic("MockClient: AttachBlockStorageVolumeToInstance is not implemented")
+	}
+
+	return mc.AttachBlockStorageVolumeToInstanceFunc(ctx, id, req)
+}
+
+func (mc *MockClient) AttachBlockStorageVolumeToInstanceAndWait(ctx context.Context, id UUID, req AttachBlockStorageVolumeToInstanceRequest, opts ...WaitOpt) (*BlockStorageVolume, error) {
+	if mc.AttachBlockStorageVolumeToInstanceAndWaitFunc == nil {
+		panic("MockClient: AttachBlockStorageVolumeToInstanceAndWait is not implemented")
+	}
+
+	return mc.AttachBlockStorageVolumeToInstanceAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) CreateBlockStorageSnapshot(ctx context.Context, id UUID, req CreateBlockStorageSnapshotRequest) (*Operation, error) {
+	if mc.CreateBlockStorageSnapshotFunc == nil {
+		panic("MockClient: CreateBlockStorageSnapshot is not implemented")
+	}
+
+	return mc.CreateBlockStorageSnapshotFunc(ctx, id, req)
+}
+
+func (mc *MockClient) DetachBlockStorageVolume(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DetachBlockStorageVolumeFunc == nil {
+		panic("MockClient: DetachBlockStorageVolume is not implemented")
+	}
+
+	return mc.DetachBlockStorageVolumeFunc(ctx, id)
+}
+
+func (mc *MockClient) DetachBlockStorageVolumeAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*BlockStorageVolume, error) {
+	if mc.DetachBlockStorageVolumeAndWaitFunc == nil {
+		panic("MockClient: DetachBlockStorageVolumeAndWait is not implemented")
+	}
+
+	return mc.DetachBlockStorageVolumeAndWaitFunc(ctx, id, opts...)
+}
+
+func (mc *MockClient) ResizeBlockStorageVolume(ctx context.Context, id UUID, req ResizeBlockStorageVolumeRequest) (*BlockStorageVolume, error) {
+	if mc.ResizeBlockStorageVolumeFunc == nil {
+		panic("MockClient: ResizeBlockStorageVolume is not implemented")
+	}
+
+	return mc.ResizeBlockStorageVolumeFunc(ctx, id, req)
+}
+
+func (mc *MockClient) GetConsoleProxyURL(ctx context.Context, id UUID) (*GetConsoleProxyURLResponse, error) {
+	if mc.GetConsoleProxyURLFunc == nil {
+		panic("MockClient: GetConsoleProxyURL is not implemented")
+	}
+
+	return mc.GetConsoleProxyURLFunc(ctx, id)
+}
+
+func (mc *MockClient) ListDeployTargets(ctx context.Context) (*ListDeployTargetsResponse, error) {
+	if mc.ListDeployTargetsFunc == nil {
+		panic("MockClient: ListDeployTargets is not implemented")
+	}
+
+	return mc.ListDeployTargetsFunc(ctx)
+}
+
+func (mc *MockClient) AllDeployTargets(ctx context.Context) iter.Seq2[DeployTarget, error] {
+	if mc.AllDeployTargetsFunc == nil {
+		panic("MockClient: AllDeployTargets is not implemented")
+	}
+
+	return mc.AllDeployTargetsFunc(ctx)
+}
+
+func (mc *MockClient) GetDeployTarget(ctx context.Context, id UUID) (*DeployTarget, error) {
+	if mc.GetDeployTargetFunc == nil {
+		panic("MockClient: GetDeployTarget is not implemented")
+	}
+
+	return mc.GetDeployTargetFunc(ctx, id)
+}
+
+func (mc *MockClient) ListElasticIPS(ctx context.Context) (*ListElasticIPSResponse, error) {
+	if mc.ListElasticIPSFunc == nil {
+		panic("MockClient: ListElasticIPS is not implemented")
+	}
+
+	return mc.ListElasticIPSFunc(ctx)
+}
+
+func (mc *MockClient) AllElasticIPS(ctx context.Context) iter.Seq2[ElasticIP, error] {
+	if mc.AllElasticIPSFunc == nil {
+		panic("MockClient: AllElasticIPS is not implemented")
+	}
+
+	return mc.AllElasticIPSFunc(ctx)
+}
+
+func (mc *MockClient) CreateElasticIP(ctx context.Context, req CreateElasticIPRequest) (*Operation, error) {
+	if mc.CreateElasticIPFunc == nil {
+		panic("MockClient: CreateElasticIP is not implemented")
+	}
+
+	return mc.CreateElasticIPFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateElasticIPAndWait(ctx context.Context, req CreateElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error) {
+	if mc.CreateElasticIPAndWaitFunc == nil {
+		panic("MockClient: CreateElasticIPAndWait is not implemented")
+	}
+
+	return mc.CreateElasticIPAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) DeleteElasticIP(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteElasticIPFunc == nil {
+		panic("MockClient: DeleteElasticIP is not implemented")
+	}
+
+	return mc.DeleteElasticIPFunc(ctx, id)
+}
+
+func (mc *MockClient) GetElasticIP(ctx context.Context, id UUID) (*ElasticIP, error) {
+	if mc.GetElasticIPFunc == nil {
+		panic("MockClient: GetElasticIP is not implemented")
+	}
+
+	return mc.GetElasticIPFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateElasticIP(ctx context.Context, id UUID, req UpdateElasticIPRequest) (*Operation, error) {
+	if mc.UpdateElasticIPFunc == nil {
+		panic("MockClient: UpdateElasticIP is not implemented")
+	}
+
+	return mc.UpdateElasticIPFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateElasticIPAndWait(ctx context.Context, id UUID, req UpdateElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error) {
+	if mc.UpdateElasticIPAndWaitFunc == nil {
+		panic("MockClient: UpdateElasticIPAndWait is not implemented")
+	}
+
+	return mc.UpdateElasticIPAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ResetElasticIPField(ctx context.Context, id UUID, field ResetElasticIPFieldField) (*Operation, error) {
+	if mc.ResetElasticIPFieldFunc == nil {
+		panic("MockClient: ResetElasticIPField is not implemented")
+	}
+
+	return mc.ResetElasticIPFieldFunc(ctx, id, field)
+}
+
+func (mc *MockClient) AttachInstanceToElasticIP(ctx context.Context, id UUID, req AttachInstanceToElasticIPRequest) (*Operation, error) {
+	if mc.AttachInstanceToElasticIPFunc == nil {
+		panic("MockClient: AttachInstanceToElasticIP is not implemented")
+	}
+
+	return mc.AttachInstanceToElasticIPFunc(ctx, id, req)
+}
+
+func (mc *MockClient) AttachInstanceToElasticIPAndWait(ctx context.Context, id UUID, req AttachInstanceToElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error) {
+	if mc.AttachInstanceToElasticIPAndWaitFunc == nil {
+		panic("MockClient: AttachInstanceToElasticIPAndWait is not implemented")
+	}
+
+	return mc.AttachInstanceToElasticIPAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) DetachInstanceFromElasticIP(ctx context.Context, id UUID, req DetachInstanceFromElasticIPRequest) (*Operation, error) {
+	if mc.DetachInstanceFromElasticIPFunc == nil {
+		panic("MockClient: DetachInstanceFromElasticIP is not implemented")
+	}
+
+	return mc.DetachInstanceFromElasticIPFunc(ctx, id, req)
+}
+
+func (mc *MockClient) DetachInstanceFromElasticIPAndWait(ctx context.Context, id UUID, req DetachInstanceFromElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error) {
+	if mc.DetachInstanceFromElasticIPAndWaitFunc == nil {
+		panic("MockClient: DetachInstanceFromElasticIPAndWait is not implemented")
+	}
+
+	return mc.DetachInstanceFromElasticIPAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ListInstances(ctx context.Context, opts ...ListInstancesOpt) (*ListInstancesResponse, error) {
+	if mc.ListInstancesFunc == nil {
+		panic("MockClient: ListInstances is not implemented")
+	}
+
+	return mc.ListInstancesFunc(ctx, opts...)
+}
+
+func (mc *MockClient) AllInstances(ctx context.Context, opts ...ListInstancesOpt) iter.Seq2[ListInstancesResponseInstances, error] {
+	if mc.AllInstancesFunc == nil {
+		panic("MockClient: AllInstances is not implemented")
+	}
+
+	return mc.AllInstancesFunc(ctx, opts...)
+}
+
+func (mc *MockClient) CreateInstance(ctx context.Context, req CreateInstanceRequest) (*Operation, error) {
+	if mc.CreateInstanceFunc == nil {
+		panic("MockClient: CreateInstance is not implemented")
+	}
+
+	return mc.CreateInstanceFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateInstanceAndWait(ctx context.Context, req CreateInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	if mc.CreateInstanceAndWaitFunc == nil {
+		panic("MockClient: CreateInstanceAndWait is not implemented")
+	}
+
+	return mc.CreateInstanceAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) ListInstancePools(ctx context.Context) (*ListInstancePoolsResponse, error) {
+	if mc.ListInstancePoolsFunc == nil {
+		panic("MockClient: ListInstancePools is not implemented")
+	}
+
+	return mc.ListInstancePoolsFunc(ctx)
+}
+
+func (mc *MockClient) AllInstancePools(ctx context.Context) iter.Seq2[InstancePool, error] {
+	if mc.AllInstancePoolsFunc == nil {
+		panic("MockClient: AllInstancePools is not implemented")
+	}
+
+	return mc.AllInstancePoolsFunc(ctx)
+}
+
+func (mc *MockClient) CreateInstancePool(ctx context.Context, req CreateInstancePoolRequest) (*Operation, error) {
+	if mc.CreateInstancePoolFunc == nil {
+		panic("MockClient: CreateInstancePool is not implemented")
+	}
+
+	return mc.CreateInstancePoolFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateInstancePoolAndWait(ctx context.Context, req CreateInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error) {
+	if mc.CreateInstancePoolAndWaitFunc == nil {
+		panic("MockClient: CreateInstancePoolAndWait is not implemented")
+	}
+
+	return mc.CreateInstancePoolAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) DeleteInstancePool(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteInstancePoolFunc == nil {
+		panic("MockClient: DeleteInstancePool is not implemented")
+	}
+
+	return mc.DeleteInstancePoolFunc(ctx, id)
+}
+
+func (mc *MockClient) GetInstancePool(ctx context.Context, id UUID) (*InstancePool, error) {
+	if mc.GetInstancePoolFunc == nil {
+		panic("MockClient: GetInstancePool is not implemented")
+	}
+
+	return mc.GetInstancePoolFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateInstancePool(ctx context.Context, id UUID, req UpdateInstancePoolRequest) (*Operation, error) {
+	if mc.UpdateInstancePoolFunc == nil {
+		panic("MockClient: UpdateInstancePool is not implemented")
+	}
+
+	return mc.UpdateInstancePoolFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateInstancePoolAndWait(ctx context.Context, id UUID, req UpdateInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error) {
+	if mc.UpdateInstancePoolAndWaitFunc == nil {
+		panic("MockClient: UpdateInstancePoolAndWait is not implemented")
+	}
+
+	return mc.UpdateInstancePoolAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ResetInstancePoolField(ctx context.Context, id UUID, field ResetInstancePoolFieldField) (*Operation, error) {
+	if mc.ResetInstancePoolFieldFunc == nil {
+		panic("MockClient: ResetInstancePoolField is not implemented")
+	}
+
+	return mc.ResetInstancePoolFieldFunc(ctx, id, field)
+}
+
+func (mc *MockClient) EvictInstancePoolMembers(ctx context.Context, id UUID, req EvictInstancePoolMembersRequest) (*Operation, error) {
+	if mc.EvictInstancePoolMembersFunc == nil {
+		panic("MockClient: EvictInstancePoolMembers is not implemented")
+	}
+
+	return mc.EvictInstancePoolMembersFunc(ctx, id, req)
+}
+
+func (mc *MockClient) EvictInstancePoolMembersAndWait(ctx context.Context, id UUID, req EvictInstancePoolMembersRequest, opts ...WaitOpt) (*InstancePool, error) {
+	if mc.EvictInstancePoolMembersAndWaitFunc == nil {
+		panic("MockClient: EvictInstancePoolMembersAndWait is not implemented")
+	}
+
+	return mc.EvictInstancePoolMembersAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ScaleInstancePool(ctx context.Context, id UUID, req ScaleInstancePoolRequest) (*Operation, error) {
+	if mc.ScaleInstancePoolFunc == nil {
+		panic("MockClient: ScaleInstancePool is not implemented")
+	}
+
+	return mc.ScaleInstancePoolFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ScaleInstancePoolAndWait(ctx context.Context, id UUID, req ScaleInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error) {
+	if mc.ScaleInstancePoolAndWaitFunc == nil {
+		panic("MockClient: ScaleInstancePoolAndWait is not implemented")
+	}
+
+	return mc.ScaleInstancePoolAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ListInstanceTypes(ctx context.Context) (*ListInstanceTypesResponse, error) {
+	if mc.ListInstanceTypesFunc == nil {
+		panic("MockClient: ListInstanceTypes is not implemented")
+	}
+
+	return mc.ListInstanceTypesFunc(ctx)
+}
+
+func (mc *MockClient) AllInstanceTypes(ctx context.Context) iter.Seq2[InstanceType, error] {
+	if mc.AllInstanceTypesFunc == nil {
+		panic("MockClient: AllInstanceTypes is not implemented")
+	}
+
+	return mc.AllInstanceTypesFunc(ctx)
+}
+
+func (mc *MockClient) GetInstanceType(ctx context.Context, id UUID) (*InstanceType, error) {
+	if mc.GetInstanceTypeFunc == nil {
+		panic("MockClient: GetInstanceType is not implemented")
+	}
+
+	return mc.GetInstanceTypeFunc(ctx, id)
+}
+
+func (mc *MockClient) DeleteInstance(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteInstanceFunc == nil {
+		panic("MockClient: DeleteInstance is not implemented")
+	}
+
+	return mc.DeleteInstanceFunc(ctx, id)
+}
+
+func (mc *MockClient) GetInstance(ctx context.Context, id UUID) (*Instance, error) {
+	if mc.GetInstanceFunc == nil {
+		panic("MockClient: GetInstance is not implemented")
+	}
+
+	return mc.GetInstanceFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateInstance(ctx context.Context, id UUID, req UpdateInstanceRequest) (*Operation, error) {
+	if mc.UpdateInstanceFunc == nil {
+		panic("MockClient: UpdateInstance is not implemented")
+	}
+
+	return mc.UpdateInstanceFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateInstanceAndWait(ctx context.Context, id UUID, req UpdateInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	if mc.UpdateInstanceAndWaitFunc == nil {
+		panic("MockClient: UpdateInstanceAndWait is not implemented")
+	}
+
+	return mc.UpdateInstanceAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ResetInstanceField(ctx context.Context, id UUID, field ResetInstanceFieldField) (*Operation, error) {
+	if mc.ResetInstanceFieldFunc == nil {
+		panic("MockClient: ResetInstanceField is not implemented")
+	}
+
+	return mc.ResetInstanceFieldFunc(ctx, id, field)
+}
+
+func (mc *MockClient) AddInstanceProtection(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.AddInstanceProtectionFunc == nil {
+		panic("MockClient: AddInstanceProtection is not implemented")
+	}
+
+	return mc.AddInstanceProtectionFunc(ctx, id)
+}
+
+func (mc *MockClient) AddInstanceProtectionAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	if mc.AddInstanceProtectionAndWaitFunc == nil {
+		panic("MockClient: AddInstanceProtectionAndWait is not implemented")
+	}
+
+	return mc.AddInstanceProtectionAndWaitFunc(ctx, id, opts...)
+}
+
+func (mc *MockClient) CreateSnapshot(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.CreateSnapshotFunc == nil {
+		panic("MockClient: CreateSnapshot is not implemented")
+	}
+
+	return mc.CreateSnapshotFunc(ctx, id)
+}
+
+func (mc *MockClient) EnableTpm(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.EnableTpmFunc == nil {
+		panic("MockClient: EnableTpm is not implemented")
+	}
+
+	return mc.EnableTpmFunc(ctx, id)
+}
+
+func (mc *MockClient) EnableTpmAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	if mc.EnableTpmAndWaitFunc == nil {
+		panic("MockClient: EnableTpmAndWait is not implemented")
+	}
+
+	return mc.EnableTpmAndWaitFunc(ctx, id, opts...)
+}
+
+func (mc *MockClient) RevealInstancePassword(ctx context.Context, id UUID) (*InstancePassword, error) {
+	if mc.RevealInstancePasswordFunc == nil {
+		panic("MockClient: RevealInstancePassword is not implemented")
+	}
+
+	return mc.RevealInstancePasswordFunc(ctx, id)
+}
+
+func (mc *MockClient) RebootInstance(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.RebootInstanceFunc == nil {
+		panic("MockClient: RebootInstance is not implemented")
+	}
+
+	return mc.RebootInstanceFunc(ctx, id)
+}
+
+func (mc *MockClient) RebootInstanceAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	if mc.RebootInstanceAndWaitFunc == nil {
+		panic("MockClient: RebootInstanceAndWait is not implemented")
+	}
+
+	return mc.RebootInstanceAndWaitFunc(ctx, id, opts...)
+}
+
+func (mc *MockClient) RemoveInstanceProtection(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.RemoveInstanceProtectionFunc == nil {
+		panic("MockClient: RemoveInstanceProtection is not implemented")
+	}
+
+	return mc.RemoveInstanceProtectionFunc(ctx, id)
+}
+
+func (mc *MockClient) RemoveInstanceProtectionAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	if mc.RemoveInstanceProtectionAndWaitFunc == nil {
+		panic("MockClient: RemoveInstanceProtectionAndWait is not implemented")
+	}
+
+	return mc.RemoveInstanceProtectionAndWaitFunc(ctx, id, opts...)
+}
+
+func (mc *MockClient) ResetInstance(ctx context.Context, id UUID, req ResetInstanceRequest) (*Operation, error) {
+	if mc.ResetInstanceFunc == nil {
+		panic("MockClient: ResetInstance is not implemented")
+	}
+
+	return mc.ResetInstanceFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ResetInstanceAndWait(ctx context.Context, id UUID, req ResetInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	if mc.ResetInstanceAndWaitFunc == nil {
+		panic("MockClient: ResetInstanceAndWait is not implemented")
+	}
+
+	return mc.ResetInstanceAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ResetInstancePassword(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.ResetInstancePasswordFunc == nil {
+		panic("MockClient: ResetInstancePassword is not implemented")
+	}
+
+	return mc.ResetInstancePasswordFunc(ctx, id)
+}
+
+func (mc *MockClient) ResetInstancePasswordAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	if mc.ResetInstancePasswordAndWaitFunc == nil {
+		panic("MockClient: ResetInstancePasswordAndWait is not implemented")
+	}
+
+	return mc.ResetInstancePasswordAndWaitFunc(ctx, id, opts...)
+}
+
+func (mc *MockClient) ResizeInstanceDisk(ctx context.Context, id UUID, req ResizeInstanceDiskRequest) (*Operation, error) {
+	if mc.ResizeInstanceDiskFunc == nil {
+		panic("MockClient: ResizeInstanceDisk is not implemented")
+	}
+
+	return mc.ResizeInstanceDiskFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ResizeInstanceDiskAndWait(ctx context.Context, id UUID, req ResizeInstanceDiskRequest, opts ...WaitOpt) (*Instance, error) {
+	if mc.ResizeInstanceDiskAndWaitFunc == nil {
+		panic("MockClient: ResizeInstanceDiskAndWait is not implemented")
+	}
+
+	return mc.ResizeInstanceDiskAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ScaleInstance(ctx context.Context, id UUID, req ScaleInstanceRequest) (*Operation, error) {
+	if mc.ScaleInstanceFunc == nil {
+		panic("MockClient: ScaleInstance is not implemented")
+	}
+
+	return mc.ScaleInstanceFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ScaleInstanceAndWait(ctx context.Context, id UUID, req ScaleInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	if mc.ScaleInstanceAndWaitFunc == nil {
+		panic("MockClient: ScaleInstanceAndWait is not implemented")
+	}
+
+	return mc.ScaleInstanceAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) StartInstance(ctx context.Context, id UUID, req StartInstanceRequest) (*Operation, error) {
+	if mc.StartInstanceFunc == nil {
+		panic("MockClient: StartInstance is not implemented")
+	}
+
+	return mc.StartInstanceFunc(ctx, id, req)
+}
+
+func (mc *MockClient) StartInstanceAndWait(ctx context.Context, id UUID, req StartInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	if mc.StartInstanceAndWaitFunc == nil {
+		panic("MockClient: StartInstanceAndWait is not implemented")
+	}
+
+	return mc.StartInstanceAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) StopInstance(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.StopInstanceFunc == nil {
+		panic("MockClient: StopInstance is not implemented")
+	}
+
+	return mc.StopInstanceFunc(ctx, id)
+}
+
+func (mc *MockClient) StopInstanceAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	if mc.StopInstanceAndWaitFunc == nil {
+		panic("MockClient: StopInstanceAndWait is not implemented")
+	}
+
+	return mc.StopInstanceAndWaitFunc(ctx, id, opts...)
+}
+
+func (mc *MockClient) RevertInstanceToSnapshot(ctx context.Context, instanceID UUID, req RevertInstanceToSnapshotRequest) (*Operation, error) {
+	if mc.RevertInstanceToSnapshotFunc == nil {
+		panic("MockClient: RevertInstanceToSnapshot is not implemented")
+	}
+
+	return mc.RevertInstanceToSnapshotFunc(ctx, instanceID, req)
+}
+
+func (mc *MockClient) RevertInstanceToSnapshotAndWait(ctx context.Context, instanceID UUID, req RevertInstanceToSnapshotRequest, opts ...WaitOpt) (*Instance, error) {
+	if mc.RevertInstanceToSnapshotAndWaitFunc == nil {
+		panic("MockClient: RevertInstanceToSnapshotAndWait is not implemented")
+	}
+
+	return mc.RevertInstanceToSnapshotAndWaitFunc(ctx, instanceID, req, opts...)
+}
+
+func (mc *MockClient) ListLoadBalancers(ctx context.Context) (*ListLoadBalancersResponse, error) {
+	if mc.ListLoadBalancersFunc == nil {
+		panic("MockClient: ListLoadBalancers is not implemented")
+	}
+
+	return mc.ListLoadBalancersFunc(ctx)
+}
+
+func (mc *MockClient) AllLoadBalancers(ctx context.Context) iter.Seq2[LoadBalancer, error] {
+	if mc.AllLoadBalancersFunc == nil {
+		panic("MockClient: AllLoadBalancers is not implemented")
+	}
+
+	return mc.AllLoadBalancersFunc(ctx)
+}
+
+func (mc *MockClient) CreateLoadBalancer(ctx context.Context, req CreateLoadBalancerRequest) (*Operation, error) {
+	if mc.CreateLoadBalancerFunc == nil {
+		panic("MockClient: CreateLoadBalancer is not implemented")
+	}
+
+	return mc.CreateLoadBalancerFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateLoadBalancerAndWait(ctx context.Context, req CreateLoadBalancerRequest, opts ...WaitOpt) (*LoadBalancer, error) {
+	if mc.CreateLoadBalancerAndWaitFunc == nil {
+		panic("MockClient: CreateLoadBalancerAndWait is not implemented")
+	}
+
+	return mc.CreateLoadBalancerAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) DeleteLoadBalancer(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteLoadBalancerFunc == nil {
+		panic("MockClient: DeleteLoadBalancer is not implemented")
+	}
+
+	return mc.DeleteLoadBalancerFunc(ctx, id)
+}
+
+func (mc *MockClient) GetLoadBalancer(ctx context.Context, id UUID) (*LoadBalancer, error) {
+	if mc.GetLoadBalancerFunc == nil {
+		panic("MockClient: GetLoadBalancer is not implemented")
+	}
+
+	return mc.GetLoadBalancerFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateLoadBalancer(ctx context.Context, id UUID, req UpdateLoadBalancerRequest) (*Operation, error) {
+	if mc.UpdateLoadBalancerFunc == nil {
+		panic("MockClient: UpdateLoadBalancer is not implemented")
+	}
+
+	return mc.UpdateLoadBalancerFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateLoadBalancerAndWait(ctx context.Context, id UUID, req UpdateLoadBalancerRequest, opts ...WaitOpt) (*LoadBalancer, error) {
+	if mc.UpdateLoadBalancerAndWaitFunc == nil {
+		panic("MockClient: UpdateLoadBalancerAndWait is not implemented")
+	}
+
+	return mc.UpdateLoadBalancerAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) AddServiceToLoadBalancer(ctx context.Context, id UUID, req AddServiceToLoadBalancerRequest) (*Operation, error) {
+	if mc.AddServiceToLoadBalancerFunc == nil {
+		panic("MockClient: AddServiceToLoadBalancer is not implemented")
+	}
+
+	return mc.AddServiceToLoadBalancerFunc(ctx, id, req)
+}
+
+func (mc *MockClient) DeleteLoadBalancerService(ctx context.Context, id UUID, serviceID UUID) (*Operation, error) {
+	if mc.DeleteLoadBalancerServiceFunc == nil {
+		panic("MockClient: DeleteLoadBalancerService is not implemented")
+	}
+
+	return mc.DeleteLoadBalancerServiceFunc(ctx, id, serviceID)
+}
+
+func (mc *MockClient) GetLoadBalancerService(ctx context.Context, id UUID, serviceID UUID) (*LoadBalancerService, error) {
+	if mc.GetLoadBalancerServiceFunc == nil {
+		panic("MockClient: GetLoadBalancerService is not implemented")
+	}
+
+	return mc.GetLoadBalancerServiceFunc(ctx, id, serviceID)
+}
+
+func (mc *MockClient) UpdateLoadBalancerService(ctx context.Context, id UUID, serviceID UUID, req UpdateLoadBalancerServiceRequest) (*Operation, error) {
+	if mc.UpdateLoadBalancerServiceFunc == nil {
+		panic("MockClient: UpdateLoadBalancerService is not implemented")
+	}
+
+	return mc.UpdateLoadBalancerServiceFunc(ctx, id, serviceID, req)
+}
+
+func (mc *MockClient) ResetLoadBalancerServiceField(ctx context.Context, id UUID, serviceID UUID, field ResetLoadBalancerServiceFieldField) (*Operation, error) {
+	if mc.ResetLoadBalancerServiceFieldFunc == nil {
+		panic("MockClient: ResetLoadBalancerServiceField is not implemented")
+	}
+
+	return mc.ResetLoadBalancerServiceFieldFunc(ctx, id, serviceID, field)
+}
+
+func (mc *MockClient) ResetLoadBalancerField(ctx context.Context, id UUID, field ResetLoadBalancerFieldField) (*Operation, error) {
+	if mc.ResetLoadBalancerFieldFunc == nil {
+		panic("MockClient: ResetLoadBalancerField is not implemented")
+	}
+
+	return mc.ResetLoadBalancerFieldFunc(ctx, id, field)
+}
+
+func (mc *MockClient) ListPrivateNetworks(ctx context.Context) (*ListPrivateNetworksResponse, error) {
+	if mc.ListPrivateNetworksFunc == nil {
+		panic("MockClient: ListPrivateNetworks is not implemented")
+	}
+
+	return mc.ListPrivateNetworksFunc(ctx)
+}
+
+func (mc *MockClient) AllPrivateNetworks(ctx context.Context) iter.Seq2[PrivateNetwork, error] {
+	if mc.AllPrivateNetworksFunc == nil {
+		panic("MockClient: AllPrivateNetworks is not implemented")
+	}
+
+	return mc.AllPrivateNetworksFunc(ctx)
+}
+
+func (mc *MockClient) CreatePrivateNetwork(ctx context.Context, req CreatePrivateNetworkRequest) (*Operation, error) {
+	if mc.CreatePrivateNetworkFunc == nil {
+		panic("MockClient: CreatePrivateNetwork is not implemented")
+	}
+
+	return mc.CreatePrivateNetworkFunc(ctx, req)
+}
+
+func (mc *MockClient) CreatePrivateNetworkAndWait(ctx context.Context, req CreatePrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	if mc.CreatePrivateNetworkAndWaitFunc == nil {
+		panic("MockClient: CreatePrivateNetworkAndWait is not implemented")
+	}
+
+	return mc.CreatePrivateNetworkAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) DeletePrivateNetwork(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeletePrivateNetworkFunc == nil {
+		panic("MockClient: DeletePrivateNetwork is not implemented")
+	}
+
+	return mc.DeletePrivateNetworkFunc(ctx, id)
+}
+
+func (mc *MockClient) GetPrivateNetwork(ctx context.Context, id UUID) (*PrivateNetwork, error) {
+	if mc.GetPrivateNetworkFunc == nil {
+		panic("MockClient: GetPrivateNetwork is not implemented")
+	}
+
+	return mc.GetPrivateNetworkFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdatePrivateNetwork(ctx context.Context, id UUID, req UpdatePrivateNetworkRequest) (*Operation, error) {
+	if mc.UpdatePrivateNetworkFunc == nil {
+		panic("MockClient: UpdatePrivateNetwork is not implemented")
+	}
+
+	return mc.UpdatePrivateNetworkFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdatePrivateNetworkAndWait(ctx context.Context, id UUID, req UpdatePrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	if mc.UpdatePrivateNetworkAndWaitFunc == nil {
+		panic("MockClient: UpdatePrivateNetworkAndWait is not implemented")
+	}
+
+	return mc.UpdatePrivateNetworkAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ResetPrivateNetworkField(ctx context.Context, id UUID, field ResetPrivateNetworkFieldField) (*Operation, error) {
+	if mc.ResetPrivateNetworkFieldFunc == nil {
+		panic("MockClient: ResetPrivateNetworkField is not implemented")
+	}
+
+	return mc.ResetPrivateNetworkFieldFunc(ctx, id, field)
+}
+
+func (mc *MockClient) AttachInstanceToPrivateNetwork(ctx context.Context, id UUID, req AttachInstanceToPrivateNetworkRequest) (*Operation, error) {
+	if mc.AttachInstanceToPrivateNetworkFunc == nil {
+		panic("MockClient: AttachInstanceToPrivateNetwork is not implemented")
+	}
+
+	return mc.AttachInstanceToPrivateNetworkFunc(ctx, id, req)
+}
+
+func (mc *MockClient) AttachInstanceToPrivateNetworkAndWait(ctx context.Context, id UUID, req AttachInstanceToPrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	if mc.AttachInstanceToPrivateNetworkAndWaitFunc == nil {
+		panic("MockClient: AttachInstanceToPrivateNetworkAndWait is not implemented")
+	}
+
+	return mc.AttachInstanceToPrivateNetworkAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) DetachInstanceFromPrivateNetwork(ctx context.Context, id UUID, req DetachInstanceFromPrivateNetworkRequest) (*Operation, error) {
+	if mc.DetachInstanceFromPrivateNetworkFunc == nil {
+		panic("MockClient: DetachInstanceFromPrivateNetwork is not implemented")
+	}
+
+	return mc.DetachInstanceFromPrivateNetworkFunc(ctx, id, req)
+}
+
+func (mc *MockClient) DetachInstanceFromPrivateNetworkAndWait(ctx context.Context, id UUID, req DetachInstanceFromPrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	if mc.DetachInstanceFromPrivateNetworkAndWaitFunc == nil {
+		panic("MockClient: DetachInstanceFromPrivateNetworkAndWait is not implemented")
+	}
+
+	return mc.DetachInstanceFromPrivateNetworkAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) UpdatePrivateNetworkInstanceIP(ctx context.Context, id UUID, req UpdatePrivateNetworkInstanceIPRequest) (*Operation, error) {
+	if mc.UpdatePrivateNetworkInstanceIPFunc == nil {
+		panic("MockClient: UpdatePrivateNetworkInstanceIP is not implemented")
+	}
+
+	return mc.UpdatePrivateNetworkInstanceIPFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdatePrivateNetworkInstanceIPAndWait(ctx context.Context, id UUID, req UpdatePrivateNetworkInstanceIPRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	if mc.UpdatePrivateNetworkInstanceIPAndWaitFunc == nil {
+		panic("MockClient: UpdatePrivateNetworkInstanceIPAndWait is not implemented")
+	}
+
+	return mc.UpdatePrivateNetworkInstanceIPAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) DeleteReverseDNSElasticIP(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteReverseDNSElasticIPFunc == nil {
+		panic("MockClient: DeleteReverseDNSElasticIP is not implemented")
+	}
+
+	return mc.DeleteReverseDNSElasticIPFunc(ctx, id)
+}
+
+func (mc *MockClient) GetReverseDNSElasticIP(ctx context.Context, id UUID) (*ReverseDNSRecord, error) {
+	if mc.GetReverseDNSElasticIPFunc == nil {
+		panic("MockClient: GetReverseDNSElasticIP is not implemented")
+	}
+
+	return mc.GetReverseDNSElasticIPFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateReverseDNSElasticIP(ctx context.Context, id UUID, req UpdateReverseDNSElasticIPRequest) (*Operation, error) {
+	if mc.UpdateReverseDNSElasticIPFunc == nil {
+		panic("MockClient: UpdateReverseDNSElasticIP is not implemented")
+	}
+
+	return mc.UpdateReverseDNSElasticIPFunc(ctx, id, req)
+}
+
+func (mc *MockClient) DeleteReverseDNSInstance(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteReverseDNSInstanceFunc == nil {
+		panic("MockClient: DeleteReverseDNSInstance is not implemented")
+	}
+
+	return mc.DeleteReverseDNSInstanceFunc(ctx, id)
+}
+
+func (mc *MockClient) GetReverseDNSInstance(ctx context.Context, id UUID) (*ReverseDNSRecord, error) {
+	if mc.GetReverseDNSInstanceFunc == nil {
+		panic("MockClient: GetReverseDNSInstance is not implemented")
+	}
+
+	return mc.GetReverseDNSInstanceFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateReverseDNSInstance(ctx context.Context, id UUID, req UpdateReverseDNSInstanceRequest) (*Operation, error) {
+	if mc.UpdateReverseDNSInstanceFunc == nil {
+		panic("MockClient: UpdateReverseDNSInstance is not implemented")
+	}
+
+	return mc.UpdateReverseDNSInstanceFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ListSecurityGroups(ctx context.Context, opts ...ListSecurityGroupsOpt) (*ListSecurityGroupsResponse, error) {
+	if mc.ListSecurityGroupsFunc == nil {
+		panic("MockClient: ListSecurityGroups is not implemented")
+	}
+
+	return mc.ListSecurityGroupsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) AllSecurityGroups(ctx context.Context, opts ...ListSecurityGroupsOpt) iter.Seq2[SecurityGroup, error] {
+	if mc.AllSecurityGroupsFunc == nil {
+		panic("MockClient: AllSecurityGroups is not implemented")
+	}
+
+	return mc.AllSecurityGroupsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) CreateSecurityGroup(ctx context.Context, req CreateSecurityGroupRequest) (*Operation, error) {
+	if mc.CreateSecurityGroupFunc == nil {
+		panic("MockClient: CreateSecurityGroup is not implemented")
+	}
+
+	return mc.CreateSecurityGroupFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateSecurityGroupAndWait(ctx context.Context, req CreateSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	if mc.CreateSecurityGroupAndWaitFunc == nil {
+		panic("MockClient: CreateSecurityGroupAndWait is not implemented")
+	}
+
+	return mc.CreateSecurityGroupAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) DeleteSecurityGroup(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteSecurityGroupFunc == nil {
+		panic("MockClient: DeleteSecurityGroup is not implemented")
+	}
+
+	return mc.DeleteSecurityGroupFunc(ctx, id)
+}
+
+func (mc *MockClient) GetSecurityGroup(ctx context.Context, id UUID) (*SecurityGroup, error) {
+	if mc.GetSecurityGroupFunc == nil {
+		panic("MockClient: GetSecurityGroup is not implemented")
+	}
+
+	return mc.GetSecurityGroupFunc(ctx, id)
+}
+
+func (mc *MockClient) AddRuleToSecurityGroup(ctx context.Context, id UUID, req AddRuleToSecurityGroupRequest) (*Operation, error) {
+	if mc.AddRuleToSecurityGroupFunc == nil {
+		panic("MockClient: AddRuleToSecurityGroup is not implemented")
+	}
+
+	return mc.AddRuleToSecurityGroupFunc(ctx, id, req)
+}
+
+func (mc *MockClient) DeleteRuleFromSecurityGroup(ctx context.Context, id UUID, ruleID UUID) (*Operation, error) {
+	if mc.DeleteRuleFromSecurityGroupFunc == nil {
+		panic("MockClient: DeleteRuleFromSecurityGroup is not implemented")
+	}
+
+	return mc.DeleteRuleFromSecurityGroupFunc(ctx, id, ruleID)
+}
+
+func (mc *MockClient) AddExternalSourceToSecurityGroup(ctx context.Context, id UUID, req AddExternalSourceToSecurityGroupRequest) (*Operation, error) {
+	if mc.AddExternalSourceToSecurityGroupFunc == nil {
+		panic("MockClient: AddExternalSourceToSecurityGroup is not implemented")
+	}
+
+	return mc.AddExternalSourceToSecurityGroupFunc(ctx, id, req)
+}
+
+func (mc *MockClient) AddExternalSourceToSecurityGroupAndWait(ctx context.Context, id UUID, req AddExternalSourceToSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	if mc.AddExternalSourceToSecurityGroupAndWaitFunc == nil {
+		panic("MockClient: AddExternalSourceToSecurityGroupAndWait is not implemented")
+	}
+
+	return mc.AddExternalSourceToSecurityGroupAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) AttachInstanceToSecurityGroup(ctx context.Context, id UUID, req AttachInstanceToSecurityGroupRequest) (*Operation, error) {
+	if mc.AttachInstanceToSecurityGroupFunc == nil {
+		panic("MockClient: AttachInstanceToSecurityGroup is not implemented")
+	}
+
+	return mc.AttachInstanceToSecurityGroupFunc(ctx, id, req)
+}
+
+func (mc *MockClient) AttachInstanceToSecurityGroupAndWait(ctx context.Context, id UUID, req AttachInstanceToSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	if mc.AttachInstanceToSecurityGroupAndWaitFunc == nil {
+		panic("MockClient: AttachInstanceToSecurityGroupAndWait is not implemented")
+	}
+
+	return mc.AttachInstanceToSecurityGroupAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) DetachInstanceFromSecurityGroup(ctx context.Context, id UUID, req DetachInstanceFromSecurityGroupRequest) (*Operation, error) {
+	if mc.DetachInstanceFromSecurityGroupFunc == nil {
+		panic("MockClient: DetachInstanceFromSecurityGroup is not implemented")
+	}
+
+	return mc.DetachInstanceFromSecurityGroupFunc(ctx, id, req)
+}
+
+func (mc *MockClient) DetachInstanceFromSecurityGroupAndWait(ctx context.Context, id UUID, req DetachInstanceFromSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	if mc.DetachInstanceFromSecurityGroupAndWaitFunc == nil {
+		panic("MockClient: DetachInstanceFromSecurityGroupAndWait is not implemented")
+	}
+
+	return mc.DetachInstanceFromSecurityGroupAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) RemoveExternalSourceFromSecurityGroup(ctx context.Context, id UUID, req RemoveExternalSourceFromSecurityGroupRequest) (*Operation, error) {
+	if mc.RemoveExternalSourceFromSecurityGroupFunc == nil {
+		panic("MockClient: RemoveExternalSourceFromSecurityGroup is not implemented")
+	}
+
+	return mc.RemoveExternalSourceFromSecurityGroupFunc(ctx, id, req)
+}
+
+func (mc *MockClient) RemoveExternalSourceFromSecurityGroupAndWait(ctx context.Context, id UUID, req RemoveExternalSourceFromSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	if mc.RemoveExternalSourceFromSecurityGroupAndWaitFunc == nil {
+		panic("MockClient: RemoveExternalSourceFromSecurityGroupAndWait is not implemented")
+	}
+
+	return mc.RemoveExternalSourceFromSecurityGroupAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) ListSnapshots(ctx context.Context) (*ListSnapshotsResponse, error) {
+	if mc.ListSnapshotsFunc == nil {
+		panic("MockClient: ListSnapshots is not implemented")
+	}
+
+	return mc.ListSnapshotsFunc(ctx)
+}
+
+func (mc *MockClient) AllSnapshots(ctx context.Context) iter.Seq2[Snapshot, error] {
+	if mc.AllSnapshotsFunc == nil {
+		panic("MockClient: AllSnapshots is not implemented")
+	}
+
+	return mc.AllSnapshotsFunc(ctx)
+}
+
+func (mc *MockClient) DeleteSnapshot(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteSnapshotFunc == nil {
+		panic("MockClient: DeleteSnapshot is not implemented")
+	}
+
+	return mc.DeleteSnapshotFunc(ctx, id)
+}
+
+func (mc *MockClient) GetSnapshot(ctx context.Context, id UUID) (*Snapshot, error) {
+	if mc.GetSnapshotFunc == nil {
+		panic("MockClient: GetSnapshot is not implemented")
+	}
+
+	return mc.GetSnapshotFunc(ctx, id)
+}
+
+func (mc *MockClient) ExportSnapshot(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.ExportSnapshotFunc == nil {
+		panic("MockClient: ExportSnapshot is not implemented")
+	}
+
+	return mc.ExportSnapshotFunc(ctx, id)
+}
+
+func (mc *MockClient) ExportSnapshotAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Snapshot, error) {
+	if mc.ExportSnapshotAndWaitFunc == nil {
+		panic("MockClient: ExportSnapshotAndWait is not implemented")
+	}
+
+	return mc.ExportSnapshotAndWaitFunc(ctx, id, opts...)
+}
+
+func (mc *MockClient) PromoteSnapshotToTemplate(ctx context.Context, id UUID, req PromoteSnapshotToTemplateRequest) (*Operation, error) {
+	if mc.PromoteSnapshotToTemplateFunc == nil {
+		panic("MockClient: PromoteSnapshotToTemplate is not implemented")
+	}
+
+	return mc.PromoteSnapshotToTemplateFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ListSSHKeys(ctx context.Context) (*ListSSHKeysResponse, error) {
+	if mc.ListSSHKeysFunc == nil {
+		panic("MockClient: ListSSHKeys is not implemented")
+	}
+
+	return mc.ListSSHKeysFunc(ctx)
+}
+
+func (mc *MockClient) AllSSHKeys(ctx context.Context) iter.Seq2[SSHKey, error] {
+	if mc.AllSSHKeysFunc == nil {
+		panic("MockClient: AllSSHKeys is not implemented")
+	}
+
+	return mc.AllSSHKeysFunc(ctx)
+}
+
+func (mc *MockClient) RegisterSSHKey(ctx context.Context, req RegisterSSHKeyRequest) (*Operation, error) {
+	if mc.RegisterSSHKeyFunc == nil {
+		panic("MockClient: RegisterSSHKey is not implemented")
+	}
+
+	return mc.RegisterSSHKeyFunc(ctx, req)
+}
+
+func (mc *MockClient) DeleteSSHKey(ctx context.Context, name string) (*Operation, error) {
+	if mc.DeleteSSHKeyFunc == nil {
+		panic("MockClient: DeleteSSHKey is not implemented")
+	}
+
+	return mc.DeleteSSHKeyFunc(ctx, name)
+}
+
+func (mc *MockClient) GetSSHKey(ctx context.Context, name string) (*SSHKey, error) {
+	if mc.GetSSHKeyFunc == nil {
+		panic("MockClient: GetSSHKey is not implemented")
+	}
+
+	return mc.GetSSHKeyFunc(ctx, name)
+}
+
+func (mc *MockClient) ListTemplates(ctx context.Context, opts ...ListTemplatesOpt) (*ListTemplatesResponse, error) {
+	if mc.ListTemplatesFunc == nil {
+		panic("MockClient: ListTemplates is not implemented")
+	}
+
+	return mc.ListTemplatesFunc(ctx, opts...)
+}
+
+func (mc *MockClient) AllTemplates(ctx context.Context, opts ...ListTemplatesOpt) iter.Seq2[Template, error] {
+	if mc.AllTemplatesFunc == nil {
+		panic("MockClient: AllTemplates is not implemented")
+	}
+
+	return mc.AllTemplatesFunc(ctx, opts...)
+}
+
+func (mc *MockClient) RegisterTemplate(ctx context.Context, req RegisterTemplateRequest) (*Operation, error) {
+	if mc.RegisterTemplateFunc == nil {
+		panic("MockClient: RegisterTemplate is not implemented")
+	}
+
+	return mc.RegisterTemplateFunc(ctx, req)
+}
+
+func (mc *MockClient) RegisterTemplateAndWait(ctx context.Context, req RegisterTemplateRequest, opts ...WaitOpt) (*Template, error) {
+	if mc.RegisterTemplateAndWaitFunc == nil {
+		panic("MockClient: RegisterTemplateAndWait is not implemented")
+	}
+
+	return mc.RegisterTemplateAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) DeleteTemplate(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteTemplateFunc == nil {
+		panic("MockClient: DeleteTemplate is not implemented")
+	}
+
+	return mc.DeleteTemplateFunc(ctx, id)
+}
+
+func (mc *MockClient) GetTemplate(ctx context.Context, id UUID) (*Template, error) {
+	if mc.GetTemplateFunc == nil {
+		panic("MockClient: GetTemplate is not implemented")
+	}
+
+	return mc.GetTemplateFunc(ctx, id)
+}
+
+func (mc *MockClient) CopyTemplate(ctx context.Context, id UUID, req CopyTemplateRequest) (*Operation, error) {
+	if mc.CopyTemplateFunc == nil {
+		panic("MockClient: CopyTemplate is not implemented")
+	}
+
+	return mc.CopyTemplateFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateTemplate(ctx context.Context, id UUID, req UpdateTemplateRequest) (*Operation, error) {
+	if mc.UpdateTemplateFunc == nil {
+		panic("MockClient: UpdateTemplate is not implemented")
+	}
+
+	return mc.UpdateTemplateFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateTemplateAndWait(ctx context.Context, id UUID, req UpdateTemplateRequest, opts ...WaitOpt) (*Template, error) {
+	if mc.UpdateTemplateAndWaitFunc == nil {
+		panic("MockClient: UpdateTemplateAndWait is not implemented")
+	}
+
+	return mc.UpdateTemplateAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) GetDBAASCACertificate(ctx context.Context) (*GetDBAASCACertificateResponse, error) {
+	if mc.GetDBAASCACertificateFunc == nil {
+		panic("MockClient: GetDBAASCACertificate is not implemented")
+	}
+
+	return mc.GetDBAASCACertificateFunc(ctx)
+}
+
+func (mc *MockClient) DeleteDBAASExternalEndpointDatadog(ctx context.Context, endpointID UUID) (*Operation, error) {
+	if mc.DeleteDBAASExternalEndpointDatadogFunc == nil {
+		panic("MockClient: DeleteDBAASExternalEndpointDatadog is not implemented")
+	}
+
+	return mc.DeleteDBAASExternalEndpointDatadogFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) GetDBAASExternalEndpointDatadog(ctx context.Context, endpointID UUID) (*DBAASExternalEndpointDatadogOutput, error) {
+	if mc.GetDBAASExternalEndpointDatadogFunc == nil {
+		panic("MockClient: GetDBAASExternalEndpointDatadog is not implemented")
+	}
+
+	return mc.GetDBAASExternalEndpointDatadogFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointDatadog(ctx context.Context, endpointID UUID, req DBAASEndpointDatadogInputUpdate) (*Operation, error) {
+	if mc.UpdateDBAASExternalEndpointDatadogFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointDatadog is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointDatadogFunc(ctx, endpointID, req)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointDatadogAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointDatadogInputUpdate, opts ...WaitOpt) (*DBAASExternalEndpointDatadogOutput, error) {
+	if mc.UpdateDBAASExternalEndpointDatadogAndWaitFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointDatadogAndWait is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointDatadogAndWaitFunc(ctx, endpointID, req, opts...)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointDatadog(ctx context.Context, name string, req DBAASEndpointDatadogInputCreate) (*Operation, error) {
+	if mc.CreateDBAASExternalEndpointDatadogFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointDatadog is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointDatadogFunc(ctx, name, req)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointDatadogAndWait(ctx context.Context, name string, req DBAASEndpointDatadogInputCreate, opts ...WaitOpt) (*DBAASExternalEndpointDatadogOutput, error) {
+	if mc.CreateDBAASExternalEndpointDatadogAndWaitFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointDatadogAndWait is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointDatadogAndWaitFunc(ctx, name, req, opts...)
+}
+
+func (mc *MockClient) DeleteDBAASExternalEndpointElasticsearch(ctx context.Context, endpointID UUID) (*Operation, error) {
+	if mc.DeleteDBAASExternalEndpointElasticsearchFunc == nil {
+		panic("MockClient: DeleteDBAASExternalEndpointElasticsearch is not implemented")
+	}
+
+	return mc.DeleteDBAASExternalEndpointElasticsearchFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) GetDBAASExternalEndpointElasticsearch(ctx context.Context, endpointID UUID) (*DBAASEndpointElasticsearchOutput, error) {
+	if mc.GetDBAASExternalEndpointElasticsearchFunc == nil {
+		panic("MockClient: GetDBAASExternalEndpointElasticsearch is not implemented")
+	}
+
+	return mc.GetDBAASExternalEndpointElasticsearchFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointElasticsearch(ctx context.Context, endpointID UUID, req DBAASEndpointElasticsearchInputUpdate) (*Operation, error) {
+	if mc.UpdateDBAASExternalEndpointElasticsearchFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointElasticsearch is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointElasticsearchFunc(ctx, endpointID, req)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointElasticsearchAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointElasticsearchInputUpdate, opts ...WaitOpt) (*DBAASEndpointElasticsearchOutput, error) {
+	if mc.UpdateDBAASExternalEndpointElasticsearchAndWaitFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointElasticsearchAndWait is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointElasticsearchAndWaitFunc(ctx, endpointID, req, opts...)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointElasticsearch(ctx context.Context, name string, req DBAASEndpointElasticsearchInputCreate) (*Operation, error) {
+	if mc.CreateDBAASExternalEndpointElasticsearchFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointElasticsearch is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointElasticsearchFunc(ctx, name, req)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointElasticsearchAndWait(ctx context.Context, name string, req DBAASEndpointElasticsearchInputCreate, opts ...WaitOpt) (*DBAASEndpointElasticsearchOutput, error) {
+	if mc.CreateDBAASExternalEndpointElasticsearchAndWaitFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointElasticsearchAndWait is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointElasticsearchAndWaitFunc(ctx, name, req, opts...)
+}
+
+func (mc *MockClient) DeleteDBAASExternalEndpointOpensearch(ctx context.Context, endpointID UUID) (*Operation, error) {
+	if mc.DeleteDBAASExternalEndpointOpensearchFunc == nil {
+		panic("MockClient: DeleteDBAASExternalEndpointOpensearch is not implemented")
+	}
+
+	return mc.DeleteDBAASExternalEndpointOpensearchFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) GetDBAASExternalEndpointOpensearch(ctx context.Context, endpointID UUID) (*DBAASEndpointOpensearchOutput, error) {
+	if mc.GetDBAASExternalEndpointOpensearchFunc == nil {
+		panic("MockClient: GetDBAASExternalEndpointOpensearch is not implemented")
+	}
+
+	return mc.GetDBAASExternalEndpointOpensearchFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointOpensearch(ctx context.Context, endpointID UUID, req DBAASEndpointOpensearchInputUpdate) (*Operation, error) {
+	if mc.UpdateDBAASExternalEndpointOpensearchFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointOpensearch is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointOpensearchFunc(ctx, endpointID, req)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointOpensearchAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointOpensearchInputUpdate, opts ...WaitOpt) (*DBAASEndpointOpensearchOutput, error) {
+	if mc.UpdateDBAASExternalEndpointOpensearchAndWaitFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointOpensearchAndWait is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointOpensearchAndWaitFunc(ctx, endpointID, req, opts...)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointOpensearch(ctx context.Context, name string, req DBAASEndpointOpensearchInputCreate) (*Operation, error) {
+	if mc.CreateDBAASExternalEndpointOpensearchFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointOpensearch is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointOpensearchFunc(ctx, name, req)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointOpensearchAndWait(ctx context.Context, name string, req DBAASEndpointOpensearchInputCreate, opts ...WaitOpt) (*DBAASEndpointOpensearchOutput, error) {
+	if mc.CreateDBAASExternalEndpointOpensearchAndWaitFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointOpensearchAndWait is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointOpensearchAndWaitFunc(ctx, name, req, opts...)
+}
+
+func (mc *MockClient) DeleteDBAASExternalEndpointPrometheus(ctx context.Context, endpointID UUID) (*Operation, error) {
+	if mc.DeleteDBAASExternalEndpointPrometheusFunc == nil {
+		panic("MockClient: DeleteDBAASExternalEndpointPrometheus is not implemented")
+	}
+
+	return mc.DeleteDBAASExternalEndpointPrometheusFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) GetDBAASExternalEndpointPrometheus(ctx context.Context, endpointID UUID) (*DBAASEndpointExternalPrometheusOutput, error) {
+	if mc.GetDBAASExternalEndpointPrometheusFunc == nil {
+		panic("MockClient: GetDBAASExternalEndpointPrometheus is not implemented")
+	}
+
+	return mc.GetDBAASExternalEndpointPrometheusFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointPrometheus(ctx context.Context, endpointID UUID, req DBAASEndpointPrometheusPayload) (*Operation, error) {
+	if mc.UpdateDBAASExternalEndpointPrometheusFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointPrometheus is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointPrometheusFunc(ctx, endpointID, req)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointPrometheusAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointPrometheusPayload, opts ...WaitOpt) (*DBAASEndpointExternalPrometheusOutput, error) {
+	if mc.UpdateDBAASExternalEndpointPrometheusAndWaitFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointPrometheusAndWait is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointPrometheusAndWaitFunc(ctx, endpointID, req, opts...)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointPrometheus(ctx context.Context, name string, req DBAASEndpointPrometheusPayload) (*Operation, error) {
+	if mc.CreateDBAASExternalEndpointPrometheusFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointPrometheus is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointPrometheusFunc(ctx, name, req)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointPrometheusAndWait(ctx context.Context, name string, req DBAASEndpointPrometheusPayload, opts ...WaitOpt) (*DBAASEndpointExternalPrometheusOutput, error) {
+	if mc.CreateDBAASExternalEndpointPrometheusAndWaitFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointPrometheusAndWait is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointPrometheusAndWaitFunc(ctx, name, req, opts...)
+}
+
+func (mc *MockClient) DeleteDBAASExternalEndpointRsyslog(ctx context.Context, endpointID UUID) (*Operation, error) {
+	if mc.DeleteDBAASExternalEndpointRsyslogFunc == nil {
+		panic("MockClient: DeleteDBAASExternalEndpointRsyslog is not implemented")
+	}
+
+	return mc.DeleteDBAASExternalEndpointRsyslogFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) GetDBAASExternalEndpointRsyslog(ctx context.Context, endpointID UUID) (*DBAASExternalEndpointRsyslogOutput, error) {
+	if mc.GetDBAASExternalEndpointRsyslogFunc == nil {
+		panic("MockClient: GetDBAASExternalEndpointRsyslog is not implemented")
+	}
+
+	return mc.GetDBAASExternalEndpointRsyslogFunc(ctx, endpointID)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointRsyslog(ctx context.Context, endpointID UUID, req DBAASEndpointRsyslogInputUpdate) (*Operation, error) {
+	if mc.UpdateDBAASExternalEndpointRsyslogFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointRsyslog is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointRsyslogFunc(ctx, endpointID, req)
+}
+
+func (mc *MockClient) UpdateDBAASExternalEndpointRsyslogAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointRsyslogInputUpdate, opts ...WaitOpt) (*DBAASExternalEndpointRsyslogOutput, error) {
+	if mc.UpdateDBAASExternalEndpointRsyslogAndWaitFunc == nil {
+		panic("MockClient: UpdateDBAASExternalEndpointRsyslogAndWait is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalEndpointRsyslogAndWaitFunc(ctx, endpointID, req, opts...)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointRsyslog(ctx context.Context, name string, req DBAASEndpointRsyslogInputCreate) (*Operation, error) {
+	if mc.CreateDBAASExternalEndpointRsyslogFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointRsyslog is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointRsyslogFunc(ctx, name, req)
+}
+
+func (mc *MockClient) CreateDBAASExternalEndpointRsyslogAndWait(ctx context.Context, name string, req DBAASEndpointRsyslogInputCreate, opts ...WaitOpt) (*DBAASExternalEndpointRsyslogOutput, error) {
+	if mc.CreateDBAASExternalEndpointRsyslogAndWaitFunc == nil {
+		panic("MockClient: CreateDBAASExternalEndpointRsyslogAndWait is not implemented")
+	}
+
+	return mc.CreateDBAASExternalEndpointRsyslogAndWaitFunc(ctx, name, req, opts...)
+}
+
+func (mc *MockClient) ListDBAASExternalEndpointTypes(ctx context.Context) (*ListDBAASExternalEndpointTypesResponse, error) {
+	if mc.ListDBAASExternalEndpointTypesFunc == nil {
+		panic("MockClient: ListDBAASExternalEndpointTypes is not implemented")
+	}
+
+	return mc.ListDBAASExternalEndpointTypesFunc(ctx)
+}
+
+func (mc *MockClient) AllDBAASExternalEndpointTypes(ctx context.Context) iter.Seq2[ListDBAASExternalEndpointTypesResponseEndpointTypes, error] {
+	if mc.AllDBAASExternalEndpointTypesFunc == nil {
+		panic("MockClient: AllDBAASExternalEndpointTypes is not implemented")
+	}
+
+	return mc.AllDBAASExternalEndpointTypesFunc(ctx)
+}
+
+func (mc *MockClient) AttachDBAASServiceToEndpoint(ctx context.Context, sourceServiceName string, req AttachDBAASServiceToEndpointRequest) (*Operation, error) {
+	if mc.AttachDBAASServiceToEndpointFunc == nil {
+		panic("MockClient: AttachDBAASServiceToEndpoint is not implemented")
+	}
+
+	return mc.AttachDBAASServiceToEndpointFunc(ctx, sourceServiceName, req)
+}
+
+func (mc *MockClient) DetachDBAASServiceFromEndpoint(ctx context.Context, sourceServiceName string, req DetachDBAASServiceFromEndpointRequest) (*Operation, error) {
+	if mc.DetachDBAASServiceFromEndpointFunc == nil {
+		panic("MockClient: DetachDBAASServiceFromEndpoint is not implemented")
+	}
+
+	return mc.DetachDBAASServiceFromEndpointFunc(ctx, sourceServiceName, req)
+}
+
+func (mc *MockClient) ListDBAASExternalEndpoints(ctx context.Context) (*ListDBAASExternalEndpointsResponse, error) {
+	if mc.ListDBAASExternalEndpointsFunc == nil {
+		panic("MockClient: ListDBAASExternalEndpoints is not implemented")
+	}
+
+	return mc.ListDBAASExternalEndpointsFunc(ctx)
+}
+
+func (mc *MockClient) AllDBAASExternalEndpoints(ctx context.Context) iter.Seq2[DBAASExternalEndpoint, error] {
+	if mc.AllDBAASExternalEndpointsFunc == nil {
+		panic("MockClient: AllDBAASExternalEndpoints is not implemented")
+	}
+
+	return mc.AllDBAASExternalEndpointsFunc(ctx)
+}
+
+func (mc *MockClient) GetDBAASExternalIntegrationSettingsDatadog(ctx context.Context, integrationID UUID) (*GetDBAASExternalIntegrationSettingsDatadogResponse, error) {
+	if mc.GetDBAASExternalIntegrationSettingsDatadogFunc == nil {
+		panic("MockClient: GetDBAASExternalIntegrationSettingsDatadog is not implemented")
+	}
+
+	return mc.GetDBAASExternalIntegrationSettingsDatadogFunc(ctx, integrationID)
+}
+
+func (mc *MockClient) UpdateDBAASExternalIntegrationSettingsDatadog(ctx context.Context, integrationID UUID, req UpdateDBAASExternalIntegrationSettingsDatadogRequest) (*Operation, error) {
+	if mc.UpdateDBAASExternalIntegrationSettingsDatadogFunc == nil {
+		panic("MockClient: UpdateDBAASExternalIntegrationSettingsDatadog is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalIntegrationSettingsDatadogFunc(ctx, integrationID, req)
+}
+
+func (mc *MockClient) UpdateDBAASExternalIntegrationSettingsDatadogAndWait(ctx context.Context, integrationID UUID, req UpdateDBAASExternalIntegrationSettingsDatadogRequest, opts ...WaitOpt) (*GetDBAASExternalIntegrationSettingsDatadogResponse, error) {
+	if mc.UpdateDBAASExternalIntegrationSettingsDatadogAndWaitFunc == nil {
+		panic("MockClient: UpdateDBAASExternalIntegrationSettingsDatadogAndWait is not implemented")
+	}
+
+	return mc.UpdateDBAASExternalIntegrationSettingsDatadogAndWaitFunc(ctx, integrationID, req, opts...)
+}
+
+func (mc *MockClient) GetDBAASExternalIntegration(ctx context.Context, integrationID UUID) (*DBAASExternalIntegration, error) {
+	if mc.GetDBAASExternalIntegrationFunc == nil {
+		panic("MockClient: GetDBAASExternalIntegration is not implemented")
+	}
+
+	return mc.GetDBAASExternalIntegrationFunc(ctx, integrationID)
+}
+
+func (mc *MockClient) ListDBAASExternalIntegrations(ctx context.Context, serviceName string) (*ListDBAASExternalIntegrationsResponse, error) {
+	if mc.ListDBAASExternalIntegrationsFunc == nil {
+		panic("MockClient: ListDBAASExternalIntegrations is not implemented")
+	}
+
+	return mc.ListDBAASExternalIntegrationsFunc(ctx, serviceName)
+}
+
+func (mc *MockClient) AllDBAASExternalIntegrations(ctx context.Context, serviceName string) iter.Seq2[DBAASExternalIntegration, error] {
+	if mc.AllDBAASExternalIntegrationsFunc == nil {
+		panic("MockClient: AllDBAASExternalIntegrations is not implemented")
+	}
+
+	return mc.AllDBAASExternalIntegrationsFunc(ctx, serviceName)
+}
+
+func (mc *MockClient) DeleteDBAASServiceGrafana(ctx context.Context, name string) (*Operation, error) {
+	if mc.DeleteDBAASServiceGrafanaFunc == nil {
+		panic("MockClient: DeleteDBAASServiceGrafana is not implemented")
+	}
+
+	return mc.DeleteDBAASServiceGrafanaFunc(ctx, name)
+}
+
+func (mc *MockClient) GetDBAASServiceGrafana(ctx context.Context, name string) (*DBAASServiceGrafana, error) {
+	if mc.GetDBAASServiceGrafanaFunc == nil {
+		panic("MockClient: GetDBAASServiceGrafana is not implemented")
+	}
+
+	return mc.GetDBAASServiceGrafanaFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASServiceGrafana(ctx context.Context, name string, req CreateDBAASServiceGrafanaRequest) (*Operation, error) {
+	if mc.CreateDBAASServiceGrafanaFunc == nil {
+		panic("MockClient: CreateDBAASServiceGrafana is not implemented")
+	}
+
+	return mc.CreateDBAASServiceGrafanaFunc(ctx, name, req)
+}
+
+func (mc *MockClient) UpdateDBAASServiceGrafana(ctx context.Context, name string, req UpdateDBAASServiceGrafanaRequest) (*Operation, error) {
+	if mc.UpdateDBAASServiceGrafanaFunc == nil {
+		panic("MockClient: UpdateDBAASServiceGrafana is not implemented")
+	}
+
+	return mc.UpdateDBAASServiceGrafanaFunc(ctx, name, req)
+}
+
+func (mc *MockClient) StartDBAASGrafanaMaintenance(ctx context.Context, name string) (*Operation, error) {
+	if mc.StartDBAASGrafanaMaintenanceFunc == nil {
+		panic("MockClient: StartDBAASGrafanaMaintenance is not implemented")
+	}
+
+	return mc.StartDBAASGrafanaMaintenanceFunc(ctx, name)
+}
+
+func (mc *MockClient) ResetDBAASGrafanaUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASGrafanaUserPasswordRequest) (*Operation, error) {
+	if mc.ResetDBAASGrafanaUserPasswordFunc == nil {
+		panic("MockClient: ResetDBAASGrafanaUserPassword is not implemented")
+	}
+
+	return mc.ResetDBAASGrafanaUserPasswordFunc(ctx, serviceName, username, req)
+}
+
+func (mc *MockClient) RevealDBAASGrafanaUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserGrafanaSecrets, error) {
+	if mc.RevealDBAASGrafanaUserPasswordFunc == nil {
+		panic("MockClient: RevealDBAASGrafanaUserPassword is not implemented")
+	}
+
+	return mc.RevealDBAASGrafanaUserPasswordFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) CreateDBAASIntegration(ctx context.Context, req CreateDBAASIntegrationRequest) (*Operation, error) {
+	if mc.CreateDBAASIntegrationFunc == nil {
+		panic("MockClient: CreateDBAASIntegration is not implemented")
+	}
+
+	return mc.CreateDBAASIntegrationFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateDBAASIntegrationAndWait(ctx context.Context, req CreateDBAASIntegrationRequest, opts ...WaitOpt) (*DBAASIntegration, error) {
+	if mc.CreateDBAASIntegrationAndWaitFunc == nil {
+		panic("MockClient: CreateDBAASIntegrationAndWait is not implemented")
+	}
+
+	return mc.CreateDBAASIntegrationAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) ListDBAASIntegrationSettings(ctx context.Context, integrationType string, sourceType string, destType string) (*ListDBAASIntegrationSettingsResponse, error) {
+	if mc.ListDBAASIntegrationSettingsFunc == nil {
+		panic("MockClient: ListDBAASIntegrationSettings is not implemented")
+	}
+
+	return mc.ListDBAASIntegrationSettingsFunc(ctx, integrationType, sourceType, destType)
+}
+
+func (mc *MockClient) ListDBAASIntegrationTypes(ctx context.Context) (*ListDBAASIntegrationTypesResponse, error) {
+	if mc.ListDBAASIntegrationTypesFunc == nil {
+		panic("MockClient: ListDBAASIntegrationTypes is not implemented")
+	}
+
+	return mc.ListDBAASIntegrationTypesFunc(ctx)
+}
+
+func (mc *MockClient) AllDBAASIntegrationTypes(ctx context.Context) iter.Seq2[DBAASIntegrationType, error] {
+	if mc.AllDBAASIntegrationTypesFunc == nil {
+		panic("MockClient: AllDBAASIntegrationTypes is not implemented")
+	}
+
+	return mc.AllDBAASIntegrationTypesFunc(ctx)
+}
+
+func (mc *MockClient) DeleteDBAASIntegration(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteDBAASIntegrationFunc == nil {
+		panic("MockClient: DeleteDBAASIntegration is not implemented")
+	}
+
+	return mc.DeleteDBAASIntegrationFunc(ctx, id)
+}
+
+func (mc *MockClient) GetDBAASIntegration(ctx context.Context, id UUID) (*DBAASIntegration, error) {
+	if mc.GetDBAASIntegrationFunc == nil {
+		panic("MockClient: GetDBAASIntegration is not implemented")
+	}
+
+	return mc.GetDBAASIntegrationFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateDBAASIntegration(ctx context.Context, id UUID, req UpdateDBAASIntegrationRequest) (*Operation, error) {
+	if mc.UpdateDBAASIntegrationFunc == nil {
+		panic("MockClient: UpdateDBAASIntegration is not implemented")
+	}
+
+	return mc.UpdateDBAASIntegrationFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateDBAASIntegrationAndWait(ctx context.Context, id UUID, req UpdateDBAASIntegrationRequest, opts ...WaitOpt) (*DBAASIntegration, error) {
+	if mc.UpdateDBAASIntegrationAndWaitFunc == nil {
+		panic("MockClient: UpdateDBAASIntegrationAndWait is not implemented")
+	}
+
+	return mc.UpdateDBAASIntegrationAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) DeleteDBAASServiceKafka(ctx context.Context, name string) (*Operation, error) {
+	if mc.DeleteDBAASServiceKafkaFunc == nil {
+		panic("MockClient: DeleteDBAASServiceKafka is not implemented")
+	}
+
+	return mc.DeleteDBAASServiceKafkaFunc(ctx, name)
+}
+
+func (mc *MockClient) GetDBAASServiceKafka(ctx context.Context, name string) (*DBAASServiceKafka, error) {
+	if mc.GetDBAASServiceKafkaFunc == nil {
+		panic("MockClient: GetDBAASServiceKafka is not implemented")
+	}
+
+	return mc.GetDBAASServiceKafkaFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASServiceKafka(ctx context.Context, name string, req CreateDBAASServiceKafkaRequest) (*Operation, error) {
+	if mc.CreateDBAASServiceKafkaFunc == nil {
+		panic("MockClient: CreateDBAASServiceKafka is not implemented")
+	}
+
+	return mc.CreateDBAASServiceKafkaFunc(ctx, name, req)
+}
+
+func (mc *MockClient) UpdateDBAASServiceKafka(ctx context.Context, name string, req UpdateDBAASServiceKafkaRequest) (*Operation, error) {
+	if mc.UpdateDBAASServiceKafkaFunc == nil {
+		panic("MockClient: UpdateDBAASServiceKafka is not implemented")
+	}
+
+	return mc.UpdateDBAASServiceKafkaFunc(ctx, name, req)
+}
+
+func (mc *MockClient) GetDBAASKafkaAclConfig(ctx context.Context, name string) (*DBAASKafkaAcls, error) {
+	if mc.GetDBAASKafkaAclConfigFunc == nil {
+		panic("MockClient: GetDBAASKafkaAclConfig is not implemented")
+	}
+
+	return mc.GetDBAASKafkaAclConfigFunc(ctx, name)
+}
+
+func (mc *MockClient) StartDBAASKafkaMaintenance(ctx context.Context, name string) (*Operation, error) {
+	if mc.StartDBAASKafkaMaintenanceFunc == nil {
+		panic("MockClient: StartDBAASKafkaMaintenance is not implemented")
+	}
+
+	return mc.StartDBAASKafkaMaintenanceFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, name string, req DBAASKafkaSchemaRegistryAclEntry) (*Operation, error) {
+	if mc.CreateDBAASKafkaSchemaRegistryAclConfigFunc == nil {
+		panic("MockClient: CreateDBAASKafkaSchemaRegistryAclConfig is not implemented")
+	}
+
+	return mc.CreateDBAASKafkaSchemaRegistryAclConfigFunc(ctx, name, req)
+}
+
+func (mc *MockClient) DeleteDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, name string, aclID string) (*Operation, error) {
+	if mc.DeleteDBAASKafkaSchemaRegistryAclConfigFunc == nil {
+		panic("MockClient: DeleteDBAASKafkaSchemaRegistryAclConfig is not implemented")
+	}
+
+	return mc.DeleteDBAASKafkaSchemaRegistryAclConfigFunc(ctx, name, aclID)
+}
+
+func (mc *MockClient) CreateDBAASKafkaTopicAclConfig(ctx context.Context, name string, req DBAASKafkaTopicAclEntry) (*Operation, error) {
+	if mc.CreateDBAASKafkaTopicAclConfigFunc == nil {
+		panic("MockClient: CreateDBAASKafkaTopicAclConfig is not implemented")
+	}
+
+	return mc.CreateDBAASKafkaTopicAclConfigFunc(ctx, name, req)
+}
+
+func (mc *MockClient) DeleteDBAASKafkaTopicAclConfig(ctx context.Context, name string, aclID string) (*Operation, error) {
+	if mc.DeleteDBAASKafkaTopicAclConfigFunc == nil {
+		panic("MockClient: DeleteDBAASKafkaTopicAclConfig is not implemented")
+	}
+
+	return mc.DeleteDBAASKafkaTopicAclConfigFunc(ctx, name, aclID)
+}
+
+func (mc *MockClient) RevealDBAASKafkaConnectPassword(ctx context.Context, serviceName string) (*DBAASUserKafkaConnectSecrets, error) {
+	if mc.RevealDBAASKafkaConnectPasswordFunc == nil {
+		panic("MockClient: RevealDBAASKafkaConnectPassword is not implemented")
+	}
+
+	return mc.RevealDBAASKafkaConnectPasswordFunc(ctx, serviceName)
+}
+
+func (mc *MockClient) CreateDBAASKafkaUser(ctx context.Context, serviceName string, req CreateDBAASKafkaUserRequest) (*Operation, error) {
+	if mc.CreateDBAASKafkaUserFunc == nil {
+		panic("MockClient: CreateDBAASKafkaUser is not implemented")
+	}
+
+	return mc.CreateDBAASKafkaUserFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) DeleteDBAASKafkaUser(ctx context.Context, serviceName string, username string) (*Operation, error) {
+	if mc.DeleteDBAASKafkaUserFunc == nil {
+		panic("MockClient: DeleteDBAASKafkaUser is not implemented")
+	}
+
+	return mc.DeleteDBAASKafkaUserFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) ResetDBAASKafkaUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASKafkaUserPasswordRequest) (*Operation, error) {
+	if mc.ResetDBAASKafkaUserPasswordFunc == nil {
+		panic("MockClient: ResetDBAASKafkaUserPassword is not implemented")
+	}
+
+	return mc.ResetDBAASKafkaUserPasswordFunc(ctx, serviceName, username, req)
+}
+
+func (mc *MockClient) RevealDBAASKafkaUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserKafkaSecrets, error) {
+	if mc.RevealDBAASKafkaUserPasswordFunc == nil {
+		panic("MockClient: RevealDBAASKafkaUserPassword is not implemented")
+	}
+
+	return mc.RevealDBAASKafkaUserPasswordFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) GetDBAASMigrationStatus(ctx context.Context, name string) (*DBAASMigrationStatus, error) {
+	if mc.GetDBAASMigrationStatusFunc == nil {
+		panic("MockClient: GetDBAASMigrationStatus is not implemented")
+	}
+
+	return mc.GetDBAASMigrationStatusFunc(ctx, name)
+}
+
+func (mc *MockClient) DeleteDBAASServiceMysql(ctx context.Context, name string) (*Operation, error) {
+	if mc.DeleteDBAASServiceMysqlFunc == nil {
+		panic("MockClient: DeleteDBAASServiceMysql is not implemented")
+	}
+
+	return mc.DeleteDBAASServiceMysqlFunc(ctx, name)
+}
+
+func (mc *MockClient) GetDBAASServiceMysql(ctx context.Context, name string) (*DBAASServiceMysql, error) {
+	if mc.GetDBAASServiceMysqlFunc == nil {
+		panic("MockClient: GetDBAASServiceMysql is not implemented")
+	}
+
+	return mc.GetDBAASServiceMysqlFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASServiceMysql(ctx context.Context, name string, req CreateDBAASServiceMysqlRequest) (*Operation, error) {
+	if mc.CreateDBAASServiceMysqlFunc == nil {
+		panic("MockClient: CreateDBAASServiceMysql is not implemented")
+	}
+
+	return mc.CreateDBAASServiceMysqlFunc(ctx, name, req)
+}
+
+func (mc *MockClient) UpdateDBAASServiceMysql(ctx context.Context, name string, req UpdateDBAASServiceMysqlRequest) (*Operation, error) {
+	if mc.UpdateDBAASServiceMysqlFunc == nil {
+		panic("MockClient: UpdateDBAASServiceMysql is not implemented")
+	}
+
+	return mc.UpdateDBAASServiceMysqlFunc(ctx, name, req)
+}
+
+func (mc *MockClient) EnableDBAASMysqlWrites(ctx context.Context, name string) (*Operation, error) {
+	if mc.EnableDBAASMysqlWritesFunc == nil {
+		panic("MockClient: EnableDBAASMysqlWrites is not implemented")
+	}
+
+	return mc.EnableDBAASMysqlWritesFunc(ctx, name)
+}
+
+func (mc *MockClient) StartDBAASMysqlMaintenance(ctx context.Context, name string) (*Operation, error) {
+	if mc.StartDBAASMysqlMaintenanceFunc == nil {
+		panic("MockClient: StartDBAASMysqlMaintenance is not implemented")
+	}
+
+	return mc.StartDBAASMysqlMaintenanceFunc(ctx, name)
+}
+
+func (mc *MockClient) StopDBAASMysqlMigration(ctx context.Context, name string) (*Operation, error) {
+	if mc.StopDBAASMysqlMigrationFunc == nil {
+		panic("MockClient: StopDBAASMysqlMigration is not implemented")
+	}
+
+	return mc.StopDBAASMysqlMigrationFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASMysqlDatabase(ctx context.Context, serviceName string, req CreateDBAASMysqlDatabaseRequest) (*Operation, error) {
+	if mc.CreateDBAASMysqlDatabaseFunc == nil {
+		panic("MockClient: CreateDBAASMysqlDatabase is not implemented")
+	}
+
+	return mc.CreateDBAASMysqlDatabaseFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) DeleteDBAASMysqlDatabase(ctx context.Context, serviceName string, databaseName string) (*Operation, error) {
+	if mc.DeleteDBAASMysqlDatabaseFunc == nil {
+		panic("MockClient: DeleteDBAASMysqlDatabase is not implemented")
+	}
+
+	return mc.DeleteDBAASMysqlDatabaseFunc(ctx, serviceName, databaseName)
+}
+
+func (mc *MockClient) CreateDBAASMysqlUser(ctx context.Context, serviceName string, req CreateDBAASMysqlUserRequest) (*Operation, error) {
+	if mc.CreateDBAASMysqlUserFunc == nil {
+		panic("MockClient: CreateDBAASMysqlUser is not implemented")
+	}
+
+	return mc.CreateDBAASMysqlUserFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) DeleteDBAASMysqlUser(ctx context.Context, serviceName string, username string) (*Operation, error) {
+	if mc.DeleteDBAASMysqlUserFunc == nil {
+		panic("MockClient: DeleteDBAASMysqlUser is not implemented")
+	}
+
+	return mc.DeleteDBAASMysqlUserFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) ResetDBAASMysqlUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASMysqlUserPasswordRequest) (*Operation, error) {
+	if mc.ResetDBAASMysqlUserPasswordFunc == nil {
+		panic("MockClient: ResetDBAASMysqlUserPassword is not implemented")
+	}
+
+	return mc.ResetDBAASMysqlUserPasswordFunc(ctx, serviceName, username, req)
+}
+
+func (mc *MockClient) RevealDBAASMysqlUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserMysqlSecrets, error) {
+	if mc.RevealDBAASMysqlUserPasswordFunc == nil {
+		panic("MockClient: RevealDBAASMysqlUserPassword is not implemented")
+	}
+
+	return mc.RevealDBAASMysqlUserPasswordFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) DeleteDBAASServiceOpensearch(ctx context.Context, name string) (*Operation, error) {
+	if mc.DeleteDBAASServiceOpensearchFunc == nil {
+		panic("MockClient: DeleteDBAASServiceOpensearch is not implemented")
+	}
+
+	return mc.DeleteDBAASServiceOpensearchFunc(ctx, name)
+}
+
+func (mc *MockClient) GetDBAASServiceOpensearch(ctx context.Context, name string) (*DBAASServiceOpensearch, error) {
+	if mc.GetDBAASServiceOpensearchFunc == nil {
+		panic("MockClient: GetDBAASServiceOpensearch is not implemented")
+	}
+
+	return mc.GetDBAASServiceOpensearchFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASServiceOpensearch(ctx context.Context, name string, req CreateDBAASServiceOpensearchRequest) (*Operation, error) {
+	if mc.CreateDBAASServiceOpensearchFunc == nil {
+		panic("MockClient: CreateDBAASServiceOpensearch is not implemented")
+	}
+
+	return mc.CreateDBAASServiceOpensearchFunc(ctx, name, req)
+}
+
+func (mc *MockClient) UpdateDBAASServiceOpensearch(ctx context.Context, name string, req UpdateDBAASServiceOpensearchRequest) (*Operation, error) {
+	if mc.UpdateDBAASServiceOpensearchFunc == nil {
+		panic("MockClient: UpdateDBAASServiceOpensearch is not implemented")
+	}
+
+	return mc.UpdateDBAASServiceOpensearchFunc(ctx, name, req)
+}
+
+func (mc *MockClient) GetDBAASOpensearchAclConfig(ctx context.Context, name string) (*DBAASOpensearchAclConfig, error) {
+	if mc.GetDBAASOpensearchAclConfigFunc == nil {
+		panic("MockClient: GetDBAASOpensearchAclConfig is not implemented")
+	}
+
+	return mc.GetDBAASOpensearchAclConfigFunc(ctx, name)
+}
+
+func (mc *MockClient) UpdateDBAASOpensearchAclConfig(ctx context.Context, name string, req DBAASOpensearchAclConfig) (*Operation, error) {
+	if mc.UpdateDBAASOpensearchAclConfigFunc == nil {
+		panic("MockClient: UpdateDBAASOpensearchAclConfig is not implemented")
+	}
+
+	return mc.UpdateDBAASOpensearchAclConfigFunc(ctx, name, req)
+}
+
+func (mc *MockClient) StartDBAASOpensearchMaintenance(ctx context.Context, name string) (*Operation, error) {
+	if mc.StartDBAASOpensearchMaintenanceFunc == nil {
+		panic("MockClient: StartDBAASOpensearchMaintenance is not implemented")
+	}
+
+	return mc.StartDBAASOpensearchMaintenanceFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASOpensearchUser(ctx context.Context, serviceName string, req CreateDBAASOpensearchUserRequest) (*Operation, error) {
+	if mc.CreateDBAASOpensearchUserFunc == nil {
+		panic("MockClient: CreateDBAASOpensearchUser is not implemented")
+	}
+
+	return mc.CreateDBAASOpensearchUserFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) DeleteDBAASOpensearchUser(ctx context.Context, serviceName string, username string) (*Operation, error) {
+	if mc.DeleteDBAASOpensearchUserFunc == nil {
+		panic("MockClient: DeleteDBAASOpensearchUser is not implemented")
+	}
+
+	return mc.DeleteDBAASOpensearchUserFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) ResetDBAASOpensearchUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASOpensearchUserPasswordRequest) (*Operation, error) {
+	if mc.ResetDBAASOpensearchUserPasswordFunc == nil {
+		panic("MockClient: ResetDBAASOpensearchUserPassword is not implemented")
+	}
+
+	return mc.ResetDBAASOpensearchUserPasswordFunc(ctx, serviceName, username, req)
+}
+
+func (mc *MockClient) RevealDBAASOpensearchUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserOpensearchSecrets, error) {
+	if mc.RevealDBAASOpensearchUserPasswordFunc == nil {
+		panic("MockClient: RevealDBAASOpensearchUserPassword is not implemented")
+	}
+
+	return mc.RevealDBAASOpensearchUserPasswordFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) DeleteDBAASServicePG(ctx context.Context, name string) (*Operation, error) {
+	if mc.DeleteDBAASServicePGFunc == nil {
+		panic("MockClient: DeleteDBAASServicePG is not implemented")
+	}
+
+	return mc.DeleteDBAASServicePGFunc(ctx, name)
+}
+
+func (mc *MockClient) GetDBAASServicePG(ctx context.Context, name string) (*DBAASServicePG, error) {
+	if mc.GetDBAASServicePGFunc == nil {
+		panic("MockClient: GetDBAASServicePG is not implemented")
+	}
+
+	return mc.GetDBAASServicePGFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASServicePG(ctx context.Context, name string, req CreateDBAASServicePGRequest) (*Operation, error) {
+	if mc.CreateDBAASServicePGFunc == nil {
+		panic("MockClient: CreateDBAASServicePG is not implemented")
+	}
+
+	return mc.CreateDBAASServicePGFunc(ctx, name, req)
+}
+
+func (mc *MockClient) UpdateDBAASServicePG(ctx context.Context, name string, req UpdateDBAASServicePGRequest) (*Operation, error) {
+	if mc.UpdateDBAASServicePGFunc == nil {
+		panic("MockClient: UpdateDBAASServicePG is not implemented")
+	}
+
+	return mc.UpdateDBAASServicePGFunc(ctx, name, req)
+}
+
+func (mc *MockClient) StartDBAASPGMaintenance(ctx context.Context, name string) (*Operation, error) {
+	if mc.StartDBAASPGMaintenanceFunc == nil {
+		panic("MockClient: StartDBAASPGMaintenance is not implemented")
+	}
+
+	return mc.StartDBAASPGMaintenanceFunc(ctx, name)
+}
+
+func (mc *MockClient) StopDBAASPGMigration(ctx context.Context, name string) (*Operation, error) {
+	if mc.StopDBAASPGMigrationFunc == nil {
+		panic("MockClient: StopDBAASPGMigration is not implemented")
+	}
+
+	return mc.StopDBAASPGMigrationFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASPGConnectionPool(ctx context.Context, serviceName string, req CreateDBAASPGConnectionPoolRequest) (*Operation, error) {
+	if mc.CreateDBAASPGConnectionPoolFunc == nil {
+		panic("MockClient: CreateDBAASPGConnectionPool is not implemented")
+	}
+
+	return mc.CreateDBAASPGConnectionPoolFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) DeleteDBAASPGConnectionPool(ctx context.Context, serviceName string, connectionPoolName string) (*Operation, error) {
+	if mc.DeleteDBAASPGConnectionPoolFunc == nil {
+		panic("MockClient: DeleteDBAASPGConnectionPool is not implemented")
+	}
+
+	return mc.DeleteDBAASPGConnectionPoolFunc(ctx, serviceName, connectionPoolName)
+}
+
+func (mc *MockClient) UpdateDBAASPGConnectionPool(ctx context.Context, serviceName string, connectionPoolName string, req UpdateDBAASPGConnectionPoolRequest) (*Operation, error) {
+	if mc.UpdateDBAASPGConnectionPoolFunc == nil {
+		panic("MockClient: UpdateDBAASPGConnectionPool is not implemented")
+	}
+
+	return mc.UpdateDBAASPGConnectionPoolFunc(ctx, serviceName, connectionPoolName, req)
+}
+
+func (mc *MockClient) CreateDBAASPGDatabase(ctx context.Context, serviceName string, req CreateDBAASPGDatabaseRequest) (*Operation, error) {
+	if mc.CreateDBAASPGDatabaseFunc == nil {
+		panic("MockClient: CreateDBAASPGDatabase is not implemented")
+	}
+
+	return mc.CreateDBAASPGDatabaseFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) DeleteDBAASPGDatabase(ctx context.Context, serviceName string, databaseName string) (*Operation, error) {
+	if mc.DeleteDBAASPGDatabaseFunc == nil {
+		panic("MockClient: DeleteDBAASPGDatabase is not implemented")
+	}
+
+	return mc.DeleteDBAASPGDatabaseFunc(ctx, serviceName, databaseName)
+}
+
+func (mc *MockClient) CreateDBAASPostgresUser(ctx context.Context, serviceName string, req CreateDBAASPostgresUserRequest) (*Operation, error) {
+	if mc.CreateDBAASPostgresUserFunc == nil {
+		panic("MockClient: CreateDBAASPostgresUser is not implemented")
+	}
+
+	return mc.CreateDBAASPostgresUserFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) DeleteDBAASPostgresUser(ctx context.Context, serviceName string, username string) (*Operation, error) {
+	if mc.DeleteDBAASPostgresUserFunc == nil {
+		panic("MockClient: DeleteDBAASPostgresUser is not implemented")
+	}
+
+	return mc.DeleteDBAASPostgresUserFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) UpdateDBAASPostgresAllowReplication(ctx context.Context, serviceName string, username string, req UpdateDBAASPostgresAllowReplicationRequest) (*DBAASPostgresUsers, error) {
+	if mc.UpdateDBAASPostgresAllowReplicationFunc == nil {
+		panic("MockClient: UpdateDBAASPostgresAllowReplication is not implemented")
+	}
+
+	return mc.UpdateDBAASPostgresAllowReplicationFunc(ctx, serviceName, username, req)
+}
+
+func (mc *MockClient) ResetDBAASPostgresUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASPostgresUserPasswordRequest) (*Operation, error) {
+	if mc.ResetDBAASPostgresUserPasswordFunc == nil {
+		panic("MockClient: ResetDBAASPostgresUserPassword is not implemented")
+	}
+
+	return mc.ResetDBAASPostgresUserPasswordFunc(ctx, serviceName, username, req)
+}
+
+func (mc *MockClient) RevealDBAASPostgresUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserPostgresSecrets, error) {
+	if mc.RevealDBAASPostgresUserPasswordFunc == nil {
+		panic("MockClient: RevealDBAASPostgresUserPassword is not implemented")
+	}
+
+	return mc.RevealDBAASPostgresUserPasswordFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) CreateDBAASPGUpgradeCheck(ctx context.Context, service string, req CreateDBAASPGUpgradeCheckRequest) (*DBAASTask, error) {
+	if mc.CreateDBAASPGUpgradeCheckFunc == nil {
+		panic("MockClient: CreateDBAASPGUpgradeCheck is not implemented")
+	}
+
+	return mc.CreateDBAASPGUpgradeCheckFunc(ctx, service, req)
+}
+
+func (mc *MockClient) ListDBAASServices(ctx context.Context) (*ListDBAASServicesResponse, error) {
+	if mc.ListDBAASServicesFunc == nil {
+		panic("MockClient: ListDBAASServices is not implemented")
+	}
+
+	return mc.ListDBAASServicesFunc(ctx)
+}
+
+func (mc *MockClient) AllDBAASServices(ctx context.Context) iter.Seq2[DBAASServiceCommon, error] {
+	if mc.AllDBAASServicesFunc == nil {
+		panic("MockClient: AllDBAASServices is not implemented")
+	}
+
+	return mc.AllDBAASServicesFunc(ctx)
+}
+
+func (mc *MockClient) GetDBAASServiceLogs(ctx context.Context, serviceName string, req GetDBAASServiceLogsRequest) (*DBAASServiceLogs, error) {
+	if mc.GetDBAASServiceLogsFunc == nil {
+		panic("MockClient: GetDBAASServiceLogs is not implemented")
+	}
+
+	return mc.GetDBAASServiceLogsFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) GetDBAASServiceMetrics(ctx context.Context, serviceName string, req GetDBAASServiceMetricsRequest) (*GetDBAASServiceMetricsResponse, error) {
+	if mc.GetDBAASServiceMetricsFunc == nil {
+		panic("MockClient: GetDBAASServiceMetrics is not implemented")
+	}
+
+	return mc.GetDBAASServiceMetricsFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) ListDBAASServiceTypes(ctx context.Context) (*ListDBAASServiceTypesResponse, error) {
+	if mc.ListDBAASServiceTypesFunc == nil {
+		panic("MockClient: ListDBAASServiceTypes is not implemented")
+	}
+
+	return mc.ListDBAASServiceTypesFunc(ctx)
+}
+
+func (mc *MockClient) AllDBAASServiceTypes(ctx context.Context) iter.Seq2[DBAASServiceType, error] {
+	if mc.AllDBAASServiceTypesFunc == nil {
+		panic("MockClient: AllDBAASServiceTypes is not implemented")
+	}
+
+	return mc.AllDBAASServiceTypesFunc(ctx)
+}
+
+func (mc *MockClient) GetDBAASServiceType(ctx context.Context, serviceTypeName string) (*DBAASServiceType, error) {
+	if mc.GetDBAASServiceTypeFunc == nil {
+		panic("MockClient: GetDBAASServiceType is not implemented")
+	}
+
+	return mc.GetDBAASServiceTypeFunc(ctx, serviceTypeName)
+}
+
+func (mc *MockClient) DeleteDBAASService(ctx context.Context, name string) (*Operation, error) {
+	if mc.DeleteDBAASServiceFunc == nil {
+		panic("MockClient: DeleteDBAASService is not implemented")
+	}
+
+	return mc.DeleteDBAASServiceFunc(ctx, name)
+}
+
+func (mc *MockClient) GetDBAASSettingsGrafana(ctx context.Context) (*GetDBAASSettingsGrafanaResponse, error) {
+	if mc.GetDBAASSettingsGrafanaFunc == nil {
+		panic("MockClient: GetDBAASSettingsGrafana is not implemented")
+	}
+
+	return mc.GetDBAASSettingsGrafanaFunc(ctx)
+}
+
+func (mc *MockClient) GetDBAASSettingsKafka(ctx context.Context) (*GetDBAASSettingsKafkaResponse, error) {
+	if mc.GetDBAASSettingsKafkaFunc == nil {
+		panic("MockClient: GetDBAASSettingsKafka is not implemented")
+	}
+
+	return mc.GetDBAASSettingsKafkaFunc(ctx)
+}
+
+func (mc *MockClient) GetDBAASSettingsMysql(ctx context.Context) (*GetDBAASSettingsMysqlResponse, error) {
+	if mc.GetDBAASSettingsMysqlFunc == nil {
+		panic("MockClient: GetDBAASSettingsMysql is not implemented")
+	}
+
+	return mc.GetDBAASSettingsMysqlFunc(ctx)
+}
+
+func (mc *MockClient) GetDBAASSettingsOpensearch(ctx context.Context) (*GetDBAASSettingsOpensearchResponse, error) {
+	if mc.GetDBAASSettingsOpensearchFunc == nil {
+		panic("MockClient: GetDBAASSettingsOpensearch is not implemented")
+	}
+
+	return mc.GetDBAASSettingsOpensearchFunc(ctx)
+}
+
+func (mc *MockClient) GetDBAASSettingsPG(ctx context.Context) (*GetDBAASSettingsPGResponse, error) {
+	if mc.GetDBAASSettingsPGFunc == nil {
+		panic("MockClient: GetDBAASSettingsPG is not implemented")
+	}
+
+	return mc.GetDBAASSettingsPGFunc(ctx)
+}
+
+func (mc *MockClient) GetDBAASSettingsThanos(ctx context.Context) (*GetDBAASSettingsThanosResponse, error) {
+	if mc.GetDBAASSettingsThanosFunc == nil {
+		panic("MockClient: GetDBAASSettingsThanos is not implemented")
+	}
+
+	return mc.GetDBAASSettingsThanosFunc(ctx)
+}
+
+func (mc *MockClient) GetDBAASSettingsValkey(ctx context.Context) (*GetDBAASSettingsValkeyResponse, error) {
+	if mc.GetDBAASSettingsValkeyFunc == nil {
+		panic("MockClient: GetDBAASSettingsValkey is not implemented")
+	}
+
+	return mc.GetDBAASSettingsValkeyFunc(ctx)
+}
+
+func (mc *MockClient) CreateDBAASTaskMigrationCheck(ctx context.Context, service string, req CreateDBAASTaskMigrationCheckRequest) (*Operation, error) {
+	if mc.CreateDBAASTaskMigrationCheckFunc == nil {
+		panic("MockClient: CreateDBAASTaskMigrationCheck is not implemented")
+	}
+
+	return mc.CreateDBAASTaskMigrationCheckFunc(ctx, service, req)
+}
+
+func (mc *MockClient) GetDBAASTask(ctx context.Context, service string, id UUID) (*DBAASTask, error) {
+	if mc.GetDBAASTaskFunc == nil {
+		panic("MockClient: GetDBAASTask is not implemented")
+	}
+
+	return mc.GetDBAASTaskFunc(ctx, service, id)
+}
+
+func (mc *MockClient) DeleteDBAASServiceThanos(ctx context.Context, name string) (*Operation, error) {
+	if mc.DeleteDBAASServiceThanosFunc == nil {
+		panic("MockClient: DeleteDBAASServiceThanos is not implemented")
+	}
+
+	return mc.DeleteDBAASServiceThanosFunc(ctx, name)
+}
+
+func (mc *MockClient) GetDBAASServiceThanos(ctx context.Context, name string) (*DBAASServiceThanos, error) {
+	if mc.GetDBAASServiceThanosFunc == nil {
+		panic("MockClient: GetDBAASServiceThanos is not implemented")
+	}
+
+	return mc.GetDBAASServiceThanosFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASServiceThanos(ctx context.Context, name string, req CreateDBAASServiceThanosRequest) (*Operation, error) {
+	if mc.CreateDBAASServiceThanosFunc == nil {
+		panic("MockClient: CreateDBAASServiceThanos is not implemented")
+	}
+
+	return mc.CreateDBAASServiceThanosFunc(ctx, name, req)
+}
+
+func (mc *MockClient) UpdateDBAASServiceThanos(ctx context.Context, name string, req UpdateDBAASServiceThanosRequest) (*Operation, error) {
+	if mc.UpdateDBAASServiceThanosFunc == nil {
+		panic("MockClient: UpdateDBAASServiceThanos is not implemented")
+	}
+
+	return mc.UpdateDBAASServiceThanosFunc(ctx, name, req)
+}
+
+func (mc *MockClient) StartDBAASThanosMaintenance(ctx context.Context, name string) (*Operation, error) {
+	if mc.StartDBAASThanosMaintenanceFunc == nil {
+		panic("MockClient: StartDBAASThanosMaintenance is not implemented")
+	}
+
+	return mc.StartDBAASThanosMaintenanceFunc(ctx, name)
+}
+
+func (mc *MockClient) RevealDBAASThanosUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserThanosSecrets, error) {
+	if mc.RevealDBAASThanosUserPasswordFunc == nil {
+		panic("MockClient: RevealDBAASThanosUserPassword is not implemented")
+	}
+
+	return mc.RevealDBAASThanosUserPasswordFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) DeleteDBAASServiceValkey(ctx context.Context, name string) (*Operation, error) {
+	if mc.DeleteDBAASServiceValkeyFunc == nil {
+		panic("MockClient: DeleteDBAASServiceValkey is not implemented")
+	}
+
+	return mc.DeleteDBAASServiceValkeyFunc(ctx, name)
+}
+
+func (mc *MockClient) GetDBAASServiceValkey(ctx context.Context, name string) (*DBAASServiceValkey, error) {
+	if mc.GetDBAASServiceValkeyFunc == nil {
+		panic("MockClient: GetDBAASServiceValkey is not implemented")
+	}
+
+	return mc.GetDBAASServiceValkeyFunc(ctx, name)
+}
+
+func (mc *MockClient) CreateDBAASServiceValkey(ctx context.Context, name string, req CreateDBAASServiceValkeyRequest) (*Operation, error) {
+	if mc.CreateDBAASServiceValkeyFunc == nil {
+		panic("MockClient: CreateDBAASServiceValkey is not implemented")
+	}
+
+	return mc.CreateDBAASServiceValkeyFunc(ctx, name, req)
+}
+
+func (mc *MockClient) UpdateDBAASServiceValkey(ctx context.Context, name string, req UpdateDBAASServiceValkeyRequest) (*Operation, error) {
+	if mc.UpdateDBAASServiceValkeyFunc == nil {
+		panic("MockClient: UpdateDBAASServiceValkey is not implemented")
+	}
+
+	return mc.UpdateDBAASServiceValkeyFunc(ctx, name, req)
+}
+
+func (mc *MockClient) StartDBAASValkeyMaintenance(ctx context.Context, name string) (*Operation, error) {
+	if mc.StartDBAASValkeyMaintenanceFunc == nil {
+		panic("MockClient: StartDBAASValkeyMaintenance is not implemented")
+	}
+
+	return mc.StartDBAASValkeyMaintenanceFunc(ctx, name)
+}
+
+func (mc *MockClient) StopDBAASValkeyMigration(ctx context.Context, name string) (*Operation, error) {
+	if mc.StopDBAASValkeyMigrationFunc == nil {
+		panic("MockClient: StopDBAASValkeyMigration is not implemented")
+	}
+
+	return mc.StopDBAASValkeyMigrationFunc(ctx, name)
+}
+
+func (mc *MockClient) ListDBAASValkeyUsers(ctx context.Context, serviceName string) (*DBAASValkeyUsers, error) {
+	if mc.ListDBAASValkeyUsersFunc == nil {
+		panic("MockClient: ListDBAASValkeyUsers is not implemented")
+	}
+
+	return mc.ListDBAASValkeyUsersFunc(ctx, serviceName)
+}
+
+func (mc *MockClient) AllDBAASValkeyUsers(ctx context.Context, serviceName string) iter.Seq2[DBAASValkeyUser, error] {
+	if mc.AllDBAASValkeyUsersFunc == nil {
+		panic("MockClient: AllDBAASValkeyUsers is not implemented")
+	}
+
+	return mc.AllDBAASValkeyUsersFunc(ctx, serviceName)
+}
+
+func (mc *MockClient) CreateDBAASValkeyUser(ctx context.Context, serviceName string, req CreateDBAASValkeyUserRequest) (*Operation, error) {
+	if mc.CreateDBAASValkeyUserFunc == nil {
+		panic("MockClient: CreateDBAASValkeyUser is not implemented")
+	}
+
+	return mc.CreateDBAASValkeyUserFunc(ctx, serviceName, req)
+}
+
+func (mc *MockClient) DeleteDBAASValkeyUser(ctx context.Context, serviceName string, username string) (*Operation, error) {
+	if mc.DeleteDBAASValkeyUserFunc == nil {
+		panic("MockClient: DeleteDBAASValkeyUser is not implemented")
+	}
+
+	return mc.DeleteDBAASValkeyUserFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) UpdateDBAASValkeyUserAccessControl(ctx context.Context, serviceName string, username string, req UpdateDBAASValkeyUserAccessControlRequest) (*Operation, error) {
+	if mc.UpdateDBAASValkeyUserAccessControlFunc == nil {
+		panic("MockClient: UpdateDBAASValkeyUserAccessControl is not implemented")
+	}
+
+	return mc.UpdateDBAASValkeyUserAccessControlFunc(ctx, serviceName, username, req)
+}
+
+func (mc *MockClient) ResetDBAASValkeyUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASValkeyUserPasswordRequest) (*Operation, error) {
+	if mc.ResetDBAASValkeyUserPasswordFunc == nil {
+		panic("MockClient: ResetDBAASValkeyUserPassword is not implemented")
+	}
+
+	return mc.ResetDBAASValkeyUserPasswordFunc(ctx, serviceName, username, req)
+}
+
+func (mc *MockClient) RevealDBAASValkeyUserPassword(ctx context.Context, serviceName string, username string) (*DBAASUserValkeySecrets, error) {
+	if mc.RevealDBAASValkeyUserPasswordFunc == nil {
+		panic("MockClient: RevealDBAASValkeyUserPassword is not implemented")
+	}
+
+	return mc.RevealDBAASValkeyUserPasswordFunc(ctx, serviceName, username)
+}
+
+func (mc *MockClient) ListDNSDomains(ctx context.Context) (*ListDNSDomainsResponse, error) {
+	if mc.ListDNSDomainsFunc == nil {
+		panic("MockClient: ListDNSDomains is not implemented")
+	}
+
+	return mc.ListDNSDomainsFunc(ctx)
+}
+
+func (mc *MockClient) AllDNSDomains(ctx context.Context) iter.Seq2[DNSDomain, error] {
+	if mc.AllDNSDomainsFunc == nil {
+		panic("MockClient: AllDNSDomains is not implemented")
+	}
+
+	return mc.AllDNSDomainsFunc(ctx)
+}
+
+func (mc *MockClient) CreateDNSDomain(ctx context.Context, req CreateDNSDomainRequest) (*Operation, error) {
+	if mc.CreateDNSDomainFunc == nil {
+		panic("MockClient: CreateDNSDomain is not implemented")
+	}
+
+	return mc.CreateDNSDomainFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateDNSDomainAndWait(ctx context.Context, req CreateDNSDomainRequest, opts ...WaitOpt) (*DNSDomain, error) {
+	if mc.CreateDNSDomainAndWaitFunc == nil {
+		panic("MockClient: CreateDNSDomainAndWait is not implemented")
+	}
+
+	return mc.CreateDNSDomainAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) ListDNSDomainRecords(ctx context.Context, domainID UUID) (*ListDNSDomainRecordsResponse, error) {
+	if mc.ListDNSDomainRecordsFunc == nil {
+		panic("MockClient: ListDNSDomainRecords is not implemented")
+	}
+
+	return mc.ListDNSDomainRecordsFunc(ctx, domainID)
+}
+
+func (mc *MockClient) AllDNSDomainRecords(ctx context.Context, domainID UUID) iter.Seq2[DNSDomainRecord, error] {
+	if mc.AllDNSDomainRecordsFunc == nil {
+		panic("MockClient: AllDNSDomainRecords is not implemented")
+	}
+
+	return mc.AllDNSDomainRecordsFunc(ctx, domainID)
+}
+
+func (mc *MockClient) CreateDNSDomainRecord(ctx context.Context, domainID UUID, req CreateDNSDomainRecordRequest) (*Operation, error) {
+	if mc.CreateDNSDomainRecordFunc == nil {
+		panic("MockClient: CreateDNSDomainRecord is not implemented")
+	}
+
+	return mc.CreateDNSDomainRecordFunc(ctx, domainID, req)
+}
+
+func (mc *MockClient) DeleteDNSDomainRecord(ctx context.Context, domainID UUID, recordID UUID) (*Operation, error) {
+	if mc.DeleteDNSDomainRecordFunc == nil {
+		panic("MockClient: DeleteDNSDomainRecord is not implemented")
+	}
+
+	return mc.DeleteDNSDomainRecordFunc(ctx, domainID, recordID)
+}
+
+func (mc *MockClient) GetDNSDomainRecord(ctx context.Context, domainID UUID, recordID UUID) (*DNSDomainRecord, error) {
+	if mc.GetDNSDomainRecordFunc == nil {
+		panic("MockClient: GetDNSDomainRecord is not implemented")
+	}
+
+	return mc.GetDNSDomainRecordFunc(ctx, domainID, recordID)
+}
+
+func (mc *MockClient) UpdateDNSDomainRecord(ctx context.Context, domainID UUID, recordID UUID, req UpdateDNSDomainRecordRequest) (*Operation, error) {
+	if mc.UpdateDNSDomainRecordFunc == nil {
+		panic("MockClient: UpdateDNSDomainRecord is not implemented")
+	}
+
+	return mc.UpdateDNSDomainRecordFunc(ctx, domainID, recordID, req)
+}
+
+func (mc *MockClient) DeleteDNSDomain(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteDNSDomainFunc == nil {
+		panic("MockClient: DeleteDNSDomain is not implemented")
+	}
+
+	return mc.DeleteDNSDomainFunc(ctx, id)
+}
+
+func (mc *MockClient) GetDNSDomain(ctx context.Context, id UUID) (*DNSDomain, error) {
+	if mc.GetDNSDomainFunc == nil {
+		panic("MockClient: GetDNSDomain is not implemented")
+	}
+
+	return mc.GetDNSDomainFunc(ctx, id)
+}
+
+func (mc *MockClient) GetDNSDomainZoneFile(ctx context.Context, id UUID) (*GetDNSDomainZoneFileResponse, error) {
+	if mc.GetDNSDomainZoneFileFunc == nil {
+		panic("MockClient: GetDNSDomainZoneFile is not implemented")
+	}
+
+	return mc.GetDNSDomainZoneFileFunc(ctx, id)
+}
+
+func (mc *MockClient) ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error) {
+	if mc.ListAPIKeysFunc == nil {
+		panic("MockClient: ListAPIKeys is not implemented")
+	}
+
+	return mc.ListAPIKeysFunc(ctx)
+}
+
+func (mc *MockClient) AllAPIKeys(ctx context.Context) iter.Seq2[IAMAPIKey, error] {
+	if mc.AllAPIKeysFunc == nil {
+		panic("MockClient: AllAPIKeys is not implemented")
+	}
+
+	return mc.AllAPIKeysFunc(ctx)
+}
+
+func (mc *MockClient) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*IAMAPIKeyCreated, error) {
+	if mc.CreateAPIKeyFunc == nil {
+		panic("MockClient: CreateAPIKey is not implemented")
+	}
+
+	return mc.CreateAPIKeyFunc(ctx, req)
+}
+
+func (mc *MockClient) DeleteAPIKey(ctx context.Context, id string) (*Operation, error) {
+	if mc.DeleteAPIKeyFunc == nil {
+		panic("MockClient: DeleteAPIKey is not implemented")
+	}
+
+	return mc.DeleteAPIKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) GetAPIKey(ctx context.Context, id string) (*IAMAPIKey, error) {
+	if mc.GetAPIKeyFunc == nil {
+		panic("MockClient: GetAPIKey is not implemented")
+	}
+
+	return mc.GetAPIKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) GetIAMOrganizationPolicy(ctx context.Context) (*IAMPolicy, error) {
+	if mc.GetIAMOrganizationPolicyFunc == nil {
+		panic("MockClient: GetIAMOrganizationPolicy is not implemented")
+	}
+
+	return mc.GetIAMOrganizationPolicyFunc(ctx)
+}
+
+func (mc *MockClient) UpdateIAMOrganizationPolicy(ctx context.Context, req IAMPolicy) (*Operation, error) {
+	if mc.UpdateIAMOrganizationPolicyFunc == nil {
+		panic("MockClient: UpdateIAMOrganizationPolicy is not implemented")
+	}
+
+	return mc.UpdateIAMOrganizationPolicyFunc(ctx, req)
+}
+
+func (mc *MockClient) ResetIAMOrganizationPolicy(ctx context.Context) (*Operation, error) {
+	if mc.ResetIAMOrganizationPolicyFunc == nil {
+		panic("MockClient: ResetIAMOrganizationPolicy is not implemented")
+	}
+
+	return mc.ResetIAMOrganizationPolicyFunc(ctx)
+}
+
+func (mc *MockClient) ListIAMRoles(ctx context.Context) (*ListIAMRolesResponse, error) {
+	if mc.ListIAMRolesFunc == nil {
+		panic("MockClient: ListIAMRoles is not implemented")
+	}
+
+	return mc.ListIAMRolesFunc(ctx)
+}
+
+func (mc *MockClient) AllIAMRoles(ctx context.Context) iter.Seq2[IAMRole, error] {
+	if mc.AllIAMRolesFunc == nil {
+		panic("MockClient: AllIAMRoles is not implemented")
+	}
+
+	return mc.AllIAMRolesFunc(ctx)
+}
+
+func (mc *MockClient) CreateIAMRole(ctx context.Context, req CreateIAMRoleRequest) (*Operation, error) {
+	if mc.CreateIAMRoleFunc == nil {
+		panic("MockClient: CreateIAMRole is not implemented")
+	}
+
+	return mc.CreateIAMRoleFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateIAMRoleAndWait(ctx context.Context, req CreateIAMRoleRequest, opts ...WaitOpt) (*IAMRole, error) {
+	if mc.CreateIAMRoleAndWaitFunc == nil {
+		panic("MockClient: CreateIAMRoleAndWait is not implemented")
+	}
+
+	return mc.CreateIAMRoleAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) DeleteIAMRole(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteIAMRoleFunc == nil {
+		panic("MockClient: DeleteIAMRole is not implemented")
+	}
+
+	return mc.DeleteIAMRoleFunc(ctx, id)
+}
+
+func (mc *MockClient) GetIAMRole(ctx context.Context, id UUID) (*IAMRole, error) {
+	if mc.GetIAMRoleFunc == nil {
+		panic("MockClient: GetIAMRole is not implemented")
+	}
+
+	return mc.GetIAMRoleFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateIAMRole(ctx context.Context, id UUID, req UpdateIAMRoleRequest) (*Operation, error) {
+	if mc.UpdateIAMRoleFunc == nil {
+		panic("MockClient: UpdateIAMRole is not implemented")
+	}
+
+	return mc.UpdateIAMRoleFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateIAMRoleAndWait(ctx context.Context, id UUID, req UpdateIAMRoleRequest, opts ...WaitOpt) (*IAMRole, error) {
+	if mc.UpdateIAMRoleAndWaitFunc == nil {
+		panic("MockClient: UpdateIAMRoleAndWait is not implemented")
+	}
+
+	return mc.UpdateIAMRoleAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) UpdateIAMRoleAssumePolicy(ctx context.Context, id UUID, req IAMPolicy) (*Operation, error) {
+	if mc.UpdateIAMRoleAssumePolicyFunc == nil {
+		panic("MockClient: UpdateIAMRoleAssumePolicy is not implemented")
+	}
+
+	return mc.UpdateIAMRoleAssumePolicyFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateIAMRoleAssumePolicyAndWait(ctx context.Context, id UUID, req IAMPolicy, opts ...WaitOpt) (*IAMRole, error) {
+	if mc.UpdateIAMRoleAssumePolicyAndWaitFunc == nil {
+		panic("MockClient: UpdateIAMRoleAssumePolicyAndWait is not implemented")
+	}
+
+	return mc.UpdateIAMRoleAssumePolicyAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) UpdateIAMRolePolicy(ctx context.Context, id UUID, req IAMPolicy) (*Operation, error) {
+	if mc.UpdateIAMRolePolicyFunc == nil {
+		panic("MockClient: UpdateIAMRolePolicy is not implemented")
+	}
+
+	return mc.UpdateIAMRolePolicyFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateIAMRolePolicyAndWait(ctx context.Context, id UUID, req IAMPolicy, opts ...WaitOpt) (*IAMRole, error) {
+	if mc.UpdateIAMRolePolicyAndWaitFunc == nil {
+		panic("MockClient: UpdateIAMRolePolicyAndWait is not implemented")
+	}
+
+	return mc.UpdateIAMRolePolicyAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) AssumeIAMRole(ctx context.Context, targetRoleID UUID, req AssumeIAMRoleRequest) (*AssumeIAMRoleResponse, error) {
+	if mc.AssumeIAMRoleFunc == nil {
+		panic("MockClient: AssumeIAMRole is not implemented")
+	}
+
+	return mc.AssumeIAMRoleFunc(ctx, targetRoleID, req)
+}
+
+func (mc *MockClient) ListUsers(ctx context.Context) (*ListUsersResponse, error) {
+	if mc.ListUsersFunc == nil {
+		panic("MockClient: ListUsers is not implemented")
+	}
+
+	return mc.ListUsersFunc(ctx)
+}
+
+func (mc *MockClient) AllUsers(ctx context.Context) iter.Seq2[User, error] {
+	if mc.AllUsersFunc == nil {
+		panic("MockClient: AllUsers is not implemented")
+	}
+
+	return mc.AllUsersFunc(ctx)
+}
+
+func (mc *MockClient) CreateUser(ctx context.Context, req CreateUserRequest) (*Operation, error) {
+	if mc.CreateUserFunc == nil {
+		panic("MockClient: CreateUser is not implemented")
+	}
+
+	return mc.CreateUserFunc(ctx, req)
+}
+
+func (mc *MockClient) DeleteUser(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteUserFunc == nil {
+		panic("MockClient: DeleteUser is not implemented")
+	}
+
+	return mc.DeleteUserFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateUserRole(ctx context.Context, id UUID, req UpdateUserRoleRequest) (*Operation, error) {
+	if mc.UpdateUserRoleFunc == nil {
+		panic("MockClient: UpdateUserRole is not implemented")
+	}
+
+	return mc.UpdateUserRoleFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ListKmsKeys(ctx context.Context) (*ListKmsKeysResponse, error) {
+	if mc.ListKmsKeysFunc == nil {
+		panic("MockClient: ListKmsKeys is not implemented")
+	}
+
+	return mc.ListKmsKeysFunc(ctx)
+}
+
+func (mc *MockClient) AllKmsKeys(ctx context.Context) iter.Seq2[ListKmsKeysResponseEntry, error] {
+	if mc.AllKmsKeysFunc == nil {
+		panic("MockClient: AllKmsKeys is not implemented")
+	}
+
+	return mc.AllKmsKeysFunc(ctx)
+}
+
+func (mc *MockClient) CreateKmsKey(ctx context.Context, req CreateKmsKeyRequest) (*CreateKmsKeyResponse, error) {
+	if mc.CreateKmsKeyFunc == nil {
+		panic("MockClient: CreateKmsKey is not implemented")
+	}
+
+	return mc.CreateKmsKeyFunc(ctx, req)
+}
+
+func (mc *MockClient) GetKmsKey(ctx context.Context, id UUID) (*GetKmsKeyResponse, error) {
+	if mc.GetKmsKeyFunc == nil {
+		panic("MockClient: GetKmsKey is not implemented")
+	}
+
+	return mc.GetKmsKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) CancelKmsKeyDeletion(ctx context.Context, id UUID) (*SuccessResponse, error) {
+	if mc.CancelKmsKeyDeletionFunc == nil {
+		panic("MockClient: CancelKmsKeyDeletion is not implemented")
+	}
+
+	return mc.CancelKmsKeyDeletionFunc(ctx, id)
+}
+
+func (mc *MockClient) Decrypt(ctx context.Context, id UUID, req DecryptRequest) (*DecryptResponse, error) {
+	if mc.DecryptFunc == nil {
+		panic("MockClient: Decrypt is not implemented")
+	}
+
+	return mc.DecryptFunc(ctx, id, req)
+}
+
+func (mc *MockClient) DisableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, error) {
+	if mc.DisableKmsKeyFunc == nil {
+		panic("MockClient: DisableKmsKey is not implemented")
+	}
+
+	return mc.DisableKmsKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) DisableKmsKeyRotation(ctx context.Context, id UUID) (*DisableKmsKeyRotationResponse, error) {
+	if mc.DisableKmsKeyRotationFunc == nil {
+		panic("MockClient: DisableKmsKeyRotation is not implemented")
+	}
+
+	return mc.DisableKmsKeyRotationFunc(ctx, id)
+}
+
+func (mc *MockClient) EnableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, error) {
+	if mc.EnableKmsKeyFunc == nil {
+		panic("MockClient: EnableKmsKey is not implemented")
+	}
+
+	return mc.EnableKmsKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) EnableKmsKeyRotation(ctx context.Context, id UUID, req EnableKmsKeyRotationRequest) (*EnableKmsKeyRotationResponse, error) {
+	if mc.EnableKmsKeyRotationFunc == nil {
+		panic("MockClient: EnableKmsKeyRotation is not implemented")
+	}
+
+	return mc.EnableKmsKeyRotationFunc(ctx, id, req)
+}
+
+func (mc *MockClient) Encrypt(ctx context.Context, id UUID, req EncryptRequest) (*EncryptResponse, error) {
+	if mc.EncryptFunc == nil {
+		panic("MockClient: Encrypt is not implemented")
+	}
+
+	return mc.EncryptFunc(ctx, id, req)
+}
+
+func (mc *MockClient) GenerateDataKey(ctx context.Context, id UUID, req GenerateDataKeyRequest) (*GenerateDataKeyResponse, error) {
+	if mc.GenerateDataKeyFunc == nil {
+		panic("MockClient: GenerateDataKey is not implemented")
+	}
+
+	return mc.GenerateDataKeyFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ListKmsKeyRotations(ctx context.Context, id UUID) (*ListKmsKeyRotationsResponse, error) {
+	if mc.ListKmsKeyRotationsFunc == nil {
+		panic("MockClient: ListKmsKeyRotations is not implemented")
+	}
+
+	return mc.ListKmsKeyRotationsFunc(ctx, id)
+}
+
+func (mc *MockClient) AllKmsKeyRotations(ctx context.Context, id UUID) iter.Seq2[ListKmsKeyRotationsResponseEntry, error] {
+	if mc.AllKmsKeyRotationsFunc == nil {
+		panic("MockClient: AllKmsKeyRotations is not implemented")
+	}
+
+	return mc.AllKmsKeyRotationsFunc(ctx, id)
+}
+
+func (mc *MockClient) ReEncrypt(ctx context.Context, id UUID, req ReEncryptRequest) (*ReEncryptResponse, error) {
+	if mc.ReEncryptFunc == nil {
+		panic("MockClient: ReEncrypt is not implemented")
+	}
+
+	return mc.ReEncryptFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ReplicateKmsKey(ctx context.Context, id UUID, req ReplicateKmsKeyRequest) (*SuccessResponse, error) {
+	if mc.ReplicateKmsKeyFunc == nil {
+		panic("MockClient: ReplicateKmsKey is not implemented")
+	}
+
+	return mc.ReplicateKmsKeyFunc(ctx, id, req)
+}
+
+func (mc *MockClient) RotateKmsKey(ctx context.Context, id UUID) (*RotateKmsKeyResponse, error) {
+	if mc.RotateKmsKeyFunc == nil {
+		panic("MockClient: RotateKmsKey is not implemented")
+	}
+
+	return mc.RotateKmsKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) ScheduleKmsKeyDeletion(ctx context.Context, id UUID, req ScheduleKmsKeyDeletionRequest) (*SuccessResponse, error) {
+	if mc.ScheduleKmsKeyDeletionFunc == nil {
+		panic("MockClient: ScheduleKmsKeyDeletion is not implemented")
+	}
+
+	return mc.ScheduleKmsKeyDeletionFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ListSKSClusters(ctx context.Context) (*ListSKSClustersResponse, error) {
+	if mc.ListSKSClustersFunc == nil {
+		panic("MockClient: ListSKSClusters is not implemented")
+	}
+
+	return mc.ListSKSClustersFunc(ctx)
+}
+
+func (mc *MockClient) AllSKSClusters(ctx context.Context) iter.Seq2[SKSCluster, error] {
+	if mc.AllSKSClustersFunc == nil {
+		panic("MockClient: AllSKSClusters is not implemented")
+	}
+
+	return mc.AllSKSClustersFunc(ctx)
+}
+
+func (mc *MockClient) CreateSKSCluster(ctx context.Context, req CreateSKSClusterRequest) (*Operation, error) {
+	if mc.CreateSKSClusterFunc == nil {
+		panic("MockClient: CreateSKSCluster is not implemented")
+	}
+
+	return mc.CreateSKSClusterFunc(ctx, req)
+}
+
+func (mc *MockClient) CreateSKSClusterAndWait(ctx context.Context, req CreateSKSClusterRequest, opts ...WaitOpt) (*SKSCluster, error) {
+	if mc.CreateSKSClusterAndWaitFunc == nil {
+		panic("MockClient: CreateSKSClusterAndWait is not implemented")
+	}
+
+	return mc.CreateSKSClusterAndWaitFunc(ctx, req, opts...)
+}
+
+func (mc *MockClient) ListSKSClusterDeprecatedResources(ctx context.Context, id UUID) ([]SKSClusterDeprecatedResource, error) {
+	if mc.ListSKSClusterDeprecatedResourcesFunc == nil {
+		panic("MockClient: ListSKSClusterDeprecatedResources is not implemented")
+	}
+
+	return mc.ListSKSClusterDeprecatedResourcesFunc(ctx, id)
+}
+
+func (mc *MockClient) AllSKSClusterDeprecatedResources(ctx context.Context, id UUID) iter.Seq2[SKSClusterDeprecatedResource, error] {
+	if mc.AllSKSClusterDeprecatedResourcesFunc == nil {
+		panic("MockClient: AllSKSClusterDeprecatedResources is not implemented")
+	}
+
+	return mc.AllSKSClusterDeprecatedResourcesFunc(ctx, id)
+}
+
+func (mc *MockClient) GenerateSKSClusterKubeconfig(ctx context.Context, id UUID, req SKSKubeconfigRequest) (*GenerateSKSClusterKubeconfigResponse, error) {
+	if mc.GenerateSKSClusterKubeconfigFunc == nil {
+		panic("MockClient: GenerateSKSClusterKubeconfig is not implemented")
+	}
+
+	return mc.GenerateSKSClusterKubeconfigFunc(ctx, id, req)
+}
+
+func (mc *MockClient) ListSKSClusterVersions(ctx context.Context, opts ...ListSKSClusterVersionsOpt) (*ListSKSClusterVersionsResponse, error) {
+	if mc.ListSKSClusterVersionsFunc == nil {
+		panic("MockClient: ListSKSClusterVersions is not implemented")
+	}
+
+	return mc.ListSKSClusterVersionsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) AllSKSClusterVersions(ctx context.Context, opts ...ListSKSClusterVersionsOpt) iter.Seq2[string, error] {
+	if mc.AllSKSClusterVersionsFunc == nil {
+		panic("MockClient: AllSKSClusterVersions is not implemented")
+	}
+
+	return mc.AllSKSClusterVersionsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) DeleteSKSCluster(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteSKSClusterFunc == nil {
+		panic("MockClient: DeleteSKSCluster is not implemented")
+	}
+
+	return mc.DeleteSKSClusterFunc(ctx, id)
+}
+
+func (mc *MockClient) GetSKSCluster(ctx context.Context, id UUID) (*SKSCluster, error) {
+	if mc.GetSKSClusterFunc == nil {
+		panic("MockClient: GetSKSCluster is not implemented")
+	}
+
+	return mc.GetSKSClusterFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateSKSCluster(ctx context.Context, id UUID, req UpdateSKSClusterRequest) (*Operation, error) {
+	if mc.UpdateSKSClusterFunc == nil {
+		panic("MockClient: UpdateSKSCluster is not implemented")
+	}
+
+	return mc.UpdateSKSClusterFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpdateSKSClusterAndWait(ctx context.Context, id UUID, req UpdateSKSClusterRequest, opts ...WaitOpt) (*SKSCluster, error) {
+	if mc.UpdateSKSClusterAndWaitFunc == nil {
+		panic("MockClient: UpdateSKSClusterAndWait is not implemented")
+	}
+
+	return mc.UpdateSKSClusterAndWaitFunc(ctx, id, req, opts...)
+}
+
+func (mc *MockClient) GetSKSClusterAuthorityCert(ctx context.Context, id UUID, authority GetSKSClusterAuthorityCertAuthority) (*GetSKSClusterAuthorityCertResponse, error) {
+	if mc.GetSKSClusterAuthorityCertFunc == nil {
+		panic("MockClient: GetSKSClusterAuthorityCert is not implemented")
+	}
+
+	return mc.GetSKSClusterAuthorityCertFunc(ctx, id, authority)
+}
+
+func (mc *MockClient) GetSKSClusterInspection(ctx context.Context, id UUID) (*GetSKSClusterInspectionResponse, error) {
+	if mc.GetSKSClusterInspectionFunc == nil {
+		panic("MockClient: GetSKSClusterInspection is not implemented")
+	}
+
+	return mc.GetSKSClusterInspectionFunc(ctx, id)
+}
+
+func (mc *MockClient) CreateSKSNodepool(ctx context.Context, id UUID, req CreateSKSNodepoolRequest) (*Operation, error) {
+	if mc.CreateSKSNodepoolFunc == nil {
+		panic("MockClient: CreateSKSNodepool is not implemented")
+	}
+
+	return mc.CreateSKSNodepoolFunc(ctx, id, req)
+}
+
+func (mc *MockClient) DeleteSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID) (*Operation, error) {
+	if mc.DeleteSKSNodepoolFunc == nil {
+		panic("MockClient: DeleteSKSNodepool is not implemented")
+	}
+
+	return mc.DeleteSKSNodepoolFunc(ctx, id, sksNodepoolID)
+}
+
+func (mc *MockClient) GetSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID) (*SKSNodepool, error) {
+	if mc.GetSKSNodepoolFunc == nil {
+		panic("MockClient: GetSKSNodepool is not implemented")
+	}
+
+	return mc.GetSKSNodepoolFunc(ctx, id, sksNodepoolID)
+}
+
+func (mc *MockClient) UpdateSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID, req UpdateSKSNodepoolRequest) (*Operation, error) {
+	if mc.UpdateSKSNodepoolFunc == nil {
+		panic("MockClient: UpdateSKSNodepool is not implemented")
+	}
+
+	return mc.UpdateSKSNodepoolFunc(ctx, id, sksNodepoolID, req)
+}
+
+func (mc *MockClient) EvictSKSNodepoolMembers(ctx context.Context, id UUID, sksNodepoolID UUID, req EvictSKSNodepoolMembersRequest) (*Operation, error) {
+	if mc.EvictSKSNodepoolMembersFunc == nil {
+		panic("MockClient: EvictSKSNodepoolMembers is not implemented")
+	}
+
+	return mc.EvictSKSNodepoolMembersFunc(ctx, id, sksNodepoolID, req)
+}
+
+func (mc *MockClient) ScaleSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID, req ScaleSKSNodepoolRequest) (*Operation, error) {
+	if mc.ScaleSKSNodepoolFunc == nil {
+		panic("MockClient: ScaleSKSNodepool is not implemented")
+	}
+
+	return mc.ScaleSKSNodepoolFunc(ctx, id, sksNodepoolID, req)
+}
+
+func (mc *MockClient) RotateSKSCcmCredentials(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.RotateSKSCcmCredentialsFunc == nil {
+		panic("MockClient: RotateSKSCcmCredentials is not implemented")
+	}
+
+	return mc.RotateSKSCcmCredentialsFunc(ctx, id)
+}
+
+func (mc *MockClient) RotateSKSCsiCredentials(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.RotateSKSCsiCredentialsFunc == nil {
+		panic("MockClient: RotateSKSCsiCredentials is not implemented")
+	}
+
+	return mc.RotateSKSCsiCredentialsFunc(ctx, id)
+}
+
+func (mc *MockClient) RotateSKSKarpenterCredentials(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.RotateSKSKarpenterCredentialsFunc == nil {
+		panic("MockClient: RotateSKSKarpenterCredentials is not implemented")
+	}
+
+	return mc.RotateSKSKarpenterCredentialsFunc(ctx, id)
+}
+
+func (mc *MockClient) RotateSKSOperatorsCA(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.RotateSKSOperatorsCAFunc == nil {
+		panic("MockClient: RotateSKSOperatorsCA is not implemented")
+	}
+
+	return mc.RotateSKSOperatorsCAFunc(ctx, id)
+}
+
+func (mc *MockClient) UpgradeSKSCluster(ctx context.Context, id UUID, req UpgradeSKSClusterRequest) (*Operation, error) {
+	if mc.UpgradeSKSClusterFunc == nil {
+		panic("MockClient: UpgradeSKSCluster is not implemented")
+	}
+
+	return mc.UpgradeSKSClusterFunc(ctx, id, req)
+}
+
+func (mc *MockClient) UpgradeSKSClusterServiceLevel(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.UpgradeSKSClusterServiceLevelFunc == nil {
+		panic("MockClient: UpgradeSKSClusterServiceLevel is not implemented")
+	}
+
+	return mc.UpgradeSKSClusterServiceLevelFunc(ctx, id)
+}
+
+func (mc *MockClient) GetActiveNodepoolTemplate(ctx context.Context, kubeVersion string, variant GetActiveNodepoolTemplateVariant) (*GetActiveNodepoolTemplateResponse, error) {
+	if mc.GetActiveNodepoolTemplateFunc == nil {
+		panic("MockClient: GetActiveNodepoolTemplate is not implemented")
+	}
+
+	return mc.GetActiveNodepoolTemplateFunc(ctx, kubeVersion, variant)
+}
+
+func (mc *MockClient) ListAIAPIKeys(ctx context.Context) (*ListAIAPIKeysResponse, error) {
+	if mc.ListAIAPIKeysFunc == nil {
+		panic("MockClient: ListAIAPIKeys is not implemented")
+	}
+
+	return mc.ListAIAPIKeysFunc(ctx)
+}
+
+func (mc *MockClient) AllAIAPIKeys(ctx context.Context) iter.Seq2[AIAPIKey, error] {
+	if mc.AllAIAPIKeysFunc == nil {
+		panic("MockClient: AllAIAPIKeys is not implemented")
+	}
+
+	return mc.AllAIAPIKeysFunc(ctx)
+}
+
+func (mc *MockClient) CreateAIAPIKey(ctx context.Context, req CreateAIAPIKeyRequest) (*AIAPIKeyWithValue, error) {
+	if mc.CreateAIAPIKeyFunc == nil {
+		panic("MockClient: CreateAIAPIKey is not implemented")
+	}
+
+	return mc.CreateAIAPIKeyFunc(ctx, req)
+}
+
+func (mc *MockClient) DeleteAIAPIKey(ctx context.Context, id UUID) (*DeleteAIAPIKeyResponse, error) {
+	if mc.DeleteAIAPIKeyFunc == nil {
+		panic("MockClient: DeleteAIAPIKey is not implemented")
+	}
+
+	return mc.DeleteAIAPIKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) GetAIAPIKey(ctx context.Context, id UUID) (*AIAPIKey, error) {
+	if mc.GetAIAPIKeyFunc == nil {
+		panic("MockClient: GetAIAPIKey is not implemented")
+	}
+
+	return mc.GetAIAPIKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateAIAPIKey(ctx context.Context, id UUID, req UpdateAIAPIKeyRequest) (*AIAPIKey, error) {
+	if mc.UpdateAIAPIKeyFunc == nil {
+		panic("MockClient: UpdateAIAPIKey is not implemented")
+	}
+
+	return mc.UpdateAIAPIKeyFunc(ctx, id, req)
+}
+
+func (mc *MockClient) RotateAIAPIKey(ctx context.Context, id UUID) (*AIAPIKeyWithValue, error) {
+	if mc.RotateAIAPIKeyFunc == nil {
+		panic("MockClient: RotateAIAPIKey is not implemented")
+	}
+
+	return mc.RotateAIAPIKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) ListDeployments(ctx context.Context, opts ...ListDeploymentsOpt) (*ListDeploymentsResponse, error) {
+	if mc.ListDeploymentsFunc == nil {
+		panic("MockClient: ListDeployments is not implemented")
+	}
+
+	return mc.ListDeploymentsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) AllDeployments(ctx context.Context, opts ...ListDeploymentsOpt) iter.Seq2[ListDeploymentsResponseEntry, error] {
+	if mc.AllDeploymentsFunc == nil {
+		panic("MockClient: AllDeployments is not implemented")
+	}
+
+	return mc.AllDeploymentsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) CreateDeployment(ctx context.Context, req CreateDeploymentRequest) (*Operation, error) {
+	if mc.CreateDeploymentFunc == nil {
+		panic("MockClient: CreateDeployment is not implemented")
+	}
+
+	return mc.CreateDeploymentFunc(ctx, req)
+}
+
+func (mc *MockClient) DeleteDeployment(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteDeploymentFunc == nil {
+		panic("MockClient: DeleteDeployment is not implemented")
+	}
+
+	return mc.DeleteDeploymentFunc(ctx, id)
+}
+
+func (mc *MockClient) GetDeployment(ctx context.Context, id UUID) (*GetDeploymentResponse, error) {
+	if mc.GetDeploymentFunc == nil {
+		panic("MockClient: GetDeployment is not implemented")
+	}
+
+	return mc.GetDeploymentFunc(ctx, id)
+}
+
+func (mc *MockClient) UpdateDeployment(ctx context.Context, id UUID, req UpdateDeploymentRequest) (*Operation, error) {
+	if mc.UpdateDeploymentFunc == nil {
+		panic("MockClient: UpdateDeployment is not implemented")
+	}
+
+	return mc.UpdateDeploymentFunc(ctx, id, req)
+}
+
+func (mc *MockClient) RevealDeploymentAPIKey(ctx context.Context, id UUID) (*RevealDeploymentAPIKeyResponse, error) {
+	if mc.RevealDeploymentAPIKeyFunc == nil {
+		panic("MockClient: RevealDeploymentAPIKey is not implemented")
+	}
+
+	return mc.RevealDeploymentAPIKeyFunc(ctx, id)
+}
+
+func (mc *MockClient) GetDeploymentLogs(ctx context.Context, id UUID, opts ...GetDeploymentLogsOpt) (*GetDeploymentLogsResponse, error) {
+	if mc.GetDeploymentLogsFunc == nil {
+		panic("MockClient: GetDeploymentLogs is not implemented")
+	}
+
+	return mc.GetDeploymentLogsFunc(ctx, id, opts...)
+}
+
+func (mc *MockClient) ScaleDeployment(ctx context.Context, id UUID, req ScaleDeploymentRequest) (*Operation, error) {
+	if mc.ScaleDeploymentFunc == nil {
+		panic("MockClient: ScaleDeployment is not implemented")
+	}
+
+	return mc.ScaleDeploymentFunc(ctx, id, req)
+}
+
+func (mc *MockClient) GetInferenceEngineHelp(ctx context.Context, opts ...GetInferenceEngineHelpOpt) (*GetInferenceEngineHelpResponse, error) {
+	if mc.GetInferenceEngineHelpFunc == nil {
+		panic("MockClient: GetInferenceEngineHelp is not implemented")
+	}
+
+	return mc.GetInferenceEngineHelpFunc(ctx, opts...)
+}
+
+func (mc *MockClient) ListAIInstanceTypes(ctx context.Context) (*ListAIInstanceTypesResponse, error) {
+	if mc.ListAIInstanceTypesFunc == nil {
+		panic("MockClient: ListAIInstanceTypes is not implemented")
+	}
+
+	return mc.ListAIInstanceTypesFunc(ctx)
+}
+
+func (mc *MockClient) AllAIInstanceTypes(ctx context.Context) iter.Seq2[InstanceTypeEntry, error] {
+	if mc.AllAIInstanceTypesFunc == nil {
+		panic("MockClient: AllAIInstanceTypes is not implemented")
+	}
+
+	return mc.AllAIInstanceTypesFunc(ctx)
+}
+
+func (mc *MockClient) ListModels(ctx context.Context, opts ...ListModelsOpt) (*ListModelsResponse, error) {
+	if mc.ListModelsFunc == nil {
+		panic("MockClient: ListModels is not implemented")
+	}
+
+	return mc.ListModelsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) AllModels(ctx context.Context, opts ...ListModelsOpt) iter.Seq2[ListModelsResponseEntry, error] {
+	if mc.AllModelsFunc == nil {
+		panic("MockClient: AllModels is not implemented")
+	}
+
+	return mc.AllModelsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) CreateModel(ctx context.Context, req CreateModelRequest) (*Operation, error) {
+	if mc.CreateModelFunc == nil {
+		panic("MockClient: CreateModel is not implemented")
+	}
+
+	return mc.CreateModelFunc(ctx, req)
+}
+
+func (mc *MockClient) DeleteModel(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.DeleteModelFunc == nil {
+		panic("MockClient: DeleteModel is not implemented")
+	}
+
+	return mc.DeleteModelFunc(ctx, id)
+}
+
+func (mc *MockClient) GetModel(ctx context.Context, id UUID) (*GetModelResponse, error) {
+	if mc.GetModelFunc == nil {
+		panic("MockClient: GetModel is not implemented")
+	}
+
+	return mc.GetModelFunc(ctx, id)
+}
+
+func (mc *MockClient) GetEnvImpact(ctx context.Context, period string) (*EnvImpactReport, error) {
+	if mc.GetEnvImpactFunc == nil {
+		panic("MockClient: GetEnvImpact is not implemented")
+	}
+
+	return mc.GetEnvImpactFunc(ctx, period)
+}
+
+func (mc *MockClient) ListEvents(ctx context.Context, opts ...ListEventsOpt) ([]Event, error) {
+	if mc.ListEventsFunc == nil {
+		panic("MockClient: ListEvents is not implemented")
+	}
+
+	return mc.ListEventsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) AllEvents(ctx context.Context, opts ...ListEventsOpt) iter.Seq2[Event, error] {
+	if mc.AllEventsFunc == nil {
+		panic("MockClient: AllEvents is not implemented")
+	}
+
+	return mc.AllEventsFunc(ctx, opts...)
+}
+
+func (mc *MockClient) GetOperation(ctx context.Context, id UUID) (*Operation, error) {
+	if mc.GetOperationFunc == nil {
+		panic("MockClient: GetOperation is not implemented")
+	}
+
+	return mc.GetOperationFunc(ctx, id)
+}
+
+func (mc *MockClient) GetOrganization(ctx context.Context) (*Organization, error) {
+	if mc.GetOrganizationFunc == nil {
+		panic("MockClient: GetOrganization is not implemented")
+	}
+
+	return mc.GetOrganizationFunc(ctx)
+}
+
+func (mc *MockClient) ListQuotas(ctx context.Context) (*ListQuotasResponse, error) {
+	if mc.ListQuotasFunc == nil {
+		panic("MockClient: ListQuotas is not implemented")
+	}
+
+	return mc.ListQuotasFunc(ctx)
+}
+
+func (mc *MockClient) AllQuotas(ctx context.Context) iter.Seq2[Quota, error] {
+	if mc.AllQuotasFunc == nil {
+		panic("MockClient: AllQuotas is not implemented")
+	}
+
+	return mc.AllQuotasFunc(ctx)
+}
+
+func (mc *MockClient) GetQuota(ctx context.Context, entity string) (*Quota, error) {
+	if mc.GetQuotaFunc == nil {
+		panic("MockClient: GetQuota is not implemented")
+	}
+
+	return mc.GetQuotaFunc(ctx, entity)
+}
+
+func (mc *MockClient) ListSOSBucketsUsage(ctx context.Context) (*ListSOSBucketsUsageResponse, error) {
+	if mc.ListSOSBucketsUsageFunc == nil {
+		panic("MockClient: ListSOSBucketsUsage is not implemented")
+	}
+
+	return mc.ListSOSBucketsUsageFunc(ctx)
+}
+
+func (mc *MockClient) AllSOSBucketsUsage(ctx context.Context) iter.Seq2[SOSBucketUsage, error] {
+	if mc.AllSOSBucketsUsageFunc == nil {
+		panic("MockClient: AllSOSBucketsUsage is not implemented")
+	}
+
+	return mc.AllSOSBucketsUsageFunc(ctx)
+}
+
+func (mc *MockClient) GetSOSPresignedURL(ctx context.Context, bucket string, opts ...GetSOSPresignedURLOpt) (*GetSOSPresignedURLResponse, error) {
+	if mc.GetSOSPresignedURLFunc == nil {
+		panic("MockClient: GetSOSPresignedURL is not implemented")
+	}
+
+	return mc.GetSOSPresignedURLFunc(ctx, bucket, opts...)
+}
+
+func (mc *MockClient) GetUsageReport(ctx context.Context, opts ...GetUsageReportOpt) (*GetUsageReportResponse, error) {
+	if mc.GetUsageReportFunc == nil {
+		panic("MockClient: GetUsageReport is not implemented")
+	}
+
+	return mc.GetUsageReportFunc(ctx, opts...)
+}
+
+func (mc *MockClient) ListZones(ctx context.Context) (*ListZonesResponse, error) {
+	if mc.ListZonesFunc == nil {
+		panic("MockClient: ListZones is not implemented")
+	}
+
+	return mc.ListZonesFunc(ctx)
+}
+
+func (mc *MockClient) AllZones(ctx context.Context) iter.Seq2[Zone, error] {
+	if mc.AllZonesFunc == nil {
+		panic("MockClient: AllZones is not implemented")
+	}
+
+	return mc.AllZonesFunc(ctx)
+}
+
+func (mc *MockClient) Wait(ctx context.Context, op *Operation, states ...OperationState) (*Operation, error) {
+	if mc.WaitFunc == nil {
+		panic("MockClient: Wait is not implemented")
+	}
+
+	return mc.WaitFunc(ctx, op, states...)
+}
+
+func (mc *MockClient) WaitWithOpts(ctx context.Context, op *Operation, opts ...WaitOpt) (*Operation, error) {
+	if mc.WaitWithOptsFunc == nil {
+		panic("MockClient: WaitWithOpts is not implemented")
+	}
+
+	return mc.WaitWithOptsFunc(ctx, op, opts...)
+}
+
+func (mc *MockClient) WaitAll(ctx context.Context, ops ...*Operation) ([]WaitResult, error) {
+	if mc.WaitAllFunc == nil {
+		panic("MockClient: WaitAll is not implemented")
+	}
+
+	return mc.WaitAllFunc(ctx, ops...)
+}
+
+func (mc *MockClient) WaitAllWithOpts(ctx context.Context, ops []*Operation, opts ...WaitOpt) ([]WaitResult, error) {
+	if mc.WaitAllWithOptsFunc == nil {
+		panic("MockClient: WaitAllWithOpts is not implemented")
+	}
+
+	return mc.WaitAllWithOptsFunc(ctx, ops, opts...)
+}
+
+func (mc *MockClient) WaitAny(ctx context.Context, ops ...*Operation) (int, *Operation, error) {
+	if mc.WaitAnyFunc == nil {
+		panic("MockClient: WaitAny is not implemented")
+	}
+
+	return mc.WaitAnyFunc(ctx, ops...)
+}
+
+func (mc *MockClient) WaitAnyWithOpts(ctx context.Context, ops []*Operation, opts ...WaitOpt) (int, *Operation, error) {
+	if mc.WaitAnyWithOptsFunc == nil {
+		panic("MockClient: WaitAnyWithOpts is not implemented")
+	}
+
+	return mc.WaitAnyWithOptsFunc(ctx, ops, opts...)
+}
+
+func (mc *MockClient) GetZoneName(ctx context.Context, endpoint Endpoint) (ZoneName, error) {
+	if mc.GetZoneNameFunc == nil {
+		panic("MockClient: GetZoneName is not implemented")
+	}
+
+	return mc.GetZoneNameFunc(ctx, endpoint)
+}
+
+func (mc *MockClient) GetZoneAPIEndpoint(ctx context.Context, zoneName ZoneName) (Endpoint, error) {
+	if mc.GetZoneAPIEndpointFunc == nil {
+		panic("MockClient: GetZoneAPIEndpoint is not implemented")
+	}
+
+	return mc.GetZoneAPIEndpointFunc(ctx, zoneName)
+}
@@ -0,0 +1,125 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// AssumeRoleFunc requests an API key pair valid for the given TTL, e.g. using the IAM AssumeIAMRole operation.
+type AssumeRoleFunc func(ctx context.Context, ttl time.Duration) (Value, error)
+
+// AssumeRoleOpt represents a function setting an AssumeRoleProvider option.
+type AssumeRoleOpt func(*AssumeRoleProvider)
+
+// AssumeRoleOptWithTTL returns an AssumeRoleOpt overriding the TTL of the assumed key pairs (15 minutes by default).
+// The TTL cannot exceed the max TTL of the assumed role.
+func AssumeRoleOptWithTTL(ttl time.Duration) AssumeRoleOpt {
+	return func(a *AssumeRoleProvider) {
+		a.ttl = ttl
+	}
+}
+
+// AssumeRoleOptWithExpiryWindow returns an AssumeRoleOpt overriding the duration before the end of their TTL
+// after which the assumed key pairs are reported expired (10% of the TTL by default).
+// The window must be positive and shorter than the TTL.
+func AssumeRoleOptWithExpiryWindow(window time.Duration) AssumeRoleOpt {
+	return func(a *AssumeRoleProvider) {
+		a.expiryWindow = &window
+	}
+}
+
+// AssumeRoleOptWithTimeout returns an AssumeRoleOpt overriding the timeout of the role assumption (30 seconds by default).
+func AssumeRoleOptWithTimeout(timeout time.Duration) AssumeRoleOpt {
+	return func(a *AssumeRoleProvider) {
+		a.timeout = timeout
+	}
+}
+
+// An AssumeRoleProvider retrieves short-lived key pairs by assuming an IAM role,
+// and reports them expired ahead of the end of their TTL so they are renewed before being rejected.
+type AssumeRoleProvider struct {
+	assume       AssumeRoleFunc
+	ttl          time.Duration
+	expiryWindow *time.Duration
+	timeout      time.Duration
+
+	mu         sync.RWMutex
+	expiration time.Time
+}
+
+// NewAssumeRoleProvider returns an AssumeRoleProvider retrieving key pairs with the assume function.
+// It returns an error if the expiry window is negative or not shorter than the TTL.
+func NewAssumeRoleProvider(assume AssumeRoleFunc, opts ...AssumeRoleOpt) (*AssumeRoleProvider, error) {
+	a := &AssumeRoleProvider{
+		assume:  assume,
+		ttl:     15 * time.Minute,
+		timeout: 30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if a.expiryWindow == nil {
+		window := a.ttl / 10
+		a.expiryWindow = &window
+	}
+
+	if *a.expiryWindow < 0 || *a.expiryWindow >= a.ttl {
+		return nil, fmt.Errorf("assume role provider: invalid expiry window %s for TTL %s", *a.expiryWindow, a.ttl)
+	}
+
+	return a, nil
+}
+
+// NewAssumeRoleCredentials returns Credentials retrieved by an AssumeRoleProvider, see NewAssumeRoleProvider.
+func NewAssumeRoleCredentials(assume AssumeRoleFunc, opts ...AssumeRoleOpt) (*Credentials, error) {
+	provider, err := NewAssumeRoleProvider(assume, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCredentials(provider), nil
+}
+
+// Retrieve assumes the role and returns the resulting key pair.
+func (a *AssumeRoleProvider) Retrieve() (Value, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.expiration = time.Time{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.timeout)
+	defer cancel()
+
+	start := time.Now()
+	v, err := a.assume(ctx, a.ttl)
+	if err != nil {
+		return Value{}, fmt.Errorf("assume role provider: %w", err)
+	}
+
+	if !v.IsSet() {
+		return Value{}, fmt.Errorf("assume role provider: %w", ErrMissingIncomplete)
+	}
+
+	a.expiration = start.Add(a.ttl - *a.expiryWindow)
+
+	return v, nil
+}
+
+// IsExpired returns if the assumed key pair is expired, or about to.
+func (a *AssumeRoleProvider) IsExpired() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return !time.Now().Before(a.expiration)
+}
+
+// ExpiresAt returns the time after which the assumed key pair is reported expired.
+func (a *AssumeRoleProvider) ExpiresAt() time.Time {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.expiration
+}
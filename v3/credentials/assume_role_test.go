@@ -0,0 +1,53 @@
+package credentials
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewAssumeRoleProvider(t *testing.T) {
+	assume := func(ctx context.Context, ttl time.Duration) (Value, error) {
+		return Value{APIKey: "EXOabcdef0123456789abcdef01", APISecret: "secret"}, nil
+	}
+
+	tests := []struct {
+		name    string
+		opts    []AssumeRoleOpt
+		wantErr bool
+	}{
+		{name: "default"},
+		{name: "zero window", opts: []AssumeRoleOpt{AssumeRoleOptWithExpiryWindow(0)}},
+		{name: "window", opts: []AssumeRoleOpt{AssumeRoleOptWithTTL(time.Hour), AssumeRoleOptWithExpiryWindow(59 * time.Minute)}},
+		{name: "negative window", opts: []AssumeRoleOpt{AssumeRoleOptWithExpiryWindow(-time.Second)}, wantErr: true},
+		{name: "window equal to TTL", opts: []AssumeRoleOpt{AssumeRoleOptWithExpiryWindow(15 * time.Minute)}, wantErr: true},
+		{name: "window longer than TTL", opts: []AssumeRoleOpt{AssumeRoleOptWithTTL(time.Minute), AssumeRoleOptWithExpiryWindow(time.Hour)}, wantErr: true},
+		{name: "zero TTL", opts: []AssumeRoleOpt{AssumeRoleOptWithTTL(0)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := NewAssumeRoleProvider(assume, tt.opts...)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := provider.Retrieve(); err != nil {
+				t.Fatal(err)
+			}
+			if provider.IsExpired() {
+				t.Error("the assumed key pair must not be expired before the expiry window")
+			}
+		})
+	}
+
+	if _, err := NewAssumeRoleCredentials(assume, AssumeRoleOptWithExpiryWindow(-time.Second)); err == nil {
+		t.Error("expected an error from NewAssumeRoleCredentials")
+	}
+}
@@ -1,8 +1,10 @@
 package credentials
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
 )
 
 var (
@@ -11,9 +13,12 @@ var (
 
 // A ChainProvider will search for a provider which returns credentials
 // and cache that provider until Retrieve is called again.
+// A ChainProvider is safe for concurrent use, its Providers must not be modified once in use.
 type ChainProvider struct {
 	Providers []Provider
-	current   Provider
+
+	mu      sync.RWMutex
+	current Provider
 }
 
 // NewChainCredentials returns a pointer to a new Credentials object
@@ -24,34 +29,62 @@ func NewChainCredentials(providers []Provider) *Credentials {
 	})
 }
 
+// NewDefaultCredentials returns Credentials searching, in order:
+//  1. the environment, see EnvProvider,
+//  2. the exo CLI configuration file, see FileProvider,
+//  3. the given fallback providers (e.g. a StaticProvider).
+func NewDefaultCredentials(fallbacks ...Provider) *Credentials {
+	return NewChainCredentials(append([]Provider{NewEnvProvider(), NewFileProvider()}, fallbacks...))
+}
+
 // Retrieve returns the first provider in the chain that succeeds,
 // or error if no provider returned.
 //
 // If a provider is found it will be cached and any calls to IsExpired()
 // will return the expired state of the cached provider.
+// Otherwise, the returned error joins ErrNoValidCredentialProviders with the error of every provider.
 func (c *ChainProvider) Retrieve() (Value, error) {
-	var errs = ErrNoValidCredentialProviders
+	return c.RetrieveWithContext(context.Background())
+}
+
+// RetrieveWithContext is Retrieve, passing ctx to the providers of the chain which are ContextProviders.
+func (c *ChainProvider) RetrieveWithContext(ctx context.Context) (Value, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
+	errs := []error{ErrNoValidCredentialProviders}
 	for _, p := range c.Providers {
-		creds, err := p.Retrieve()
+		creds, err := retrieveWithContext(ctx, p)
 		if err == nil {
 			c.current = p
 			return creds, nil
 		}
 
-		errs = fmt.Errorf("%v: %w", errs, err)
+		errs = append(errs, fmt.Errorf("%T: %w", p, err))
 	}
 	c.current = nil
 
-	return Value{}, fmt.Errorf("chain provider: %w", errs)
+	return Value{}, fmt.Errorf("chain provider: %w", errors.Join(errs...))
 }
 
 // IsExpired will returned the expired state of the currently cached provider
 // if there is one.  If there is no current provider, true will be returned.
 func (c *ChainProvider) IsExpired() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	if c.current != nil {
 		return c.current.IsExpired()
 	}
 
 	return true
 }
+
+// Current returns the provider which supplied the last retrieved credentials,
+// nil if none did.
+func (c *ChainProvider) Current() Provider {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.current
+}
@@ -0,0 +1,146 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// failingProvider is a Provider always failing with err.
+type failingProvider struct {
+	err error
+}
+
+func (p failingProvider) Retrieve() (Value, error) {
+	return Value{}, p.err
+}
+
+func (p failingProvider) IsExpired() bool {
+	return true
+}
+
+func TestChainProviderRetrieve(t *testing.T) {
+	errLocked := errors.New("locked")
+	fallback := NewStaticProvider("EXOfallback", "secret")
+
+	chain := &ChainProvider{Providers: []Provider{failingProvider{err: errLocked}, fallback}}
+	if chain.Current() != nil || !chain.IsExpired() {
+		t.Fatal("a chain provider must be expired without current provider before Retrieve")
+	}
+
+	v, err := chain.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.APIKey != "EXOfallback" {
+		t.Errorf("got key %q, want the key of the first succeeding provider", v.APIKey)
+	}
+	if chain.Current() != fallback {
+		t.Errorf("got current provider %#v, want the first succeeding provider", chain.Current())
+	}
+	if chain.IsExpired() {
+		t.Error("a chain provider must report the expiry of its current provider")
+	}
+
+	chain.Providers = []Provider{failingProvider{err: errLocked}, failingProvider{err: ErrMissingIncomplete}}
+	_, err = chain.Retrieve()
+	if !errors.Is(err, ErrNoValidCredentialProviders) || !errors.Is(err, errLocked) || !errors.Is(err, ErrMissingIncomplete) {
+		t.Errorf("the error must join ErrNoValidCredentialProviders with the provider errors, got %v", err)
+	}
+	want := "chain provider: " + ErrNoValidCredentialProviders.Error() + "\n" +
+		"credentials.failingProvider: locked\n" +
+		"credentials.failingProvider: " + ErrMissingIncomplete.Error()
+	if err.Error() != want {
+		t.Errorf("got error %q, want %q", err, want)
+	}
+	if chain.Current() != nil {
+		t.Errorf("a failed Retrieve must reset the current provider, got %#v", chain.Current())
+	}
+}
+
+func TestChainProviderConcurrency(t *testing.T) {
+	creds := NewChainCredentials([]Provider{
+		failingProvider{err: ErrMissingIncomplete},
+		NewStaticProvider("EXOstatic", "secret"),
+	})
+	chain := creds.provider.(*ChainProvider)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			for range 100 {
+				if _, err := chain.Retrieve(); err != nil {
+					t.Error(err)
+				}
+				chain.IsExpired()
+				chain.Current()
+				if _, err := creds.Get(); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func TestNewDefaultCredentials(t *testing.T) {
+	configFile := writeConfig(t, t.TempDir(), "file", "file")
+	missingFile := filepath.Join(t.TempDir(), "exoscale.toml")
+
+	tests := []struct {
+		name       string
+		env        map[string]string
+		wantKey    string
+		wantSource Provider
+	}{
+		{
+			name: "environment first",
+			env: map[string]string{
+				"EXOSCALE_API_KEY":    "EXOenv",
+				"EXOSCALE_API_SECRET": "secret",
+				"EXOSCALE_CONFIG":     configFile,
+			},
+			wantKey:    "EXOenv",
+			wantSource: &EnvProvider{},
+		},
+		{
+			name:       "then the configuration file",
+			env:        map[string]string{"EXOSCALE_API_KEY": "EXOenv", "EXOSCALE_CONFIG": configFile},
+			wantKey:    "EXOfile",
+			wantSource: &FileProvider{},
+		},
+		{
+			name:       "then the fallbacks",
+			env:        map[string]string{"EXOSCALE_CONFIG": missingFile},
+			wantKey:    "EXOfallback",
+			wantSource: &StaticProvider{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{
+				"EXOSCALE_API_KEY", "EXOSCALE_API_KEY_FILE", "EXOSCALE_API_SECRET", "EXOSCALE_API_SECRET_FILE",
+				"EXOSCALE_CONFIG", "EXOSCALE_ACCOUNT",
+			} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			creds := NewDefaultCredentials(NewStaticProvider("EXOfallback", "secret"))
+			v, err := creds.Get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v.APIKey != tt.wantKey {
+				t.Errorf("got key %q, want %q", v.APIKey, tt.wantKey)
+			}
+
+			current := creds.provider.(*ChainProvider).Current()
+			if got, want := fmt.Sprintf("%T", current), fmt.Sprintf("%T", tt.wantSource); got != want {
+				t.Errorf("got credentials from %s, want %s", got, want)
+			}
+		})
+	}
+}
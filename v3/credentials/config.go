@@ -0,0 +1,171 @@
+package credentials
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// tomlStatement matches a TOML table header or key/value pair.
+var tomlStatement = regexp.MustCompile(`(?m)^\s*(\[\[?[\w.-]+\]\]?|[\w.-]+\s*=)`)
+
+// detectConfigType returns the format of a configuration file from its extension,
+// or from its content if the extension is unknown.
+func detectConfigType(filename string) (string, error) {
+	switch ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(filename), ".")); ext {
+	case "toml", "json", "yaml", "yml":
+		return ext, nil
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("file provider: %w", err)
+	}
+
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(data, []byte("{")):
+		return "json", nil
+	case tomlStatement.Match(data):
+		return "toml", nil
+	default:
+		return "yaml", nil
+	}
+}
+
+// configExtensions lists the extensions of the configuration files searched by findConfigFile, by priority.
+var configExtensions = []string{"toml", "yaml", "yml", "json"}
+
+// findConfigFile returns the first "exoscale" configuration file of dirs along with its format,
+// found from its extension: exoscale.toml, exoscale.yaml, exoscale.json...
+// An extensionless exoscale file is a TOML file, as written by former versions of the exo CLI.
+func findConfigFile(dirs ...string) (string, string, error) {
+	for _, dir := range dirs {
+		for _, ext := range configExtensions {
+			filename := filepath.Join(dir, "exoscale."+ext)
+			if isFile(filename) {
+				return filename, ext, nil
+			}
+		}
+
+		if filename := filepath.Join(dir, "exoscale"); isFile(filename) {
+			return filename, "toml", nil
+		}
+	}
+
+	return "", "", fmt.Errorf("file provider: no exoscale configuration file found in %s", strings.Join(dirs, ", "))
+}
+
+// isFile returns true if filename is an existing regular file.
+func isFile(filename string) bool {
+	info, err := os.Stat(filename)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// legacyAccountKeys lists the account keys of former exo CLI versions, accepted and ignored.
+var legacyAccountKeys = []string{"endpoint", "computeendpoint", "dnsendpoint", "runstatusendpoint", "defaultrunstatuspage"}
+
+// decodeConfig decodes and validates a configuration,
+// decoding errors are reported along with the name of the offending account.
+// Unknown keys are ignored, unless strict.
+func decodeConfig(v *viper.Viper, strict bool) (Config, error) {
+	config := Config{}
+	if err := v.Unmarshal(&config); err != nil {
+		for i, raw := range rawAccounts(v) {
+			sub := viper.New()
+			if err := sub.MergeConfigMap(raw); err != nil {
+				continue
+			}
+			if err := sub.Unmarshal(&Account{}); err != nil {
+				return Config{}, fmt.Errorf("%s: %w", accountLabel(raw, i), err)
+			}
+		}
+
+		return Config{}, err
+	}
+
+	if strict {
+		if keys := unknownKeys(v.AllSettings(), Config{}); len(keys) > 0 {
+			return Config{}, fmt.Errorf("unknown keys %s", strings.Join(keys, ", "))
+		}
+		for i, raw := range rawAccounts(v) {
+			if keys := unknownKeys(raw, Account{}, legacyAccountKeys...); len(keys) > 0 {
+				return Config{}, fmt.Errorf("%s: unknown keys %s", accountLabel(raw, i), strings.Join(keys, ", "))
+			}
+		}
+	}
+
+	return config, config.validate()
+}
+
+// unknownKeys returns the sorted keys of raw matching neither a field of the struct v
+// (case-insensitively, like the decoding) nor the ignored keys.
+func unknownKeys(raw map[string]any, v any, ignored ...string) []string {
+	known := map[string]struct{}{}
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(v)) {
+		known[strings.ToLower(field.Name)] = struct{}{}
+	}
+	for _, key := range ignored {
+		known[key] = struct{}{}
+	}
+
+	var keys []string
+	for key := range raw {
+		if _, ok := known[strings.ToLower(key)]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// validate returns an error if an account has no name, or the same name as another account.
+func (c Config) validate() error {
+	names := make(map[string]struct{}, len(c.Accounts))
+	for i, a := range c.Accounts {
+		if a.Name == "" {
+			return fmt.Errorf("account #%d: missing name", i+1)
+		}
+		if _, ok := names[a.Name]; ok {
+			return fmt.Errorf("account %q: duplicate name", a.Name)
+		}
+		names[a.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// rawAccounts returns the undecoded accounts of a configuration.
+func rawAccounts(v *viper.Viper) []map[string]any {
+	switch accounts := v.Get("accounts").(type) {
+	case []map[string]any:
+		return accounts
+	case []any:
+		raw := make([]map[string]any, 0, len(accounts))
+		for _, a := range accounts {
+			if m, ok := a.(map[string]any); ok {
+				raw = append(raw, m)
+			}
+		}
+		return raw
+	}
+
+	return nil
+}
+
+// accountLabel names an undecoded account in errors.
+func accountLabel(raw map[string]any, i int) string {
+	if name, ok := raw["name"].(string); ok && name != "" {
+		return fmt.Sprintf("account %q", name)
+	}
+
+	return fmt.Sprintf("account #%d", i+1)
+}
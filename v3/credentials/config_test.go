@@ -0,0 +1,175 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDetectConfigType(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{name: "exoscale.toml", content: "{}", want: "toml"},
+		{name: "exoscale.YAML", content: "{}", want: "yaml"},
+		{name: "exoscale.yml", content: "", want: "yml"},
+		{name: "exoscale.json", content: "", want: "json"},
+		{name: "json", content: "\n  {\"defaultAccount\": \"main\"}", want: "json"},
+		{name: "toml-table", content: "[[accounts]]\nname = \"main\"", want: "toml"},
+		{name: "toml-key", content: "# exo CLI\ndefaultAccount = \"main\"", want: "toml"},
+		{name: "yaml", content: "defaultAccount: main\naccounts:\n  - name: main", want: "yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(dir, tt.name)
+			if err := os.WriteFile(filename, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := detectConfigType(filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindConfigFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		wantFile string
+		wantType string
+	}{
+		{name: "toml", files: []string{"exoscale.toml"}, wantFile: "exoscale.toml", wantType: "toml"},
+		{name: "yaml", files: []string{"exoscale.yaml"}, wantFile: "exoscale.yaml", wantType: "yaml"},
+		{name: "json", files: []string{"exoscale.json"}, wantFile: "exoscale.json", wantType: "json"},
+		{name: "extensionless", files: []string{"exoscale"}, wantFile: "exoscale", wantType: "toml"},
+		{
+			name:     "extension first",
+			files:    []string{"exoscale", "exoscale.yaml"},
+			wantFile: "exoscale.yaml",
+			wantType: "yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			empty, dir := t.TempDir(), t.TempDir()
+			for _, name := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), nil, 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			filename, configType, err := findConfigFile(empty, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if filename != filepath.Join(dir, tt.wantFile) || configType != tt.wantType {
+				t.Errorf("got %s (%s), want %s (%s)", filename, configType, tt.wantFile, tt.wantType)
+			}
+		})
+	}
+
+	if _, _, err := findConfigFile(t.TempDir()); err == nil {
+		t.Error("findConfigFile must fail without configuration file")
+	}
+}
+
+func TestFileProviderConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		account string
+		opts    []FileOpt
+		wantErr string
+	}{
+		{
+			name:    "yaml",
+			file:    "exoscale.yaml",
+			content: "defaultAccount: main\naccounts:\n  - name: main\n    key: EXOmain\n    secret: secret\n",
+		},
+		{
+			name:    "json",
+			file:    "exoscale.json",
+			content: `{"defaultAccount": "main", "accounts": [{"name": "main", "key": "EXOmain", "secret": "secret"}]}`,
+		},
+		{
+			name:    "account decoding error",
+			file:    "exoscale.toml",
+			content: "defaultAccount = \"main\"\n[[accounts]]\nname = \"main\"\nkey = \"EXOmain\"\nsecret = \"secret\"\nclientTimeout = \"soon\"\n",
+			wantErr: `account "main": `,
+		},
+		{
+			name:    "unknown keys",
+			file:    "exoscale.toml",
+			content: "defaultAccount = \"main\"\nfoo = 1\n[[accounts]]\nname = \"main\"\nkey = \"EXOmain\"\nsecret = \"secret\"\nsecrt = \"x\"\n",
+		},
+		{
+			name:    "legacy keys",
+			file:    "exoscale.toml",
+			content: "defaultAccount = \"main\"\n[[accounts]]\nname = \"main\"\nkey = \"EXOmain\"\nsecret = \"secret\"\nendpoint = \"https://api.exoscale.com/v1\"\ncomputeEndpoint = \"https://api.exoscale.com/v1\"\ndnsEndpoint = \"https://api.exoscale.com/dns\"\nrunstatusEndpoint = \"https://api.runstatus.com\"\ndefaultRunstatusPage = \"\"\n",
+			opts:    []FileOpt{FileOptWithStrictConfig()},
+		},
+		{
+			name:    "strict unknown account key",
+			file:    "exoscale.toml",
+			content: "defaultAccount = \"main\"\n[[accounts]]\nname = \"main\"\nkey = \"EXOmain\"\nsecret = \"secret\"\nsecrt = \"x\"\n",
+			opts:    []FileOpt{FileOptWithStrictConfig()},
+			wantErr: `account "main": unknown keys secrt`,
+		},
+		{
+			name:    "strict unknown key",
+			file:    "exoscale.toml",
+			content: "defaultAcount = \"main\"\n[[accounts]]\nname = \"main\"\nkey = \"EXOmain\"\nsecret = \"secret\"\n",
+			opts:    []FileOpt{FileOptWithStrictConfig()},
+			wantErr: "unknown keys defaultacount",
+		},
+		{
+			name:    "syntax error",
+			file:    "exoscale.toml",
+			content: "[[accounts]]\nname = \"main\"\n[[accounts]\nname = \"other\"\n",
+			account: "main",
+			wantErr: `file provider: couldn't parse config`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("EXOSCALE_ACCOUNT", tt.account)
+			filename := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(filename, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			v, err := NewFileProvider(append(tt.opts, FileOptWithFilename(filename))...).Retrieve()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				// The selected account is not the one failing to parse.
+				if tt.account != "" && strings.Contains(err.Error(), `account "`+tt.account+`"`) {
+					t.Errorf("the error must not name the selected account, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v.APIKey != "EXOmain" {
+				t.Errorf("got key %q, want EXOmain", v.APIKey)
+			}
+		})
+	}
+}
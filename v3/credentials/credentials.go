@@ -1,6 +1,7 @@
 package credentials
 
 import (
+	"context"
 	"errors"
 	"sync"
 )
@@ -29,6 +30,24 @@ type Provider interface {
 	IsExpired() bool
 }
 
+// ContextProvider is a Provider whose retrieval can be canceled or bounded with a context,
+// e.g. when it runs a command or sends a request.
+type ContextProvider interface {
+	Provider
+
+	// RetrieveWithContext is Retrieve, aborted once ctx is done.
+	RetrieveWithContext(ctx context.Context) (Value, error)
+}
+
+// retrieveWithContext retrieves the credentials of p, with ctx if p is a ContextProvider.
+func retrieveWithContext(ctx context.Context, p Provider) (Value, error) {
+	if cp, ok := p.(ContextProvider); ok {
+		return cp.RetrieveWithContext(ctx)
+	}
+
+	return p.Retrieve()
+}
+
 type Credentials struct {
 	credentials Value
 	provider    Provider
@@ -52,8 +71,14 @@ func (c *Credentials) Expire() {
 }
 
 func (c *Credentials) Get() (Value, error) {
+	return c.GetWithContext(context.Background())
+}
+
+// GetWithContext is Get, passing ctx to the provider if it is a ContextProvider
+// to abort the retrieval of expired credentials.
+func (c *Credentials) GetWithContext(ctx context.Context) (Value, error) {
 	if c.IsExpired() {
-		if err := c.retrieve(); err != nil {
+		if err := c.retrieve(ctx); err != nil {
 			return Value{}, err
 		}
 	}
@@ -71,14 +96,24 @@ func (c *Credentials) IsExpired() bool {
 	c.RLock()
 	defer c.RUnlock()
 
+	return c.isExpired()
+}
+
+func (c *Credentials) isExpired() bool {
 	return (!c.credentials.IsSet() || c.provider.IsExpired())
 }
 
-func (c *Credentials) retrieve() error {
+// retrieve retrieves the credentials from the provider, unless a concurrent
+// call already did while waiting for the lock.
+func (c *Credentials) retrieve(ctx context.Context) error {
 	c.Lock()
 	defer c.Unlock()
 
-	v, err := c.provider.Retrieve()
+	if !c.isExpired() {
+		return nil
+	}
+
+	v, err := retrieveWithContext(ctx, c.provider)
 	if err != nil {
 		return err
 	}
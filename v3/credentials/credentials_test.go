@@ -0,0 +1,76 @@
+package credentials
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// expiringProvider is a Provider counting its retrievals, expired until the next retrieval
+// once expire is called. Its first IsExpired calls after expire wait for each other,
+// so that concurrent Get calls all observe the expiry.
+type expiringProvider struct {
+	retrievals atomic.Int32
+	expired    atomic.Bool
+	arrivals   atomic.Int32
+	barrier    sync.WaitGroup
+	callers    int32
+}
+
+func (p *expiringProvider) Retrieve() (Value, error) {
+	p.retrievals.Add(1)
+	p.expired.Store(false)
+
+	return Value{APIKey: "EXOabcdef0123456789abcdef01", APISecret: "secret"}, nil
+}
+
+func (p *expiringProvider) IsExpired() bool {
+	expired := p.expired.Load()
+	if p.arrivals.Add(1) <= p.callers {
+		p.barrier.Done()
+		p.barrier.Wait()
+	}
+
+	return expired
+}
+
+func (p *expiringProvider) expire(callers int) {
+	p.arrivals.Store(0)
+	p.callers = int32(callers)
+	p.barrier.Add(callers)
+	p.expired.Store(true)
+}
+
+func TestCredentialsGetConcurrentRefresh(t *testing.T) {
+	provider := &expiringProvider{}
+	creds := NewCredentials(provider)
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	const callers = 10
+	provider.expire(callers)
+
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Go(func() {
+			if _, err := creds.Get(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if n := provider.retrievals.Load(); n != 2 {
+		t.Errorf("concurrent Get calls on expired credentials must retrieve them once, got %d retrievals", n-1)
+	}
+
+	creds.Expire()
+	if _, err := creds.Get(); err != nil {
+		t.Fatal(err)
+	}
+	if n := provider.retrievals.Load(); n != 3 {
+		t.Errorf("Get must retrieve expired credentials again, got %d retrievals", n)
+	}
+}
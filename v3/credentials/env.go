@@ -1,22 +1,44 @@
 package credentials
 
-import "os"
+import (
+	"fmt"
+	"os"
+	"strings"
+)
 
+// EnvProvider retrieves the credentials from the EXOSCALE_API_KEY and EXOSCALE_API_SECRET environment variables,
+// or from the files set in the EXOSCALE_API_KEY_FILE and EXOSCALE_API_SECRET_FILE environment variables
+// (e.g. mounted Kubernetes secrets).
 type EnvProvider struct {
 	retrieved bool
 }
 
+// NewEnvProvider returns an EnvProvider.
+func NewEnvProvider() *EnvProvider {
+	return &EnvProvider{}
+}
+
 func NewEnvCredentials() *Credentials {
-	return NewCredentials(&EnvProvider{})
+	return NewCredentials(NewEnvProvider())
 }
 
 // Retrieve retrieves the keys from the environment.
 func (e *EnvProvider) Retrieve() (Value, error) {
 	e.retrieved = false
 
+	apiKey, err := getenvOrFile("EXOSCALE_API_KEY")
+	if err != nil {
+		return Value{}, err
+	}
+
+	apiSecret, err := getenvOrFile("EXOSCALE_API_SECRET")
+	if err != nil {
+		return Value{}, err
+	}
+
 	v := Value{
-		APIKey:    os.Getenv("EXOSCALE_API_KEY"),
-		APISecret: os.Getenv("EXOSCALE_API_SECRET"),
+		APIKey:    apiKey,
+		APISecret: apiSecret,
 	}
 
 	if !v.IsSet() {
@@ -32,3 +54,23 @@ func (e *EnvProvider) Retrieve() (Value, error) {
 func (e *EnvProvider) IsExpired() bool {
 	return !e.retrieved
 }
+
+// getenvOrFile returns the value of the environment variable name,
+// or the content of the file set in the environment variable name_FILE.
+func getenvOrFile(name string) (string, error) {
+	if v := os.Getenv(name); v != "" {
+		return v, nil
+	}
+
+	filename := os.Getenv(name + "_FILE")
+	if filename == "" {
+		return "", nil
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("env provider: %s_FILE: %w", name, err)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,81 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestEnvProvider(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key")
+	if err := os.WriteFile(keyFile, []byte("EXOfromfile0123456789abcdef\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	secretFile := filepath.Join(dir, "secret")
+	if err := os.WriteFile(secretFile, []byte("  secret-from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    Value
+		wantErr string
+	}{
+		{
+			name: "variables",
+			env:  map[string]string{"EXOSCALE_API_KEY": "EXOfromenv", "EXOSCALE_API_SECRET": "secret-from-env"},
+			want: Value{APIKey: "EXOfromenv", APISecret: "secret-from-env"},
+		},
+		{
+			name: "files",
+			env:  map[string]string{"EXOSCALE_API_KEY_FILE": keyFile, "EXOSCALE_API_SECRET_FILE": secretFile},
+			want: Value{APIKey: "EXOfromfile0123456789abcdef", APISecret: "secret-from-file"},
+		},
+		{
+			name: "variables take precedence over files",
+			env: map[string]string{
+				"EXOSCALE_API_KEY":         "EXOfromenv",
+				"EXOSCALE_API_KEY_FILE":    keyFile,
+				"EXOSCALE_API_SECRET_FILE": secretFile,
+			},
+			want: Value{APIKey: "EXOfromenv", APISecret: "secret-from-file"},
+		},
+		{
+			name:    "missing file",
+			env:     map[string]string{"EXOSCALE_API_KEY": "EXOfromenv", "EXOSCALE_API_SECRET_FILE": filepath.Join(dir, "missing")},
+			wantErr: "env provider: EXOSCALE_API_SECRET_FILE",
+		},
+		{
+			name:    "incomplete",
+			env:     map[string]string{"EXOSCALE_API_KEY": "EXOfromenv"},
+			wantErr: ErrMissingIncomplete.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{
+				"EXOSCALE_API_KEY", "EXOSCALE_API_KEY_FILE", "EXOSCALE_API_SECRET", "EXOSCALE_API_SECRET_FILE",
+			} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			got, err := NewEnvProvider().Retrieve()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,96 +1,186 @@
 package credentials
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"os/user"
 	"path"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type FileOpt func(*FileProvider)
 
-// FileOptWithFilename returns a FileOpt overriding the default filename.
+// FileOptWithFilename returns a FileOpt overriding the default filename,
+// and the one set in the EXOSCALE_CONFIG environment variable.
 func FileOptWithFilename(filename string) FileOpt {
 	return func(f *FileProvider) {
 		f.filename = filename
 	}
 }
 
-// FileOptWithAccount returns a FileOpt overriding the default account.
+// FileOptWithAccount returns a FileOpt overriding the default account,
+// and the one set in the EXOSCALE_ACCOUNT environment variable.
 func FileOptWithAccount(account string) FileOpt {
 	return func(f *FileProvider) {
 		f.account = account
 	}
 }
 
-type FileProvider struct {
-	filename  string
-	account   string
-	retrieved bool
+// FileOptWithStrictConfig returns a FileOpt rejecting configuration files with unknown keys,
+// e.g. misspelled ones, which are ignored by default. The keys of former exo CLI versions are accepted.
+func FileOptWithStrictConfig() FileOpt {
+	return func(f *FileProvider) {
+		f.strict = true
+	}
+}
 
-	// TODO: export some fields from the config like: default zone...etc.
+// FileOptWithSecretCommandTimeout returns a FileOpt overriding the timeout of the account secret command
+// (30 seconds by default).
+func FileOptWithSecretCommandTimeout(timeout time.Duration) FileOpt {
+	return func(f *FileProvider) {
+		f.secretCommandTimeout = timeout
+	}
 }
 
-func NewFileCredentials(opts ...FileOpt) *Credentials {
-	fp := &FileProvider{}
+type FileProvider struct {
+	filename             string
+	account              string
+	secretCommandTimeout time.Duration
+	strict               bool
+	retrieved            bool
+}
+
+// NewFileProvider returns a FileProvider reading an account of the exo CLI configuration file.
+func NewFileProvider(opts ...FileOpt) *FileProvider {
+	fp := &FileProvider{
+		secretCommandTimeout: 30 * time.Second,
+	}
 	for _, opt := range opts {
 		opt(fp)
 	}
-	return NewCredentials(fp)
+	return fp
+}
+
+func NewFileCredentials(opts ...FileOpt) *Credentials {
+	return NewCredentials(NewFileProvider(opts...))
+}
+
+// Account returns the account profile (default zone, environment, custom headers...) read from the configuration file.
+// The secret command of the account is not run, see Retrieve.
+func (f *FileProvider) Account() (Account, error) {
+	account, _, err := f.readAccount()
+	return account, err
 }
 
 func (f *FileProvider) Retrieve() (Value, error) {
+	return f.RetrieveWithContext(context.Background())
+}
+
+// RetrieveWithContext is Retrieve, killing the secret command of the account once ctx is done.
+func (f *FileProvider) RetrieveWithContext(ctx context.Context) (Value, error) {
 	f.retrieved = false
 
-	viperConf, err := f.retrieveViperConfig()
+	account, accountName, err := f.readAccount()
 	if err != nil {
 		return Value{}, err
 	}
 
-	if err := viperConf.ReadInConfig(); err != nil {
-		return Value{}, err
+	v := Value{
+		APIKey:    account.Key,
+		APISecret: account.Secret,
 	}
 
-	config := Config{}
-	if err := viperConf.Unmarshal(&config); err != nil {
-		return Value{}, fmt.Errorf("file provider: couldn't read config: %w", err)
+	if len(account.SecretCommand) > 0 {
+		secret, err := f.runSecretCommand(ctx, account.SecretCommand)
+		if err != nil {
+			return Value{}, fmt.Errorf("file provider: account %q: secret command: %w", accountName, err)
+		}
+		v.APISecret = secret
 	}
 
-	if len(config.Accounts) == 0 {
-		return Value{}, fmt.Errorf("file provider: no accounts were found into %q", viper.ConfigFileUsed())
+	if !v.IsSet() {
+		return Value{}, fmt.Errorf("file provider: account %q: %w", accountName, ErrMissingIncomplete)
 	}
 
-	if f.account == "" && config.DefaultAccount == "" {
-		return Value{}, fmt.Errorf("file provider: no account defined")
+	f.retrieved = true
+
+	return v, nil
+}
+
+// readAccount returns the selected account of the configuration file along with its name.
+func (f *FileProvider) readAccount() (Account, string, error) {
+	viperConf, err := f.retrieveViperConfig()
+	if err != nil {
+		return Account{}, "", err
 	}
 
-	accountName := config.DefaultAccount
+	accountName := os.Getenv("EXOSCALE_ACCOUNT")
 	if f.account != "" {
 		accountName = f.account
 	}
 
-	account := Account{}
-	for i, a := range config.Accounts {
-		if a.Name == accountName {
-			account = config.Accounts[i]
-			break
-		}
+	if err := viperConf.ReadInConfig(); err != nil {
+		return Account{}, "", fmt.Errorf("file provider: couldn't parse config %q: %w", viperConf.ConfigFileUsed(), err)
 	}
 
-	v := Value{
-		APIKey:    account.Key,
-		APISecret: account.Secret,
+	config, err := decodeConfig(viperConf, f.strict)
+	if err != nil {
+		return Account{}, "", fmt.Errorf("file provider: couldn't read config %q: %w", viperConf.ConfigFileUsed(), err)
 	}
 
-	if !v.IsSet() {
-		return Value{}, fmt.Errorf("file provider: account %q: %w", accountName, ErrMissingIncomplete)
+	if len(config.Accounts) == 0 {
+		return Account{}, "", fmt.Errorf("file provider: no accounts were found into %q", viperConf.ConfigFileUsed())
 	}
 
-	f.retrieved = true
+	if accountName == "" {
+		accountName = config.DefaultAccount
+	}
 
-	return v, nil
+	if accountName == "" {
+		return Account{}, "", fmt.Errorf("file provider: no account defined")
+	}
+
+	for _, a := range config.Accounts {
+		if a.Name == accountName {
+			return a, accountName, nil
+		}
+	}
+
+	return Account{}, "", fmt.Errorf("file provider: account %q not found into %q", accountName, viperConf.ConfigFileUsed())
+}
+
+// runSecretCommand returns the API secret printed on the standard output of the secret command,
+// killing it on the secret command timeout or on ctx cancellation.
+func (f *FileProvider) runSecretCommand(ctx context.Context, command []string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.secretCommandTimeout)
+	defer cancel()
+
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stderr = stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return "", ctxErr
+		}
+
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && stderr.Len() > 0 {
+			return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+		}
+
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
 }
 
 // IsExpired returns if the shared credentials have expired.
@@ -123,8 +213,18 @@ type Config struct {
 func (f *FileProvider) retrieveViperConfig() (*viper.Viper, error) {
 	config := viper.New()
 
-	if f.filename != "" {
-		config.SetConfigFile(f.filename)
+	filename := f.filename
+	if filename == "" {
+		filename = os.Getenv("EXOSCALE_CONFIG")
+	}
+
+	if filename != "" {
+		configType, err := detectConfigType(filename)
+		if err != nil {
+			return nil, err
+		}
+		config.SetConfigFile(filename)
+		config.SetConfigType(configType)
 		return config, nil
 	}
 
@@ -138,12 +238,17 @@ func (f *FileProvider) retrieveViperConfig() (*viper.Viper, error) {
 		return nil, err
 	}
 
-	config.SetConfigName("exoscale")
-	config.SetConfigType("toml")
-	config.AddConfigPath(path.Join(cfgdir, "exoscale"))
-	config.AddConfigPath(path.Join(usr.HomeDir, ".exoscale"))
-	config.AddConfigPath(usr.HomeDir)
-	config.AddConfigPath(".")
+	filename, configType, err := findConfigFile(
+		path.Join(cfgdir, "exoscale"),
+		path.Join(usr.HomeDir, ".exoscale"),
+		usr.HomeDir,
+		".",
+	)
+	if err != nil {
+		return nil, err
+	}
+	config.SetConfigFile(filename)
+	config.SetConfigType(configType)
 
 	return config, nil
 }
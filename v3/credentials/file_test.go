@@ -0,0 +1,216 @@
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunSecretCommand(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		timeout time.Duration
+		command []string
+		want    string
+		wantErr func(error) bool
+	}{
+		{
+			name:    "success",
+			ctx:     context.Background(),
+			timeout: 10 * time.Second,
+			command: []string{"sh", "-c", "echo secret"},
+			want:    "secret",
+		},
+		{
+			name:    "non-zero exit",
+			ctx:     context.Background(),
+			timeout: 10 * time.Second,
+			command: []string{"sh", "-c", "echo locked >&2; exit 3"},
+			wantErr: func(err error) bool {
+				return strings.Contains(err.Error(), "exit status 3: locked")
+			},
+		},
+		{
+			name:    "timeout",
+			ctx:     context.Background(),
+			timeout: 50 * time.Millisecond,
+			command: []string{"sleep", "10"},
+			wantErr: func(err error) bool {
+				return errors.Is(err, context.DeadlineExceeded)
+			},
+		},
+		{
+			name:    "cancellation",
+			ctx:     canceled,
+			timeout: 10 * time.Second,
+			command: []string{"sleep", "10"},
+			wantErr: func(err error) bool {
+				return errors.Is(err, context.Canceled)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFileProvider(FileOptWithSecretCommandTimeout(tt.timeout))
+
+			got, err := f.runSecretCommand(tt.ctx, tt.command)
+			if tt.wantErr != nil {
+				if err == nil || !tt.wantErr(err) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got secret %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileProviderSecretCommand(t *testing.T) {
+	t.Setenv("EXOSCALE_ACCOUNT", "")
+
+	filename := filepath.Join(t.TempDir(), "exoscale.toml")
+	if err := os.WriteFile(filename, []byte(`
+defaultAccount = "main"
+
+[[accounts]]
+name = "main"
+key = "EXOabcdef0123456789abcdef01"
+secretCommand = ["sh", "-c", "echo from-command"]
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := NewFileProvider(FileOptWithFilename(filename)).Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.APISecret != "from-command" {
+		t.Errorf("got secret %q, want the secret command output", v.APISecret)
+	}
+}
+
+// writeConfig writes a TOML configuration of the given accounts, named after their key, to dir.
+func writeConfig(t *testing.T, dir, defaultAccount string, accounts ...string) string {
+	t.Helper()
+
+	config := fmt.Sprintf("defaultAccount = %q\n", defaultAccount)
+	for _, name := range accounts {
+		config += fmt.Sprintf("\n[[accounts]]\nname = %q\nkey = %q\nsecret = \"secret\"\n", name, "EXO"+name)
+	}
+
+	filename := filepath.Join(dir, "exoscale.toml")
+	if err := os.WriteFile(filename, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func TestFileProviderSelection(t *testing.T) {
+	envFile := writeConfig(t, t.TempDir(), "env-default", "env-default", "env-other")
+	optFile := writeConfig(t, t.TempDir(), "opt-default", "opt-default", "opt-other")
+
+	tests := []struct {
+		name       string
+		envConfig  string
+		envAccount string
+		opts       []FileOpt
+		wantKey    string
+	}{
+		{
+			name:      "EXOSCALE_CONFIG default account",
+			envConfig: envFile,
+			wantKey:   "EXOenv-default",
+		},
+		{
+			name:       "EXOSCALE_ACCOUNT overrides the default account",
+			envConfig:  envFile,
+			envAccount: "env-other",
+			wantKey:    "EXOenv-other",
+		},
+		{
+			name:       "FileOptWithAccount overrides EXOSCALE_ACCOUNT",
+			envConfig:  envFile,
+			envAccount: "missing",
+			opts:       []FileOpt{FileOptWithAccount("env-other")},
+			wantKey:    "EXOenv-other",
+		},
+		{
+			name:      "FileOptWithFilename overrides EXOSCALE_CONFIG",
+			envConfig: envFile,
+			opts:      []FileOpt{FileOptWithFilename(optFile)},
+			wantKey:   "EXOopt-default",
+		},
+		{
+			name:       "FileOptWithFilename and EXOSCALE_ACCOUNT",
+			envConfig:  envFile,
+			envAccount: "opt-other",
+			opts:       []FileOpt{FileOptWithFilename(optFile)},
+			wantKey:    "EXOopt-other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("EXOSCALE_CONFIG", tt.envConfig)
+			t.Setenv("EXOSCALE_ACCOUNT", tt.envAccount)
+
+			v, err := NewFileProvider(tt.opts...).Retrieve()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v.APIKey != tt.wantKey {
+				t.Errorf("got key %q, want %q", v.APIKey, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestFileProviderRetrieveWithContext(t *testing.T) {
+	t.Setenv("EXOSCALE_ACCOUNT", "")
+	filename := filepath.Join(t.TempDir(), "exoscale.toml")
+	if err := os.WriteFile(filename, []byte(`
+defaultAccount = "main"
+
+[[accounts]]
+name = "main"
+key = "EXOabcdef0123456789abcdef01"
+secretCommand = ["sleep", "10"]
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, get := range map[string]func(context.Context) (Value, error){
+		"file provider": NewFileProvider(FileOptWithFilename(filename)).RetrieveWithContext,
+		"credentials":   NewFileCredentials(FileOptWithFilename(filename)).GetWithContext,
+		"chain":         NewChainCredentials([]Provider{NewFileProvider(FileOptWithFilename(filename))}).GetWithContext,
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			start := time.Now()
+			if _, err := get(ctx); !errors.Is(err, context.Canceled) {
+				t.Errorf("expected context.Canceled, got %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("the secret command must be killed on cancellation, took %s", elapsed)
+			}
+		})
+	}
+}
@@ -7,10 +7,13 @@ type StaticProvider struct {
 	retrieved bool
 }
 
+// NewStaticProvider returns a StaticProvider.
+func NewStaticProvider(apiKey, apiSecret string) *StaticProvider {
+	return &StaticProvider{creds: Value{APIKey: apiKey, APISecret: apiSecret}}
+}
+
 func NewStaticCredentials(apiKey, apiSecret string) *Credentials {
-	return NewCredentials(
-		&StaticProvider{creds: Value{APIKey: apiKey, APISecret: apiSecret}},
-	)
+	return NewCredentials(NewStaticProvider(apiKey, apiSecret))
 }
 
 // Retrieve returns the credentials or error if the credentials are invalid.
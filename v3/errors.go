@@ -51,6 +51,54 @@ var (
 	ErrNetworkAuthenticationRequired = errors.New(http.StatusText(http.StatusNetworkAuthenticationRequired))
 )
 
+// ErrWaitTimeout is returned when an async operation doesn't reach a final state within the wait timeout.
+var ErrWaitTimeout = errors.New("max wait timeout reached")
+
+// ErrInteractionNotFound is returned by a replaying Recorder for requests matching no recorded interaction.
+var ErrInteractionNotFound = errors.New("no recorded interaction found")
+
+var (
+	// ErrInvalidSignature is returned by Signer.Verify when a request signature is missing, malformed or invalid.
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrSignatureExpired is returned by Signer.Verify when a request signature is expired.
+	ErrSignatureExpired = errors.New("signature expired")
+)
+
+// OperationError is returned when an async operation reaches an unexpected final state
+// (e.g. failure or timeout).
+type OperationError struct {
+	Operation *Operation
+}
+
+func (e *OperationError) Error() string {
+	var ref OperationReference
+	if e.Operation.Reference != nil {
+		ref = *e.Operation.Reference
+	}
+
+	return fmt.Sprintf("operation: %q %v, state: %s, reason: %q, message: %q",
+		e.Operation.ID,
+		ref,
+		e.Operation.State,
+		e.Operation.Reason,
+		e.Operation.Message,
+	)
+}
+
+// ZoneError is the error of an operation run in a zone.
+type ZoneError struct {
+	Zone ZoneName
+	Err  error
+}
+
+func (e *ZoneError) Error() string {
+	return fmt.Sprintf("zone %q: %v", e.Zone, e.Err)
+}
+
+func (e *ZoneError) Unwrap() error {
+	return e.Err
+}
+
 var httpStatusCodeErrors = map[int]error{
 	http.StatusBadRequest:                    ErrBadRequest,
 	http.StatusUnauthorized:                  ErrUnauthorized,
@@ -0,0 +1,943 @@
+package fake
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+var (
+	// Instances public IPv4 addresses are allocated in TEST-NET-2, elastic IPs in TEST-NET-3.
+	instancesStartIP  = net.IPv4(198, 51, 100, 1)
+	instancesEndIP    = net.IPv4(198, 51, 100, 254)
+	elasticIPsStartIP = net.IPv4(203, 0, 113, 1)
+	elasticIPsEndIP   = net.IPv4(203, 0, 113, 254)
+)
+
+func (s *Server) computeRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /instance", s.listInstances)
+	mux.HandleFunc("POST /instance", s.createInstance)
+	mux.HandleFunc("GET /instance/{id}", s.getInstance)
+	mux.HandleFunc("PUT /instance/{id}", s.updateInstance)
+	mux.HandleFunc("DELETE /instance/{id}", s.deleteInstance)
+
+	mux.HandleFunc("GET /security-group", s.listSecurityGroups)
+	mux.HandleFunc("POST /security-group", s.createSecurityGroup)
+	mux.HandleFunc("GET /security-group/{id}", s.getSecurityGroup)
+	mux.HandleFunc("PUT /security-group/{id}", s.updateSecurityGroupInstance)
+	mux.HandleFunc("DELETE /security-group/{id}", s.deleteSecurityGroup)
+	mux.HandleFunc("POST /security-group/{id}/rules", s.addRuleToSecurityGroup)
+	mux.HandleFunc("DELETE /security-group/{id}/rules/{rule}", s.deleteRuleFromSecurityGroup)
+
+	mux.HandleFunc("GET /private-network", s.listPrivateNetworks)
+	mux.HandleFunc("POST /private-network", s.createPrivateNetwork)
+	mux.HandleFunc("GET /private-network/{id}", s.getPrivateNetwork)
+	mux.HandleFunc("PUT /private-network/{id}", s.updatePrivateNetwork)
+	mux.HandleFunc("DELETE /private-network/{id}", s.deletePrivateNetwork)
+
+	mux.HandleFunc("GET /elastic-ip", s.listElasticIPs)
+	mux.HandleFunc("POST /elastic-ip", s.createElasticIP)
+	mux.HandleFunc("GET /elastic-ip/{id}", s.getElasticIP)
+	mux.HandleFunc("PUT /elastic-ip/{id}", s.updateElasticIP)
+	mux.HandleFunc("DELETE /elastic-ip/{id}", s.deleteElasticIP)
+
+	mux.HandleFunc("GET /ssh-key", s.listSSHKeys)
+	mux.HandleFunc("POST /ssh-key", s.registerSSHKey)
+	mux.HandleFunc("GET /ssh-key/{name}", s.getSSHKey)
+	mux.HandleFunc("DELETE /ssh-key/{name}", s.deleteSSHKey)
+
+	mux.HandleFunc("GET /template", s.listTemplates)
+	mux.HandleFunc("POST /template", s.registerTemplate)
+	mux.HandleFunc("GET /template/{id}", s.getTemplate)
+	mux.HandleFunc("PUT /template/{id}", s.updateTemplate)
+	mux.HandleFunc("DELETE /template/{id}", s.deleteTemplate)
+}
+
+func (s *Server) listInstances(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ipAddress := r.URL.Query().Get("ip-address")
+
+	resp := v3.ListInstancesResponse{Instances: []v3.ListInstancesResponseInstances{}}
+	for _, instance := range sorted(s.instances, func(i *v3.Instance) string { return i.Name }) {
+		if ipAddress != "" && instance.PublicIP.String() != ipAddress {
+			continue
+		}
+
+		var item v3.ListInstancesResponseInstances
+		convert(instance, &item)
+		resp.Instances = append(resp.Instances, item)
+	}
+
+	writeJSON(w, resp)
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateInstanceRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, ok := s.templates[req.Template.ID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "template %q not found", req.Template.ID)
+		return
+	}
+
+	instance := &v3.Instance{
+		ID:                 newID(),
+		Name:               req.Name,
+		CreatedAT:          time.Now().UTC(),
+		DiskSize:           req.DiskSize,
+		InstanceType:       req.InstanceType,
+		Labels:             req.Labels,
+		PublicIPAssignment: req.PublicIPAssignment,
+		Template:           template,
+		UserData:           req.UserData,
+		SSHKeys:            req.SSHKeys,
+		State:              v3.InstanceStateStarting,
+	}
+	if instance.Name == "" {
+		instance.Name = "vm-" + instance.ID.String()[:8]
+	}
+	if instance.PublicIPAssignment == "" {
+		instance.PublicIPAssignment = v3.PublicIPAssignmentInet4
+	}
+	if instance.PublicIPAssignment != v3.PublicIPAssignmentNone {
+		used := make([]net.IP, 0, len(s.instances))
+		for _, i := range s.instances {
+			used = append(used, i.PublicIP)
+		}
+		ip, err := allocateIP(instancesStartIP, instancesEndIP, used)
+		if err != nil {
+			writeError(w, http.StatusConflict, "instance public IP: %s", err)
+			return
+		}
+		instance.PublicIP = ip
+	}
+
+	if req.SSHKey != nil {
+		instance.SSHKeys = append(instance.SSHKeys, *req.SSHKey)
+	}
+	for _, key := range instance.SSHKeys {
+		if _, ok := s.sshKeys[key.Name]; !ok {
+			writeError(w, http.StatusNotFound, "SSH key %q not found", key.Name)
+			return
+		}
+	}
+	if len(instance.SSHKeys) > 0 {
+		instance.SSHKey = &instance.SSHKeys[0]
+	}
+
+	for _, ref := range req.SecurityGroups {
+		sg, ok := s.securityGroups[ref.ID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "security group %q not found", ref.ID)
+			return
+		}
+		instance.SecurityGroups = append(instance.SecurityGroups, v3.SecurityGroup{ID: sg.ID, Name: sg.Name})
+	}
+
+	final := v3.InstanceStateRunning
+	if req.AutoStart != nil && !*req.AutoStart {
+		final = v3.InstanceStateStopped
+	}
+
+	s.instances[instance.ID] = instance
+	writeJSON(w, s.newOperation("get-instance", "instance", instance.ID, func() {
+		instance.State = final
+	}))
+}
+
+func (s *Server) getInstance(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	instance, ok := s.lookupInstance(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, instance)
+}
+
+func (s *Server) updateInstance(w http.ResponseWriter, r *http.Request) {
+	id, action := splitAction(r.PathValue("id"))
+
+	var states map[v3.InstanceState]v3.InstanceState
+	var final v3.InstanceState
+	switch action {
+	case "":
+		var req v3.UpdateInstanceRequest
+		if !s.decode(w, r, &req) {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		instance, ok := s.lookupInstance(w, id)
+		if !ok {
+			return
+		}
+		if req.Name != "" {
+			instance.Name = req.Name
+		}
+		if req.Labels != nil {
+			instance.Labels = req.Labels
+		}
+		if req.UserData != "" {
+			instance.UserData = req.UserData
+		}
+
+		writeJSON(w, s.newOperation("get-instance", "instance", id, nil))
+		return
+	case "start":
+		states, final = map[v3.InstanceState]v3.InstanceState{v3.InstanceStateStopped: v3.InstanceStateStarting}, v3.InstanceStateRunning
+	case "stop":
+		states, final = map[v3.InstanceState]v3.InstanceState{v3.InstanceStateRunning: v3.InstanceStateStopping}, v3.InstanceStateStopped
+	case "reboot":
+		states, final = map[v3.InstanceState]v3.InstanceState{v3.InstanceStateRunning: v3.InstanceStateStarting}, v3.InstanceStateRunning
+	default:
+		writeError(w, http.StatusNotFound, "instance action %q is not implemented by the fake server", action)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	instance, ok := s.lookupInstance(w, id)
+	if !ok {
+		return
+	}
+
+	transient, ok := states[instance.State]
+	if !ok {
+		writeError(w, http.StatusConflict, "cannot %s instance %q in state %q", action, id, instance.State)
+		return
+	}
+
+	instance.State = transient
+	writeJSON(w, s.newOperation("get-instance", "instance", id, func() {
+		instance.State = final
+	}))
+}
+
+func (s *Server) deleteInstance(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	instance, ok := s.lookupInstance(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	instance.State = v3.InstanceStateDestroying
+	writeJSON(w, s.newOperation("get-instance", "instance", instance.ID, func() {
+		delete(s.instances, instance.ID)
+		for _, pn := range s.privateNetworks {
+			pn.Leases = slices.DeleteFunc(pn.Leases, func(l v3.PrivateNetworkLease) bool {
+				return l.InstanceID == instance.ID
+			})
+		}
+	}))
+}
+
+// lookupInstance returns the instance or writes a not found error.
+// It must be called with the lock held.
+func (s *Server) lookupInstance(w http.ResponseWriter, id v3.UUID) (*v3.Instance, bool) {
+	instance, ok := s.instances[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "instance %q not found", id)
+	}
+
+	return instance, ok
+}
+
+func (s *Server) listSecurityGroups(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := v3.ListSecurityGroupsResponse{SecurityGroups: []v3.SecurityGroup{}}
+	for _, sg := range sorted(s.securityGroups, func(sg *v3.SecurityGroup) string { return sg.Name }) {
+		resp.SecurityGroups = append(resp.SecurityGroups, *sg)
+	}
+
+	writeJSON(w, resp)
+}
+
+func (s *Server) createSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateSecurityGroupRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sg := range s.securityGroups {
+		if sg.Name == req.Name {
+			writeError(w, http.StatusConflict, "security group %q already exists", req.Name)
+			return
+		}
+	}
+
+	sg := &v3.SecurityGroup{ID: newID(), Name: req.Name, Description: req.Description}
+	s.securityGroups[sg.ID] = sg
+
+	writeJSON(w, s.newOperation("get-security-group", "security-group", sg.ID, nil))
+}
+
+func (s *Server) getSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.lookupSecurityGroup(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, sg)
+}
+
+// updateSecurityGroupInstance attaches and detaches instances to and from security groups.
+func (s *Server) updateSecurityGroupInstance(w http.ResponseWriter, r *http.Request) {
+	id, action := splitAction(r.PathValue("id"))
+	if action != "attach" && action != "detach" {
+		writeError(w, http.StatusNotFound, "security group action %q is not implemented by the fake server", action)
+		return
+	}
+
+	var req v3.AttachInstanceToSecurityGroupRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.lookupSecurityGroup(w, id)
+	if !ok {
+		return
+	}
+	instance, ok := s.lookupInstance(w, req.Instance.ID)
+	if !ok {
+		return
+	}
+
+	attached := slices.IndexFunc(instance.SecurityGroups, func(ref v3.SecurityGroup) bool { return ref.ID == id })
+	switch {
+	case action == "attach" && attached < 0:
+		instance.SecurityGroups = append(instance.SecurityGroups, v3.SecurityGroup{ID: sg.ID, Name: sg.Name})
+	case action == "detach" && attached >= 0:
+		instance.SecurityGroups = slices.Delete(instance.SecurityGroups, attached, attached+1)
+	default:
+		writeError(w, http.StatusConflict, "cannot %s instance %q: already done", action, instance.ID)
+		return
+	}
+
+	writeJSON(w, s.newOperation("get-security-group", "security-group", id, nil))
+}
+
+func (s *Server) deleteSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.lookupSecurityGroup(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	for _, instance := range s.instances {
+		if slices.ContainsFunc(instance.SecurityGroups, func(ref v3.SecurityGroup) bool { return ref.ID == sg.ID }) {
+			writeError(w, http.StatusConflict, "security group %q is in use by instance %q", sg.ID, instance.ID)
+			return
+		}
+	}
+
+	writeJSON(w, s.newOperation("get-security-group", "security-group", sg.ID, func() {
+		delete(s.securityGroups, sg.ID)
+	}))
+}
+
+func (s *Server) addRuleToSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	var req v3.AddRuleToSecurityGroupRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.lookupSecurityGroup(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	var rule v3.SecurityGroupRule
+	convert(req, &rule)
+	rule.ID = newID()
+	sg.Rules = append(sg.Rules, rule)
+
+	writeJSON(w, s.newOperation("get-security-group", "security-group", sg.ID, nil))
+}
+
+func (s *Server) deleteRuleFromSecurityGroup(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sg, ok := s.lookupSecurityGroup(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	ruleID := v3.UUID(r.PathValue("rule"))
+	i := slices.IndexFunc(sg.Rules, func(rule v3.SecurityGroupRule) bool { return rule.ID == ruleID })
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "security group rule %q not found", ruleID)
+		return
+	}
+	sg.Rules = slices.Delete(sg.Rules, i, i+1)
+
+	writeJSON(w, s.newOperation("get-security-group", "security-group", sg.ID, nil))
+}
+
+// lookupSecurityGroup returns the security group or writes a not found error.
+// It must be called with the lock held.
+func (s *Server) lookupSecurityGroup(w http.ResponseWriter, id v3.UUID) (*v3.SecurityGroup, bool) {
+	sg, ok := s.securityGroups[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "security group %q not found", id)
+	}
+
+	return sg, ok
+}
+
+func (s *Server) listPrivateNetworks(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := v3.ListPrivateNetworksResponse{PrivateNetworks: []v3.PrivateNetwork{}}
+	for _, pn := range sorted(s.privateNetworks, func(pn *v3.PrivateNetwork) string { return pn.Name }) {
+		resp.PrivateNetworks = append(resp.PrivateNetworks, *pn)
+	}
+
+	writeJSON(w, resp)
+}
+
+func (s *Server) createPrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreatePrivateNetworkRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pn := &v3.PrivateNetwork{ID: newID(), Vni: int64(len(s.privateNetworks) + 1)}
+	convert(req, pn)
+	s.privateNetworks[pn.ID] = pn
+
+	writeJSON(w, s.newOperation("get-private-network", "private-network", pn.ID, nil))
+}
+
+func (s *Server) getPrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pn, ok := s.lookupPrivateNetwork(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, pn)
+}
+
+func (s *Server) updatePrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	id, action := splitAction(r.PathValue("id"))
+
+	switch action {
+	case "":
+		var req v3.UpdatePrivateNetworkRequest
+		if !s.decode(w, r, &req) {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		pn, ok := s.lookupPrivateNetwork(w, id)
+		if !ok {
+			return
+		}
+		convert(req, pn)
+	case "attach":
+		var req v3.AttachInstanceToPrivateNetworkRequest
+		if !s.decode(w, r, &req) {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		pn, ok := s.lookupPrivateNetwork(w, id)
+		if !ok {
+			return
+		}
+		instance, ok := s.lookupInstance(w, req.Instance.ID)
+		if !ok {
+			return
+		}
+		if slices.ContainsFunc(instance.PrivateNetworks, func(ref v3.InstancePrivateNetworks) bool { return ref.ID == id }) {
+			writeError(w, http.StatusConflict, "instance %q is already attached to private network %q", instance.ID, id)
+			return
+		}
+
+		if pn.StartIP != nil {
+			used := make([]net.IP, 0, len(pn.Leases))
+			for _, l := range pn.Leases {
+				used = append(used, l.IP)
+			}
+			end := privateNetworkEndIP(pn)
+
+			switch {
+			case req.IP == nil:
+				ip, err := allocateIP(pn.StartIP, end, used)
+				if err != nil {
+					writeError(w, http.StatusConflict, "private network %q: %s", id, err)
+					return
+				}
+				req.IP = ip
+			case !ipInRange(req.IP, pn.StartIP, end):
+				writeError(w, http.StatusBadRequest, "IP %s is outside of private network %q range %s - %s", req.IP, id, pn.StartIP, end)
+				return
+			case slices.ContainsFunc(used, req.IP.Equal):
+				writeError(w, http.StatusConflict, "IP %s of private network %q is already leased", req.IP, id)
+				return
+			}
+		}
+		pn.Leases = append(pn.Leases, v3.PrivateNetworkLease{InstanceID: instance.ID, IP: req.IP})
+		instance.PrivateNetworks = append(instance.PrivateNetworks, v3.InstancePrivateNetworks{
+			ID:         id,
+			MACAddress: fmt.Sprintf("0a:00:00:00:%02x:%02x", len(pn.Leases)>>8, len(pn.Leases)&0xff),
+		})
+	case "detach":
+		var req v3.DetachInstanceFromPrivateNetworkRequest
+		if !s.decode(w, r, &req) {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		pn, ok := s.lookupPrivateNetwork(w, id)
+		if !ok {
+			return
+		}
+		instance, ok := s.lookupInstance(w, req.Instance.ID)
+		if !ok {
+			return
+		}
+		i := slices.IndexFunc(instance.PrivateNetworks, func(ref v3.InstancePrivateNetworks) bool { return ref.ID == id })
+		if i < 0 {
+			writeError(w, http.StatusConflict, "instance %q is not attached to private network %q", instance.ID, id)
+			return
+		}
+
+		instance.PrivateNetworks = slices.Delete(instance.PrivateNetworks, i, i+1)
+		pn.Leases = slices.DeleteFunc(pn.Leases, func(l v3.PrivateNetworkLease) bool { return l.InstanceID == instance.ID })
+	default:
+		writeError(w, http.StatusNotFound, "private network action %q is not implemented by the fake server", action)
+		return
+	}
+
+	writeJSON(w, s.newOperation("get-private-network", "private-network", id, nil))
+}
+
+func (s *Server) deletePrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pn, ok := s.lookupPrivateNetwork(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	if len(pn.Leases) > 0 {
+		writeError(w, http.StatusConflict, "private network %q is in use by instance %q", pn.ID, pn.Leases[0].InstanceID)
+		return
+	}
+
+	writeJSON(w, s.newOperation("get-private-network", "private-network", pn.ID, func() {
+		delete(s.privateNetworks, pn.ID)
+	}))
+}
+
+// privateNetworkEndIP returns the last address of the managed private network range,
+// the last address of the /24 network of its start IP if it has no end IP.
+func privateNetworkEndIP(pn *v3.PrivateNetwork) net.IP {
+	if pn.EndIP != nil {
+		return pn.EndIP
+	}
+
+	start := pn.StartIP.To4()
+	return net.IPv4(start[0], start[1], start[2], 254)
+}
+
+// lookupPrivateNetwork returns the private network or writes a not found error.
+// It must be called with the lock held.
+func (s *Server) lookupPrivateNetwork(w http.ResponseWriter, id v3.UUID) (*v3.PrivateNetwork, bool) {
+	pn, ok := s.privateNetworks[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "private network %q not found", id)
+	}
+
+	return pn, ok
+}
+
+func (s *Server) listElasticIPs(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := v3.ListElasticIPSResponse{ElasticIPS: []v3.ElasticIP{}}
+	for _, eip := range sorted(s.elasticIPs, func(eip *v3.ElasticIP) string { return eip.IP }) {
+		resp.ElasticIPS = append(resp.ElasticIPS, *eip)
+	}
+
+	writeJSON(w, resp)
+}
+
+func (s *Server) createElasticIP(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateElasticIPRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	used := make([]net.IP, 0, len(s.elasticIPs))
+	for _, eip := range s.elasticIPs {
+		used = append(used, net.ParseIP(eip.IP))
+	}
+	ip, err := allocateIP(elasticIPsStartIP, elasticIPsEndIP, used)
+	if err != nil {
+		writeError(w, http.StatusConflict, "elastic IP: %s", err)
+		return
+	}
+
+	eip := &v3.ElasticIP{
+		ID:            newID(),
+		Addressfamily: v3.ElasticIPAddressfamilyInet4,
+		IP:            ip.String(),
+		Description:   req.Description,
+		Healthcheck:   req.Healthcheck,
+		Labels:        req.Labels,
+	}
+	s.elasticIPs[eip.ID] = eip
+
+	writeJSON(w, s.newOperation("get-elastic-ip", "elastic-ip", eip.ID, nil))
+}
+
+func (s *Server) getElasticIP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	eip, ok := s.lookupElasticIP(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, eip)
+}
+
+func (s *Server) updateElasticIP(w http.ResponseWriter, r *http.Request) {
+	id, action := splitAction(r.PathValue("id"))
+
+	switch action {
+	case "":
+		var req v3.UpdateElasticIPRequest
+		if !s.decode(w, r, &req) {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		eip, ok := s.lookupElasticIP(w, id)
+		if !ok {
+			return
+		}
+		convert(req, eip)
+	case "attach", "detach":
+		var req v3.AttachInstanceToElasticIPRequest
+		if !s.decode(w, r, &req) {
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		eip, ok := s.lookupElasticIP(w, id)
+		if !ok {
+			return
+		}
+		instance, ok := s.lookupInstance(w, req.Instance.ID)
+		if !ok {
+			return
+		}
+
+		attached := slices.IndexFunc(instance.ElasticIPS, func(ref v3.ElasticIP) bool { return ref.ID == id })
+		switch {
+		case action == "attach" && attached < 0:
+			instance.ElasticIPS = append(instance.ElasticIPS, v3.ElasticIP{ID: eip.ID, IP: eip.IP})
+		case action == "detach" && attached >= 0:
+			instance.ElasticIPS = slices.Delete(instance.ElasticIPS, attached, attached+1)
+		default:
+			writeError(w, http.StatusConflict, "cannot %s instance %q: already done", action, instance.ID)
+			return
+		}
+	default:
+		writeError(w, http.StatusNotFound, "elastic IP action %q is not implemented by the fake server", action)
+		return
+	}
+
+	writeJSON(w, s.newOperation("get-elastic-ip", "elastic-ip", id, nil))
+}
+
+func (s *Server) deleteElasticIP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	eip, ok := s.lookupElasticIP(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	for _, instance := range s.instances {
+		if slices.ContainsFunc(instance.ElasticIPS, func(ref v3.ElasticIP) bool { return ref.ID == eip.ID }) {
+			writeError(w, http.StatusConflict, "elastic IP %q is attached to instance %q", eip.ID, instance.ID)
+			return
+		}
+	}
+
+	writeJSON(w, s.newOperation("get-elastic-ip", "elastic-ip", eip.ID, func() {
+		delete(s.elasticIPs, eip.ID)
+	}))
+}
+
+// lookupElasticIP returns the elastic IP or writes a not found error.
+// It must be called with the lock held.
+func (s *Server) lookupElasticIP(w http.ResponseWriter, id v3.UUID) (*v3.ElasticIP, bool) {
+	eip, ok := s.elasticIPs[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "elastic IP %q not found", id)
+	}
+
+	return eip, ok
+}
+
+func (s *Server) listSSHKeys(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := v3.ListSSHKeysResponse{SSHKeys: []v3.SSHKey{}}
+	for _, key := range sorted(s.sshKeys, func(key *v3.SSHKey) string { return key.Name }) {
+		resp.SSHKeys = append(resp.SSHKeys, *key)
+	}
+
+	writeJSON(w, resp)
+}
+
+func (s *Server) registerSSHKey(w http.ResponseWriter, r *http.Request) {
+	var req v3.RegisterSSHKeyRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	// Public keys are formatted as "type base64-blob [comment]", the fingerprint is the MD5 of the blob.
+	fields := strings.Fields(req.PublicKey)
+	if len(fields) < 2 {
+		writeError(w, http.StatusBadRequest, "invalid public key")
+		return
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid public key: %s", err)
+		return
+	}
+	sum := md5.Sum(blob)
+	fingerprint := make([]string, len(sum))
+	for i, b := range sum {
+		fingerprint[i] = fmt.Sprintf("%02x", b)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sshKeys[req.Name]; ok {
+		writeError(w, http.StatusConflict, "SSH key %q already exists", req.Name)
+		return
+	}
+
+	s.sshKeys[req.Name] = &v3.SSHKey{Name: req.Name, Fingerprint: strings.Join(fingerprint, ":")}
+	s.sshKeyIDs[req.Name] = newID()
+	writeJSON(w, s.sshKeyOperation(req.Name, nil))
+}
+
+func (s *Server) getSSHKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.sshKeys[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "SSH key %q not found", r.PathValue("name"))
+		return
+	}
+
+	writeJSON(w, key)
+}
+
+func (s *Server) deleteSSHKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := r.PathValue("name")
+	if _, ok := s.sshKeys[name]; !ok {
+		writeError(w, http.StatusNotFound, "SSH key %q not found", name)
+		return
+	}
+
+	writeJSON(w, s.sshKeyOperation(name, func() {
+		delete(s.sshKeys, name)
+		delete(s.sshKeyIDs, name)
+	}))
+}
+
+// sshKeyOperation returns a new operation referencing an SSH key, identified by name in the API
+// and by a UUID in the reference. It must be called with the lock held.
+func (s *Server) sshKeyOperation(name string, complete func()) v3.Operation {
+	return s.newReferenceOperation(v3.OperationReference{
+		ID:      s.sshKeyIDs[name],
+		Command: "get-ssh-key",
+		Link:    "/v2/ssh-key/" + name,
+	}, complete)
+}
+
+func (s *Server) listTemplates(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	visibility := v3.TemplateVisibility(r.URL.Query().Get("visibility"))
+	if visibility == "" {
+		visibility = v3.TemplateVisibilityPublic
+	}
+	family := r.URL.Query().Get("family")
+
+	resp := v3.ListTemplatesResponse{Templates: []v3.Template{}}
+	for _, t := range sorted(s.templates, func(t *v3.Template) string { return t.Name }) {
+		if t.Visibility != visibility || (family != "" && t.Family != family) {
+			continue
+		}
+		resp.Templates = append(resp.Templates, *t)
+	}
+
+	writeJSON(w, resp)
+}
+
+func (s *Server) registerTemplate(w http.ResponseWriter, r *http.Request) {
+	var req v3.RegisterTemplateRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := &v3.Template{
+		ID:         newID(),
+		CreatedAT:  time.Now().UTC(),
+		Visibility: v3.TemplateVisibilityPrivate,
+		Zones:      []v3.ZoneName{s.zone},
+	}
+	convert(req, t)
+
+	// Registered templates are only listed once downloaded.
+	writeJSON(w, s.newOperation("get-template", "template", t.ID, func() {
+		s.templates[t.ID] = t
+	}))
+}
+
+func (s *Server) getTemplate(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.lookupTemplate(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, t)
+}
+
+func (s *Server) updateTemplate(w http.ResponseWriter, r *http.Request) {
+	var req v3.UpdateTemplateRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.lookupTemplate(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+	if t.Visibility == v3.TemplateVisibilityPublic {
+		writeError(w, http.StatusForbidden, "public template %q cannot be updated", t.ID)
+		return
+	}
+	convert(req, t)
+
+	writeJSON(w, s.newOperation("get-template", "template", t.ID, nil))
+}
+
+func (s *Server) deleteTemplate(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.lookupTemplate(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+	if t.Visibility == v3.TemplateVisibilityPublic {
+		writeError(w, http.StatusForbidden, "public template %q cannot be deleted", t.ID)
+		return
+	}
+
+	writeJSON(w, s.newOperation("get-template", "template", t.ID, func() {
+		delete(s.templates, t.ID)
+	}))
+}
+
+// lookupTemplate returns the template or writes a not found error.
+// It must be called with the lock held.
+func (s *Server) lookupTemplate(w http.ResponseWriter, id v3.UUID) (*v3.Template, bool) {
+	t, ok := s.templates[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "template %q not found", id)
+	}
+
+	return t, ok
+}
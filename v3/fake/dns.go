@@ -0,0 +1,224 @@
+package fake
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	v3 "github.com/exoscale/egoscale/v3"
+)
+
+func (s *Server) dnsRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /dns-domain", s.listDNSDomains)
+	mux.HandleFunc("POST /dns-domain", s.createDNSDomain)
+	mux.HandleFunc("GET /dns-domain/{id}", s.getDNSDomain)
+	mux.HandleFunc("DELETE /dns-domain/{id}", s.deleteDNSDomain)
+	mux.HandleFunc("GET /dns-domain/{id}/record", s.listDNSDomainRecords)
+	mux.HandleFunc("POST /dns-domain/{id}/record", s.createDNSDomainRecord)
+	mux.HandleFunc("GET /dns-domain/{id}/record/{record}", s.getDNSDomainRecord)
+	mux.HandleFunc("PUT /dns-domain/{id}/record/{record}", s.updateDNSDomainRecord)
+	mux.HandleFunc("DELETE /dns-domain/{id}/record/{record}", s.deleteDNSDomainRecord)
+}
+
+func (s *Server) listDNSDomains(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := v3.ListDNSDomainsResponse{DNSDomains: []v3.DNSDomain{}}
+	for _, domain := range sorted(s.dnsDomains, func(d *v3.DNSDomain) string { return d.UnicodeName }) {
+		resp.DNSDomains = append(resp.DNSDomains, *domain)
+	}
+
+	writeJSON(w, resp)
+}
+
+func (s *Server) createDNSDomain(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateDNSDomainRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+	if req.UnicodeName == "" {
+		writeError(w, http.StatusBadRequest, "missing domain name")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, domain := range s.dnsDomains {
+		if strings.EqualFold(domain.UnicodeName, req.UnicodeName) {
+			writeError(w, http.StatusConflict, "domain %q already exists", req.UnicodeName)
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+	domain := &v3.DNSDomain{ID: newID(), UnicodeName: req.UnicodeName, CreatedAT: now}
+	s.dnsDomains[domain.ID] = domain
+
+	// Domains are created with their SOA and NS system records.
+	s.dnsRecords[domain.ID] = map[v3.UUID]*v3.DNSDomainRecord{}
+	for _, record := range []v3.DNSDomainRecord{
+		{Type: v3.DNSDomainRecordTypeSOA, Content: "ns1.exoscale.ch. support.exoscale.ch. 1 10800 3600 604800 3600"},
+		{Type: v3.DNSDomainRecordTypeNS, Content: "ns1.exoscale.ch."},
+		{Type: v3.DNSDomainRecordTypeNS, Content: "ns1.exoscale.com."},
+	} {
+		record.ID = newID()
+		record.Ttl = 3600
+		record.SystemRecord = v3.Ptr(true)
+		record.CreatedAT, record.UpdatedAT = now, now
+		s.dnsRecords[domain.ID][record.ID] = &record
+	}
+
+	writeJSON(w, s.newOperation("get-dns-domain", "dns-domain", domain.ID, nil))
+}
+
+func (s *Server) getDNSDomain(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	domain, ok := s.lookupDNSDomain(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, domain)
+}
+
+func (s *Server) deleteDNSDomain(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	domain, ok := s.lookupDNSDomain(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, s.newOperation("get-dns-domain", "dns-domain", domain.ID, func() {
+		delete(s.dnsDomains, domain.ID)
+		delete(s.dnsRecords, domain.ID)
+	}))
+}
+
+func (s *Server) listDNSDomainRecords(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	domain, ok := s.lookupDNSDomain(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	resp := v3.ListDNSDomainRecordsResponse{DNSDomainRecords: []v3.DNSDomainRecord{}}
+	for _, record := range sorted(s.dnsRecords[domain.ID], func(r *v3.DNSDomainRecord) string {
+		return r.Name + " " + string(r.Type) + " " + r.Content
+	}) {
+		resp.DNSDomainRecords = append(resp.DNSDomainRecords, *record)
+	}
+
+	writeJSON(w, resp)
+}
+
+func (s *Server) createDNSDomainRecord(w http.ResponseWriter, r *http.Request) {
+	var req v3.CreateDNSDomainRecordRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	domain, ok := s.lookupDNSDomain(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return
+	}
+
+	now := time.Now().UTC()
+	record := &v3.DNSDomainRecord{ID: newID(), Ttl: 3600, CreatedAT: now, UpdatedAT: now}
+	convert(req, record)
+	s.dnsRecords[domain.ID][record.ID] = record
+
+	writeJSON(w, s.newOperation("get-dns-domain-record", "dns-domain/"+domain.ID.String()+"/record", record.ID, nil))
+}
+
+func (s *Server) getDNSDomainRecord(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.lookupDNSDomainRecord(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, record)
+}
+
+func (s *Server) updateDNSDomainRecord(w http.ResponseWriter, r *http.Request) {
+	var req v3.UpdateDNSDomainRecordRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.lookupDNSDomainRecord(w, r)
+	if !ok {
+		return
+	}
+	if record.SystemRecord != nil && *record.SystemRecord {
+		writeError(w, http.StatusForbidden, "system record %q cannot be updated", record.ID)
+		return
+	}
+
+	convert(req, record)
+	record.UpdatedAT = time.Now().UTC()
+
+	writeJSON(w, s.newOperation("get-dns-domain-record", "dns-domain/"+r.PathValue("id")+"/record", record.ID, nil))
+}
+
+func (s *Server) deleteDNSDomainRecord(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.lookupDNSDomainRecord(w, r)
+	if !ok {
+		return
+	}
+	if record.SystemRecord != nil && *record.SystemRecord {
+		writeError(w, http.StatusForbidden, "system record %q cannot be deleted", record.ID)
+		return
+	}
+
+	domainID := v3.UUID(r.PathValue("id"))
+	writeJSON(w, s.newOperation("get-dns-domain-record", "dns-domain/"+domainID.String()+"/record", record.ID, func() {
+		delete(s.dnsRecords[domainID], record.ID)
+	}))
+}
+
+// lookupDNSDomain returns the DNS domain or writes a not found error.
+// It must be called with the lock held.
+func (s *Server) lookupDNSDomain(w http.ResponseWriter, id v3.UUID) (*v3.DNSDomain, bool) {
+	domain, ok := s.dnsDomains[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "DNS domain %q not found", id)
+	}
+
+	return domain, ok
+}
+
+// lookupDNSDomainRecord returns the DNS domain record of the request path or writes a not found error.
+// It must be called with the lock held.
+func (s *Server) lookupDNSDomainRecord(w http.ResponseWriter, r *http.Request) (*v3.DNSDomainRecord, bool) {
+	domain, ok := s.lookupDNSDomain(w, v3.UUID(r.PathValue("id")))
+	if !ok {
+		return nil, false
+	}
+
+	record, ok := s.dnsRecords[domain.ID][v3.UUID(r.PathValue("record"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "DNS domain record %q not found", r.PathValue("record"))
+	}
+
+	return record, ok
+}
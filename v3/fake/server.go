@@ -0,0 +1,398 @@
+// Package fake provides an in-memory Exoscale API server, to exercise v3 clients in tests
+// without credentials nor network access.
+//
+// The server implements the core Compute resources (instances, security groups, private networks,
+// elastic IPs, SSH keys and templates), DNS domains and records, and the async operations
+// returned by their mutations.
+package fake
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+// ServerOpt represents a function setting a Server option.
+type ServerOpt func(*Server)
+
+// ServerOptWithOperationDelay returns a ServerOpt keeping the operations pending for d before they succeed.
+// By default, operations succeed before being returned.
+func ServerOptWithOperationDelay(d time.Duration) ServerOpt {
+	return func(s *Server) {
+		s.operationDelay = d
+	}
+}
+
+// ServerOptWithCredentials returns a ServerOpt overriding the API key pair requests must be signed with
+// (EXOfake and fake by default).
+func ServerOptWithCredentials(apiKey, apiSecret string) ServerOpt {
+	return func(s *Server) {
+		s.apiKey = apiKey
+		s.apiSecret = apiSecret
+	}
+}
+
+// ServerOptWithZone returns a ServerOpt overriding the zone served by the Server (ch-gva-2 by default).
+func ServerOptWithZone(zone v3.ZoneName) ServerOpt {
+	return func(s *Server) {
+		s.zone = zone
+	}
+}
+
+// A Server is an in-memory Exoscale API of a single zone, served over HTTP.
+type Server struct {
+	srv            *httptest.Server
+	signer         *v3.Signer
+	validate       *validator.Validate
+	operationDelay time.Duration
+	apiKey         string
+	apiSecret      string
+	zone           v3.ZoneName
+
+	mu              sync.Mutex
+	operations      map[v3.UUID]*operation
+	pending         []*operation
+	instances       map[v3.UUID]*v3.Instance
+	securityGroups  map[v3.UUID]*v3.SecurityGroup
+	privateNetworks map[v3.UUID]*v3.PrivateNetwork
+	elasticIPs      map[v3.UUID]*v3.ElasticIP
+	sshKeys         map[string]*v3.SSHKey
+	sshKeyIDs       map[string]v3.UUID
+	templates       map[v3.UUID]*v3.Template
+	dnsDomains      map[v3.UUID]*v3.DNSDomain
+	dnsRecords      map[v3.UUID]map[v3.UUID]*v3.DNSDomainRecord
+}
+
+// An operation is an async operation, applying complete when it succeeds.
+type operation struct {
+	v3.Operation
+	doneAt   time.Time
+	complete func()
+}
+
+// NewServer starts and returns a new Server, seeded with a public template.
+// The Server must be closed once done.
+func NewServer(opts ...ServerOpt) *Server {
+	s := &Server{
+		signer:          v3.NewSigner(),
+		validate:        validator.New(),
+		apiKey:          "EXOfake",
+		apiSecret:       "fake",
+		zone:            v3.ZoneNameCHGva2,
+		operations:      map[v3.UUID]*operation{},
+		instances:       map[v3.UUID]*v3.Instance{},
+		securityGroups:  map[v3.UUID]*v3.SecurityGroup{},
+		privateNetworks: map[v3.UUID]*v3.PrivateNetwork{},
+		elasticIPs:      map[v3.UUID]*v3.ElasticIP{},
+		sshKeys:         map[string]*v3.SSHKey{},
+		sshKeyIDs:       map[string]v3.UUID{},
+		templates:       map[v3.UUID]*v3.Template{},
+		dnsDomains:      map[v3.UUID]*v3.DNSDomain{},
+		dnsRecords:      map[v3.UUID]map[v3.UUID]*v3.DNSDomainRecord{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.AddTemplate(v3.Template{
+		Name:            "Linux Ubuntu 24.04 LTS 64-bit",
+		Family:          "ubuntu",
+		DefaultUser:     "ubuntu",
+		BootMode:        v3.TemplateBootModeLegacy,
+		PasswordEnabled: v3.Ptr(false),
+		SSHKeyEnabled:   v3.Ptr(true),
+		Size:            10 << 30,
+		Visibility:      v3.TemplateVisibilityPublic,
+	})
+
+	mux := http.NewServeMux()
+	s.routes(mux)
+	s.srv = httptest.NewServer(s.authenticate(mux))
+
+	return s
+}
+
+// Close shuts down the Server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Endpoint returns the API endpoint of the Server.
+func (s *Server) Endpoint() v3.Endpoint {
+	return v3.Endpoint(s.srv.URL)
+}
+
+// Client returns a v3.Client of the Server, signing requests with the Server credentials.
+func (s *Server) Client(opts ...v3.ClientOpt) (*v3.Client, error) {
+	opts = append([]v3.ClientOpt{
+		v3.ClientOptWithEndpoint(s.Endpoint()),
+		v3.ClientOptWithHTTPClient(s.srv.Client()),
+	}, opts...)
+
+	return v3.NewClient(credentials.NewStaticCredentials(s.apiKey, s.apiSecret), opts...)
+}
+
+// AddTemplate adds a template to the Server and returns it with its ID.
+func (s *Server) AddTemplate(t v3.Template) v3.Template {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if t.ID == "" {
+		t.ID = newID()
+	}
+	if t.CreatedAT.IsZero() {
+		t.CreatedAT = time.Now().UTC()
+	}
+	if len(t.Zones) == 0 {
+		t.Zones = []v3.ZoneName{s.zone}
+	}
+	s.templates[t.ID] = &t
+
+	return t
+}
+
+func (s *Server) routes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /zone", s.listZones)
+	mux.HandleFunc("GET /operation/{id}", s.getOperation)
+
+	s.computeRoutes(mux)
+	s.dnsRoutes(mux)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "%s %s is not implemented by the fake server", r.Method, r.URL.Path)
+	})
+}
+
+// authenticate verifies the request signatures and completes the due operations before serving requests.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := s.signer.Verify(r, func(apiKey string) (string, error) {
+			if apiKey != s.apiKey {
+				return "", fmt.Errorf("unknown API key %q", apiKey)
+			}
+			return s.apiSecret, nil
+		})
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, "%s", err)
+			return
+		}
+
+		s.mu.Lock()
+		s.completeOperations()
+		s.mu.Unlock()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) listZones(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, v3.ListZonesResponse{Zones: []v3.Zone{{Name: s.zone, APIEndpoint: s.Endpoint()}}})
+}
+
+func (s *Server) getOperation(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op, ok := s.operations[v3.UUID(r.PathValue("id"))]
+	if !ok {
+		writeError(w, http.StatusNotFound, "operation %q not found", r.PathValue("id"))
+		return
+	}
+
+	writeJSON(w, op.Operation)
+}
+
+// newOperation returns a new operation referencing the resource returned by the getter operation,
+// calling complete once it succeeds. It must be called with the lock held.
+func (s *Server) newOperation(getter, path string, id v3.UUID, complete func()) v3.Operation {
+	return s.newReferenceOperation(v3.OperationReference{
+		ID:      id,
+		Command: getter,
+		Link:    "/v2/" + path + "/" + id.String(),
+	}, complete)
+}
+
+// newReferenceOperation returns a new operation with the given reference, calling complete once it succeeds.
+// It must be called with the lock held.
+func (s *Server) newReferenceOperation(ref v3.OperationReference, complete func()) v3.Operation {
+	op := &operation{
+		Operation: v3.Operation{
+			ID:        newID(),
+			State:     v3.OperationStatePending,
+			Reference: &ref,
+		},
+		doneAt:   time.Now().Add(s.operationDelay),
+		complete: complete,
+	}
+	s.operations[op.ID] = op
+	s.pending = append(s.pending, op)
+
+	if s.operationDelay <= 0 {
+		s.completeOperations()
+	}
+
+	return op.Operation
+}
+
+// completeOperations completes the pending operations which are due, in creation order.
+// It must be called with the lock held.
+func (s *Server) completeOperations() {
+	now := time.Now()
+	for len(s.pending) > 0 && !s.pending[0].doneAt.After(now) {
+		op := s.pending[0]
+		s.pending = s.pending[1:]
+
+		if op.complete != nil {
+			op.complete()
+		}
+		op.State = v3.OperationStateSuccess
+	}
+}
+
+// errIPRangeExhausted is returned by allocateIP when every address of the range is in use.
+var errIPRangeExhausted = errors.New("IP address range exhausted")
+
+// allocateIP returns the lowest IPv4 address of the start-end range which is not used.
+func allocateIP(start, end net.IP, used []net.IP) (net.IP, error) {
+	inUse := make(map[uint32]struct{}, len(used))
+	for _, ip := range used {
+		if ip4 := ip.To4(); ip4 != nil {
+			inUse[binary.BigEndian.Uint32(ip4)] = struct{}{}
+		}
+	}
+
+	first, last := binary.BigEndian.Uint32(start.To4()), binary.BigEndian.Uint32(end.To4())
+	for n := first; n <= last && n >= first; n++ {
+		if _, ok := inUse[n]; !ok {
+			return binary.BigEndian.AppendUint32(nil, n), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s - %s: %w", start, end, errIPRangeExhausted)
+}
+
+// ipInRange returns true if ip is an IPv4 address of the start-end range.
+func ipInRange(ip, start, end net.IP) bool {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return false
+	}
+	n := binary.BigEndian.Uint32(ip4)
+
+	return n >= binary.BigEndian.Uint32(start.To4()) && n <= binary.BigEndian.Uint32(end.To4())
+}
+
+func newID() v3.UUID {
+	return v3.UUID(uuid.NewString())
+}
+
+// decode decodes and validates the JSON request body into v.
+func (s *Server) decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: %s", err)
+		return false
+	}
+
+	if err := s.validate.Struct(v); err != nil {
+		var validationErrors validator.ValidationErrors
+		if errors.As(err, &validationErrors) {
+			writeValidationError(w, v3.NewValidationError(v, validationErrors))
+			return false
+		}
+		writeError(w, http.StatusBadRequest, "invalid request body: %s", err)
+		return false
+	}
+
+	return true
+}
+
+// convert converts the src schema into the dst one, sharing the same JSON representation.
+func convert(src, dst any) {
+	data, err := json.Marshal(src)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		panic(err)
+	}
+}
+
+// sorted returns the values of m sorted by key, for stable listings.
+func sorted[K comparable, V any](m map[K]V, key func(V) string) []V {
+	values := slices.Collect(maps.Values(m))
+	slices.SortFunc(values, func(a, b V) int { return strings.Compare(key(a), key(b)) })
+
+	return values
+}
+
+// splitAction splits the {id}:{action} path segments.
+func splitAction(segment string) (v3.UUID, string) {
+	id, action, _ := strings.Cut(segment, ":")
+	return v3.UUID(id), action
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// problem is an RFC 9457 problem details response body.
+type problem struct {
+	Type   string         `json:"type"`
+	Title  string         `json:"title"`
+	Status int            `json:"status"`
+	Detail string         `json:"detail,omitempty"`
+	Errors []problemError `json:"errors,omitempty"`
+}
+
+type problemError struct {
+	Detail   string `json:"detail"`
+	Location string `json:"location"`
+}
+
+func writeProblem(w http.ResponseWriter, p problem) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+func writeError(w http.ResponseWriter, status int, format string, args ...any) {
+	writeProblem(w, problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: fmt.Sprintf(format, args...),
+	})
+}
+
+func writeValidationError(w http.ResponseWriter, validationErr *v3.ValidationError) {
+	p := problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusBadRequest),
+		Status: http.StatusBadRequest,
+		Detail: "invalid request body",
+	}
+	for _, f := range validationErr.Errors {
+		p.Errors = append(p.Errors, problemError{
+			Detail:   fmt.Sprintf("does not validate '%s'", f.Rule),
+			Location: "body." + f.Path,
+		})
+	}
+
+	writeProblem(w, p)
+}
@@ -0,0 +1,331 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	v3 "github.com/exoscale/egoscale/v3"
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+const testPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIIaFGEmTSwPwFFvgDNCbdqWBbV9MH4ReqTqsDXDoXMh9 test"
+
+func newTestClient(t *testing.T, opts ...ServerOpt) (*Server, *v3.Client) {
+	t.Helper()
+
+	srv := NewServer(opts...)
+	t.Cleanup(srv.Close)
+
+	client, err := srv.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return srv, client
+}
+
+func TestServerInstanceLifecycle(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	templates, err := client.ListTemplates(ctx)
+	if err != nil || len(templates.Templates) != 1 {
+		t.Fatalf("expected the seeded template, got %+v: %v", templates, err)
+	}
+
+	if _, err := client.RegisterSSHKey(ctx, v3.RegisterSSHKeyRequest{Name: "test", PublicKey: testPublicKey}); err != nil {
+		t.Fatal(err)
+	}
+	sg, err := client.CreateSecurityGroupAndWait(ctx, v3.CreateSecurityGroupRequest{Name: "web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.AddRuleToSecurityGroup(ctx, sg.ID, v3.AddRuleToSecurityGroupRequest{
+		FlowDirection: v3.AddRuleToSecurityGroupRequestFlowDirectionIngress,
+		Protocol:      v3.AddRuleToSecurityGroupRequestProtocolTCP,
+		Network:       "0.0.0.0/0",
+		StartPort:     443,
+		EndPort:       443,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	instance, err := client.CreateInstanceAndWait(ctx, v3.CreateInstanceRequest{
+		Name:           "test",
+		DiskSize:       10,
+		InstanceType:   &v3.InstanceType{ID: "b6cd1ff5-3a2f-4e9d-a4d1-8988c1191fe8"},
+		Template:       &templates.Templates[0],
+		SSHKey:         &v3.SSHKey{Name: "test"},
+		SecurityGroups: []v3.SecurityGroup{{ID: sg.ID}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if instance.State != v3.InstanceStateRunning || instance.PublicIP == nil || len(instance.SecurityGroups) != 1 {
+		t.Errorf("unexpected instance %+v", instance)
+	}
+
+	if _, err := client.DeleteSecurityGroup(ctx, sg.ID); !errors.Is(err, v3.ErrConflict) {
+		t.Errorf("expected ErrConflict deleting a security group in use, got %v", err)
+	}
+
+	pn, err := client.CreatePrivateNetworkAndWait(ctx, v3.CreatePrivateNetworkRequest{Name: "private"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pn, err = client.AttachInstanceToPrivateNetworkAndWait(ctx, pn.ID, v3.AttachInstanceToPrivateNetworkRequest{
+		Instance: &v3.AttachInstanceToPrivateNetworkRequestInstance{ID: instance.ID},
+	}); err != nil || len(pn.Leases) != 1 {
+		t.Errorf("unexpected private network %+v: %v", pn, err)
+	}
+
+	instance, err = client.StopInstanceAndWait(ctx, instance.ID)
+	if err != nil || instance.State != v3.InstanceStateStopped || len(instance.PrivateNetworks) != 1 {
+		t.Errorf("unexpected instance %+v: %v", instance, err)
+	}
+	if _, err := client.StopInstance(ctx, instance.ID); !errors.Is(err, v3.ErrConflict) {
+		t.Errorf("expected ErrConflict stopping a stopped instance, got %v", err)
+	}
+
+	op, err := client.DeleteInstance(ctx, instance.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WaitWithOpts(ctx, op,
+		v3.WaitOptWithBackoff(func(time.Duration) time.Duration { return 10 * time.Millisecond }),
+	); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetInstance(ctx, instance.ID); !errors.Is(err, v3.ErrNotFound) {
+		t.Errorf("expected ErrNotFound for a deleted instance, got %v", err)
+	}
+	if pn, err := client.GetPrivateNetwork(ctx, pn.ID); err != nil || len(pn.Leases) != 0 {
+		t.Errorf("the leases of deleted instances must be released, got %+v: %v", pn, err)
+	}
+}
+
+func TestServerOperationDelay(t *testing.T) {
+	_, client := newTestClient(t, ServerOptWithOperationDelay(50*time.Millisecond))
+	ctx := context.Background()
+
+	templates, err := client.ListTemplates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op, err := client.CreateInstance(ctx, v3.CreateInstanceRequest{
+		DiskSize:     10,
+		InstanceType: &v3.InstanceType{ID: "b6cd1ff5-3a2f-4e9d-a4d1-8988c1191fe8"},
+		Template:     &templates.Templates[0],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.State != v3.OperationStatePending {
+		t.Errorf("expected a pending operation, got %q", op.State)
+	}
+
+	instance, err := client.GetInstance(ctx, op.Reference.ID)
+	if err != nil || instance.State != v3.InstanceStateStarting {
+		t.Errorf("unexpected instance %+v: %v", instance, err)
+	}
+
+	var polls int
+	if _, err := client.WaitWithOpts(ctx, op,
+		v3.WaitOptWithStates(v3.OperationStateSuccess),
+		v3.WaitOptWithBackoff(func(time.Duration) time.Duration { return 10 * time.Millisecond }),
+		v3.WaitOptWithOnPoll(func(*v3.Operation, time.Duration) { polls++ }),
+	); err != nil {
+		t.Fatal(err)
+	}
+	if polls < 2 {
+		t.Errorf("expected the operation to be pending for a while, got %d polls", polls)
+	}
+
+	if instance, err = client.GetInstance(ctx, op.Reference.ID); err != nil || instance.State != v3.InstanceStateRunning {
+		t.Errorf("unexpected instance %+v: %v", instance, err)
+	}
+}
+
+func TestServerValidation(t *testing.T) {
+	_, client := newTestClient(t)
+
+	_, err := client.CreateInstance(context.Background(), v3.CreateInstanceRequest{
+		DiskSize:     5,
+		InstanceType: &v3.InstanceType{ID: "b6cd1ff5-3a2f-4e9d-a4d1-8988c1191fe8"},
+		Template:     &v3.Template{ID: "b6cd1ff5-3a2f-4e9d-a4d1-8988c1191fe8"},
+	})
+
+	var apiErr *v3.APIError
+	if !errors.As(err, &apiErr) || !errors.Is(err, v3.ErrBadRequest) {
+		t.Fatalf("expected a bad request APIError, got %v", err)
+	}
+	if len(apiErr.Errors) != 1 || apiErr.Errors[0].Location != "body.disk-size" {
+		t.Errorf("unexpected errors %+v", apiErr.Errors)
+	}
+}
+
+func TestServerAuthentication(t *testing.T) {
+	srv, _ := newTestClient(t)
+
+	client, err := srv.Client(v3.ClientOptWithCredentials(credentials.NewStaticCredentials("EXOfake", "wrong")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.ListZones(context.Background()); !errors.Is(err, v3.ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestServerDNS(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	domain, err := client.CreateDNSDomainAndWait(ctx, v3.CreateDNSDomainRequest{UnicodeName: "example.net"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op, err := client.CreateDNSDomainRecord(ctx, domain.ID, v3.CreateDNSDomainRecordRequest{
+		Name:    "www",
+		Type:    v3.CreateDNSDomainRecordRequestTypeA,
+		Content: "198.51.100.1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.UpdateDNSDomainRecord(ctx, domain.ID, op.Reference.ID, v3.UpdateDNSDomainRecordRequest{Ttl: 60}); err != nil {
+		t.Fatal(err)
+	}
+	record, err := client.GetDNSDomainRecord(ctx, domain.ID, op.Reference.ID)
+	if err != nil || record.Ttl != 60 || record.Content != "198.51.100.1" {
+		t.Errorf("unexpected record %+v: %v", record, err)
+	}
+
+	records, err := client.ListDNSDomainRecords(ctx, domain.ID)
+	if err != nil || len(records.DNSDomainRecords) != 4 {
+		t.Fatalf("expected the system records and the created one, got %+v: %v", records, err)
+	}
+
+	for _, r := range records.DNSDomainRecords {
+		if r.Type == v3.DNSDomainRecordTypeSOA {
+			if _, err := client.DeleteDNSDomainRecord(ctx, domain.ID, r.ID); !errors.Is(err, v3.ErrForbidden) {
+				t.Errorf("expected ErrForbidden deleting a system record, got %v", err)
+			}
+		}
+	}
+}
+
+func TestServerPrivateNetworkLeases(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	templates, err := client.ListTemplates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pn, err := client.CreatePrivateNetworkAndWait(ctx, v3.CreatePrivateNetworkRequest{
+		Name:    "private",
+		StartIP: net.ParseIP("10.0.1.250"),
+		EndIP:   net.ParseIP("10.0.1.251"),
+		Netmask: net.ParseIP("255.255.255.0"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var leases []string
+	for i := range 4 {
+		instance, err := client.CreateInstanceAndWait(ctx, v3.CreateInstanceRequest{
+			DiskSize:     10,
+			InstanceType: &v3.InstanceType{ID: "b6cd1ff5-3a2f-4e9d-a4d1-8988c1191fe8"},
+			Template:     &templates.Templates[0],
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req := v3.AttachInstanceToPrivateNetworkRequest{
+			Instance: &v3.AttachInstanceToPrivateNetworkRequestInstance{ID: instance.ID},
+		}
+		switch i {
+		case 2:
+			if _, err := client.AttachInstanceToPrivateNetwork(ctx, pn.ID, req); !errors.Is(err, v3.ErrConflict) {
+				t.Errorf("expected ErrConflict once the range is exhausted, got %v", err)
+			}
+			continue
+		case 3:
+			req.IP = net.ParseIP("10.0.2.1")
+			if _, err := client.AttachInstanceToPrivateNetwork(ctx, pn.ID, req); !errors.Is(err, v3.ErrBadRequest) {
+				t.Errorf("expected ErrBadRequest for an IP outside of the range, got %v", err)
+			}
+			continue
+		}
+
+		if pn, err = client.AttachInstanceToPrivateNetworkAndWait(ctx, pn.ID, req); err != nil {
+			t.Fatal(err)
+		}
+		leases = append(leases, pn.Leases[len(pn.Leases)-1].IP.String())
+	}
+
+	if !slices.Equal(leases, []string{"10.0.1.250", "10.0.1.251"}) {
+		t.Errorf("the leases must be allocated in the private network range, got %v", leases)
+	}
+}
+
+func TestServerSSHKeyOperation(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+
+	op, err := client.RegisterSSHKey(ctx, v3.RegisterSSHKeyRequest{Name: "test", PublicKey: testPublicKey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uuid.Parse(op.Reference.ID.String()); err != nil || op.Reference.Link != "/v2/ssh-key/test" {
+		t.Errorf("unexpected SSH key reference %+v", op.Reference)
+	}
+
+	deleted, err := client.DeleteSSHKey(ctx, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.Reference.ID != op.Reference.ID {
+		t.Errorf("the SSH key must keep its reference ID, got %s and %s", op.Reference.ID, deleted.Reference.ID)
+	}
+}
+
+func TestServerDeleteOperationDelay(t *testing.T) {
+	_, client := newTestClient(t, ServerOptWithOperationDelay(50*time.Millisecond))
+	ctx := context.Background()
+
+	backoff := v3.WaitOptWithBackoff(func(time.Duration) time.Duration { return 10 * time.Millisecond })
+
+	sg, err := client.CreateSecurityGroupAndWait(ctx, v3.CreateSecurityGroupRequest{Name: "web"}, backoff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op, err := client.DeleteSecurityGroup(ctx, sg.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetSecurityGroup(ctx, sg.ID); err != nil {
+		t.Errorf("the security group must be deleted once the operation succeeds, got %v", err)
+	}
+
+	if _, err := client.WaitWithOpts(ctx, op, backoff); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetSecurityGroup(ctx, sg.ID); !errors.Is(err, v3.ErrNotFound) {
+		t.Errorf("expected ErrNotFound once the deletion succeeded, got %v", err)
+	}
+}
@@ -0,0 +1,302 @@
+package v3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FaultOpt represents a function setting an option of a fault injected by a FaultInjector.
+type FaultOpt func(*fault)
+
+// FaultOptWithOperations returns a FaultOpt injecting the fault in the given operations only
+// (e.g. "create-instance"), instead of every request.
+func FaultOptWithOperations(operationIDs ...string) FaultOpt {
+	return func(f *fault) {
+		f.operationIDs = append(f.operationIDs, operationIDs...)
+	}
+}
+
+// FaultOptWithProbability returns a FaultOpt injecting the fault with the given probability,
+// between 0 and 1 (1 by default).
+func FaultOptWithProbability(p float64) FaultOpt {
+	return func(f *fault) {
+		f.probability = p
+	}
+}
+
+// FaultOptWithLatency returns a FaultOpt delaying requests by d.
+func FaultOptWithLatency(d time.Duration) FaultOpt {
+	return func(f *fault) {
+		f.latency = d
+	}
+}
+
+// FaultOptWithStatus returns a FaultOpt answering requests with an RFC 9457 error response of the given status
+// (e.g. 429 or 503) instead of sending them.
+func FaultOptWithStatus(statusCode int) FaultOpt {
+	return func(f *fault) {
+		f.statusCode = statusCode
+	}
+}
+
+// FaultOptWithRetryAfter returns a FaultOpt setting the Retry-After header of the injected error responses.
+func FaultOptWithRetryAfter(d time.Duration) FaultOpt {
+	return func(f *fault) {
+		f.retryAfter = d
+	}
+}
+
+// FaultOptWithConnectionReset returns a FaultOpt failing requests with a connection reset error instead of sending them.
+func FaultOptWithConnectionReset() FaultOpt {
+	return func(f *fault) {
+		f.connectionReset = true
+	}
+}
+
+// FaultOptWithStuckOperations returns a FaultOpt reporting the polled operations as pending,
+// whatever their actual state.
+func FaultOptWithStuckOperations() FaultOpt {
+	return func(f *fault) {
+		f.stuckOperations = true
+	}
+}
+
+type fault struct {
+	operationIDs    []string
+	probability     float64
+	latency         time.Duration
+	statusCode      int
+	retryAfter      time.Duration
+	connectionReset bool
+	stuckOperations bool
+}
+
+// FaultInjectorOpt represents a function setting a FaultInjector option.
+type FaultInjectorOpt func(*FaultInjector)
+
+// FaultInjectorOptWithSeed returns a FaultInjectorOpt seeding the random source of the fault probabilities,
+// for reproducible runs.
+func FaultInjectorOptWithSeed(seed uint64) FaultInjectorOpt {
+	return func(f *FaultInjector) {
+		f.rand = rand.New(rand.NewPCG(seed, seed))
+	}
+}
+
+// A FaultInjector is an http.RoundTripper injecting faults in API requests, to test how their callers
+// behave when the API misbehaves: latency, error responses, connection resets and operations stuck in pending.
+//
+// Faults match requests by operation ID, as reported by OperationIDFromContext.
+type FaultInjector struct {
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	rand     *rand.Rand
+	faults   []*fault
+	injected map[string]int
+}
+
+// NewFaultInjector returns a FaultInjector sending requests with transport (http.DefaultTransport if nil).
+//
+//	faults := v3.NewFaultInjector(nil)
+//	faults.Inject(v3.FaultOptWithOperations("create-instance"), v3.FaultOptWithStatus(503), v3.FaultOptWithProbability(0.5))
+//	client, err := v3.NewClient(creds, v3.ClientOptWithHTTPClient(&http.Client{Transport: faults}))
+func NewFaultInjector(transport http.RoundTripper, opts ...FaultInjectorOpt) *FaultInjector {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	f := &FaultInjector{
+		transport: transport,
+		rand:      rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+		injected:  map[string]int{},
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
+}
+
+// Inject adds a fault, injected in the matching requests along with the previously added ones.
+func (f *FaultInjector) Inject(opts ...FaultOpt) {
+	flt := &fault{probability: 1}
+	for _, opt := range opts {
+		opt(flt)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.faults = append(f.faults, flt)
+}
+
+// Reset removes the faults and resets the injection counts.
+func (f *FaultInjector) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.faults = nil
+	clear(f.injected)
+}
+
+// Injected returns the number of faults injected in requests of the given operation,
+// counting only the faults that changed the outcome of a request.
+func (f *FaultInjector) Injected(operationID string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.injected[operationID]
+}
+
+// RoundTrip sends the request, injecting the matching faults.
+func (f *FaultInjector) RoundTrip(req *http.Request) (*http.Response, error) {
+	operationID, _ := OperationIDFromContext(req.Context())
+	faults := f.match(operationID)
+
+	injected := map[*fault]struct{}{}
+	defer func() { f.count(operationID, len(injected)) }()
+
+	for _, flt := range faults {
+		if flt.latency <= 0 {
+			continue
+		}
+
+		injected[flt] = struct{}{}
+		timer := time.NewTimer(flt.latency)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+
+	for _, flt := range faults {
+		if flt.connectionReset {
+			injected[flt] = struct{}{}
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}
+		}
+
+		if flt.statusCode != 0 {
+			injected[flt] = struct{}{}
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return faultResponse(req, flt), nil
+		}
+	}
+
+	resp, err := f.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if operationID != "get-operation" || resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	var stuck bool
+	for _, flt := range faults {
+		if flt.stuckOperations {
+			injected[flt] = struct{}{}
+			stuck = true
+		}
+	}
+	if !stuck {
+		return resp, nil
+	}
+
+	return stuckOperationResponse(resp)
+}
+
+// match returns the faults to inject in a request of the given operation.
+func (f *FaultInjector) match(operationID string) []*fault {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var faults []*fault
+	for _, flt := range f.faults {
+		if len(flt.operationIDs) > 0 && !slices.Contains(flt.operationIDs, operationID) {
+			continue
+		}
+		if flt.probability < 1 && f.rand.Float64() >= flt.probability {
+			continue
+		}
+
+		faults = append(faults, flt)
+	}
+
+	return faults
+}
+
+// count records the number of faults injected in a request of the given operation.
+func (f *FaultInjector) count(operationID string, n int) {
+	if n == 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.injected[operationID] += n
+}
+
+// faultResponse returns an RFC 9457 error response of the fault status.
+func faultResponse(req *http.Request, flt *fault) *http.Response {
+	body, _ := json.Marshal(map[string]any{
+		"type":   "about:blank",
+		"title":  http.StatusText(flt.statusCode),
+		"status": flt.statusCode,
+		"detail": "fault injected",
+	})
+
+	header := http.Header{"Content-Type": []string{"application/problem+json"}}
+	if flt.retryAfter > 0 {
+		header.Set("Retry-After", strconv.Itoa(int(flt.retryAfter.Seconds())))
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", flt.statusCode, http.StatusText(flt.statusCode)),
+		StatusCode:    flt.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// stuckOperationResponse rewrites an operation response as pending.
+func stuckOperationResponse(resp *http.Response) (*http.Response, error) {
+	defer resp.Body.Close()
+
+	var op map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&op); err != nil {
+		return nil, fmt.Errorf("fault injector: decode operation: %w", err)
+	}
+	op["state"] = OperationStatePending
+
+	body, err := json.Marshal(op)
+	if err != nil {
+		return nil, fmt.Errorf("fault injector: encode operation: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+
+	return resp, nil
+}
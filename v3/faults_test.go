@@ -0,0 +1,91 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestFaultInjector(t *testing.T) {
+	var calls int
+	faults := NewFaultInjector(nil, FaultInjectorOptWithSeed(1))
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch r.URL.Path {
+		case "/zone":
+			_, _ = w.Write([]byte(`{"zones":[]}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":"0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b","state":"success"}`))
+		}
+	})
+	faults.transport = client.httpClient.Transport
+	client = client.WithHTTPClient(&http.Client{Transport: faults})
+	ctx := context.Background()
+
+	faults.Inject(FaultOptWithOperations("list-zones"), FaultOptWithStatus(http.StatusServiceUnavailable))
+	_, err := client.ListZones(ctx)
+	var apiErr *APIError
+	if !errors.Is(err, ErrServiceUnavailable) || !errors.As(err, &apiErr) || apiErr.Detail != "fault injected" {
+		t.Errorf("expected an injected RFC 9457 error, got %v", err)
+	}
+	if calls != 0 || faults.Injected("list-zones") != 1 {
+		t.Errorf("the request must not be sent, got %d calls and %d faults", calls, faults.Injected("list-zones"))
+	}
+
+	faults.Reset()
+	faults.Inject(FaultOptWithConnectionReset(), FaultOptWithProbability(0))
+	if _, err := client.ListZones(ctx); err != nil || calls != 1 {
+		t.Errorf("faults with a zero probability must not be injected, got %v", err)
+	}
+
+	faults.Reset()
+	faults.Inject(FaultOptWithConnectionReset())
+	if _, err := client.ListZones(ctx); !errors.Is(err, syscall.ECONNRESET) {
+		t.Errorf("expected a connection reset, got %v", err)
+	}
+
+	faults.Reset()
+	faults.Inject(FaultOptWithLatency(time.Second))
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := client.ListZones(timeoutCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the latency to exceed the deadline, got %v", err)
+	}
+
+	faults.Reset()
+	faults.Inject(FaultOptWithStuckOperations())
+	if _, err := client.ListZones(ctx); err != nil || faults.Injected("list-zones") != 0 {
+		t.Errorf("stuck operations must only be counted on rewritten operations, got %d: %v", faults.Injected("list-zones"), err)
+	}
+	_, err = client.WaitWithOpts(ctx, &Operation{ID: "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b", State: OperationStatePending},
+		WaitOptWithBackoff(func(time.Duration) time.Duration { return time.Millisecond }),
+		WaitOptWithTimeout(20*time.Millisecond),
+	)
+	if !errors.Is(err, ErrWaitTimeout) {
+		t.Errorf("expected the operation to be stuck, got %v", err)
+	}
+}
+
+func TestFaultInjectorRetries(t *testing.T) {
+	var calls int
+	faults := NewFaultInjector(nil)
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	})
+	faults.transport = client.httpClient.Transport
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 3
+	client = client.WithHTTPClient(&http.Client{Transport: faults}).WithRetryPolicy(policy)
+
+	faults.Inject(FaultOptWithOperations("list-zones"), FaultOptWithStatus(http.StatusServiceUnavailable))
+	if _, err := client.ListZones(context.Background()); !errors.Is(err, ErrServiceUnavailable) {
+		t.Errorf("expected the retries to be exhausted, got %v", err)
+	}
+	if calls != 0 || faults.Injected("list-zones") != 3 {
+		t.Errorf("every attempt must be faulted, got %d calls and %d faults", calls, faults.Injected("list-zones"))
+	}
+}
@@ -32,6 +32,7 @@ func Generate(doc libopenapi.Document, path, packageName string) error {
 		"fmt"
 		"io"
 		"log"
+		"log/slog"
 		"net/http"
 		"context"
 		"runtime"
@@ -40,6 +41,8 @@ func Generate(doc libopenapi.Document, path, packageName string) error {
 		"github.com/exoscale/egoscale/v3/credentials"
 		"github.com/go-playground/validator/v10"
 		"github.com/hashicorp/go-retryablehttp"
+		"go.opentelemetry.io/otel/metric"
+		"go.opentelemetry.io/otel/trace"
 	)
 	`, packageName))
 
@@ -74,6 +77,7 @@ func Generate(doc libopenapi.Document, path, packageName string) error {
 // Template use client.tmpl file
 type Template struct {
 	Enum           string
+	ZoneEndpoints  string
 	ServerEndpoint string
 }
 
@@ -94,14 +98,17 @@ func renderClient(s *v3.Server) ([]byte, error) {
 		}
 
 		enum := ""
+		zoneEndpoints := ""
 		for _, z := range v.Enum {
 			url := strings.Replace(s.URL, "{zone}", z, 1)
 			enum += fmt.Sprintf("%s Endpoint = %q\n", helpers.ToCamel(z), url)
+			zoneEndpoints += fmt.Sprintf("%q: %s,\n", z, helpers.ToCamel(z))
 		}
 
 		client = Template{
 			ServerEndpoint: helpers.ToCamel(v.Default),
 			Enum:           enum,
+			ZoneEndpoints:  zoneEndpoints,
 		}
 	}
 
@@ -0,0 +1,224 @@
+package operations
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/exoscale/egoscale/v3/generator/helpers"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+// Service groups the API methods of a set of operation tags in an interface.
+type Service struct {
+	Name    string
+	Comment string
+	Tags    []string
+	Methods []Method
+}
+
+// Method describes a Client method.
+type Method struct {
+	Name    string
+	Params  string
+	Args    string
+	Returns string
+}
+
+// newServices returns the interfaces ClientAPI is split into, by first operation tag, without methods.
+// Operations with tags not listed here belong to the Platform service.
+func newServices() []*Service {
+	return []*Service{
+		{
+			Name:    "Compute",
+			Comment: "compute instances, storage, networking and related resources",
+			Tags: []string{
+				"instance", "instance-type", "instance-pool", "template", "snapshot", "ssh-key", "deploy-target",
+				"anti-affinity-group", "security-group", "private-network", "elastic-ip", "reverse-dns",
+				"network-load-balancer", "block-storage",
+			},
+		},
+		{Name: "DBAAS", Comment: "managed databases", Tags: []string{"dbaas"}},
+		{Name: "DNS", Comment: "DNS domains and records", Tags: []string{"domain", "record"}},
+		{Name: "IAM", Comment: "identity and access management", Tags: []string{"role", "api-key", "user", "organization-policy"}},
+		{Name: "KMS", Comment: "key management service", Tags: []string{"kms-key", "crypto"}},
+		{Name: "SKS", Comment: "scalable Kubernetes service", Tags: []string{"cluster", "nodepool", "nodepool-template"}},
+		{Name: "AI", Comment: "dedicated inference", Tags: []string{"deployment", "model", "ai-api-key"}},
+		{Name: "Platform", Comment: "organization, zones, operations and other platform-wide resources"},
+	}
+}
+
+// clientMethods lists the hand-written Client methods part of ClientAPI.
+// Their Args are derived from their Params when rendering.
+var clientMethods = []Method{
+	{Name: "Wait", Params: "ctx context.Context, op *Operation, states ...OperationState", Returns: "(*Operation, error)"},
+	{Name: "WaitWithOpts", Params: "ctx context.Context, op *Operation, opts ...WaitOpt", Returns: "(*Operation, error)"},
+	{Name: "WaitAll", Params: "ctx context.Context, ops ...*Operation", Returns: "([]WaitResult, error)"},
+	{Name: "WaitAllWithOpts", Params: "ctx context.Context, ops []*Operation, opts ...WaitOpt", Returns: "([]WaitResult, error)"},
+	{Name: "WaitAny", Params: "ctx context.Context, ops ...*Operation", Returns: "(int, *Operation, error)"},
+	{Name: "WaitAnyWithOpts", Params: "ctx context.Context, ops []*Operation, opts ...WaitOpt", Returns: "(int, *Operation, error)"},
+	{Name: "GetZoneName", Params: "ctx context.Context, endpoint Endpoint", Returns: "(ZoneName, error)"},
+	{Name: "GetZoneAPIEndpoint", Params: "ctx context.Context, zoneName ZoneName", Returns: "(Endpoint, error)"},
+}
+
+// serviceFor returns the service of the operation among services, by first tag.
+func serviceFor(services []*Service, op *v3.Operation) *Service {
+	if len(op.Tags) > 0 {
+		for _, s := range services {
+			for _, tag := range s.Tags {
+				if tag == op.Tags[0] {
+					return s
+				}
+			}
+		}
+	}
+
+	return services[len(services)-1]
+}
+
+// addMethod adds a method to the service of the operation among services.
+func addMethod(services []*Service, op *v3.Operation, name, params, returns string) {
+	s := serviceFor(services, op)
+	s.Methods = append(s.Methods, newMethod(name, params, returns))
+}
+
+// newMethod returns the Method of the given signature, passing its parameters as arguments.
+func newMethod(name, params, returns string) Method {
+	return Method{
+		Name:    name,
+		Params:  params,
+		Args:    strings.Join(getArguments(strings.Split(params, ", ")), ", "),
+		Returns: returns,
+	}
+}
+
+const apiTemplate = `
+{{ range .Services }}
+// {{ .Name }}API is the interface of the {{ .Comment }} operations of Client.
+type {{ .Name }}API interface {
+{{- range .Methods }}
+	{{ .Name }}({{ .Params }}) {{ .Returns }}
+{{- end }}
+}
+{{ end }}
+
+// ClientAPI is the interface implemented by Client, e.g. to substitute a MockClient in tests.
+type ClientAPI interface {
+{{- range .Services }}
+	{{ .Name }}API
+{{- end }}
+
+{{ range .ClientMethods }}
+	{{ .Name }}({{ .Params }}) {{ .Returns }}
+{{- end }}
+}
+
+var _ ClientAPI = Client{}
+`
+
+const mockTemplate = `
+// MockClient is a ClientAPI implementation calling the function of the matching field,
+// e.g. GetInstanceFunc for GetInstance. Methods with a nil function panic.
+type MockClient struct {
+{{- range .Methods }}
+	{{ .Name }}Func func({{ .Params }}) {{ .Returns }}
+{{- end }}
+}
+
+var _ ClientAPI = (*MockClient)(nil)
+{{ range .Methods }}
+func (mc *MockClient) {{ .Name }}({{ .Params }}) {{ .Returns }} {
+	if mc.{{ .Name }}Func == nil {
+		panic("MockClient: {{ .Name }} is not implemented")
+	}
+
+	return mc.{{ .Name }}Func({{ .Args }})
+}
+{{ end }}
+`
+
+// renderAPI renders the ClientAPI interfaces of services and their MockClient implementation
+// to the client_api.go and client_mock.go files of dir.
+func renderAPI(services []*Service, dir, packageName string) error {
+	var methods []Method
+	for _, s := range services {
+		methods = append(methods, s.Methods...)
+	}
+	handWritten := make([]Method, 0, len(clientMethods))
+	for _, m := range clientMethods {
+		handWritten = append(handWritten, newMethod(m.Name, m.Params, m.Returns))
+	}
+	methods = append(methods, handWritten...)
+
+	files := []struct {
+		name string
+		tmpl string
+	}{
+		{name: "client_api.go", tmpl: apiTemplate},
+		{name: "client_mock.go", tmpl: mockTemplate},
+	}
+
+	for _, f := range files {
+		t, err := template.New(f.name).Parse(f.tmpl)
+		if err != nil {
+			return err
+		}
+
+		body := bytes.NewBuffer([]byte{})
+		if err := t.Execute(body, map[string]any{
+			"Services":      services,
+			"ClientMethods": handWritten,
+			"Methods":       methods,
+		}); err != nil {
+			return err
+		}
+
+		output := bytes.NewBuffer(helpers.Header(packageName, "v0.0.1"))
+		output.WriteString(fmt.Sprintf("package %s\n\n%s\n%s", packageName, renderImports(body.String()), body.String()))
+
+		content, err := format.Source(output.Bytes())
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, f.name), content, os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importUsages matches the references to the packages the ClientAPI files may import, by import path.
+var importUsages = func() map[string]*regexp.Regexp {
+	usages := map[string]*regexp.Regexp{}
+	for _, pkg := range []string{"context", "io", "iter", "net", "net/url", "time"} {
+		usages[pkg] = regexp.MustCompile(`\b` + path.Base(pkg) + `\.`)
+	}
+
+	return usages
+}()
+
+// renderImports returns the import declaration of the packages referenced by the code.
+func renderImports(code string) string {
+	var imports []string
+	for _, pkg := range slices.Sorted(maps.Keys(importUsages)) {
+		if importUsages[pkg].MatchString(code) {
+			imports = append(imports, fmt.Sprintf("%q", pkg))
+		}
+	}
+
+	if len(imports) == 0 {
+		return ""
+	}
+
+	return "import (\n" + strings.Join(imports, "\n") + "\n)\n"
+}
@@ -2,10 +2,14 @@ package operations
 
 import (
 	"bytes"
+	_ "embed"
 	"fmt"
 	"go/format"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 	"text/template"
 
@@ -26,24 +30,18 @@ func Generate(doc libopenapi.Document, path, packageName string) error {
 		}
 	}
 
-	output := bytes.NewBuffer(helpers.Header(packageName, "v0.0.1"))
-	output.WriteString(fmt.Sprintf(`package %s
-	
-import (
-	"context"
-	"fmt"
-	"net"
-	"net/http"
-	"net/url"
-	"time"
-)
-`, packageName))
+	output := bytes.NewBuffer([]byte{})
+	// Streamed request and response bodies are io.Reader and io.ReadCloser.
+	var streamed bool
 
 	if orderedmap.Len(model.Model.Paths.PathItems) == 0 {
 		slog.Warn("no path items defined in the spec")
 		return nil
 	}
 
+	getters := resourceGetters(model.Model.Paths)
+	services := newServices()
+
 	// Iterate over all paths.
 	for pair := orderedmap.SortAlpha(model.Model.Paths.PathItems).First(); pair != nil; pair = pair.Next() {
 		path, pathItems := pair.Key(), pair.Value()
@@ -88,9 +86,51 @@ import (
 				return err
 			}
 			output.Write(m)
+			addMethod(services, operation, request.Name, request.Params, request.ValueReturn)
+			streamed = streamed || strings.Contains(request.Params+request.ValueReturn, "io.")
+
+			iterator, err := iteratorFor(funcName, operation)
+			if err != nil {
+				return err
+			}
+			if iterator != nil {
+				it, err := renderIterator(iterator)
+				if err != nil {
+					return err
+				}
+				output.Write(it)
+				addMethod(services, operation, iterator.Name, iterator.Params, "iter.Seq2["+iterator.ItemType+", error]")
+			}
+
+			if andWait := andWaitFor(path, request, operation, getters); andWait != nil {
+				aw, err := renderAndWait(andWait)
+				if err != nil {
+					return err
+				}
+				output.Write(aw)
+				addMethod(services, operation, andWait.Name, andWait.Params, "(*"+andWait.ResourceType+", error)")
+			}
 		}
 	}
 
+	if err := renderAPI(services, filepath.Dir(path), packageName); err != nil {
+		return err
+	}
+
+	imports := []string{"context", "fmt", "iter", "net", "net/http", "net/url", "time"}
+	if streamed {
+		imports = append(imports, "io")
+		slices.Sort(imports)
+	}
+	header := bytes.NewBuffer(helpers.Header(packageName, "v0.0.1"))
+	header.WriteString(fmt.Sprintf("package %s\n\nimport (\n", packageName))
+	for _, pkg := range imports {
+		header.WriteString(fmt.Sprintf("%q\n", pkg))
+	}
+	header.WriteString(")\n")
+	header.Write(output.Bytes())
+	output = header
+
 	if os.Getenv("GENERATOR_DEBUG") == "operations" {
 		fmt.Println(output.String())
 	}
@@ -113,8 +153,8 @@ func renderResponseSchema(name string, op *v3.Operation) ([]byte, error) {
 
 	for pair := op.Responses.Codes.First(); pair != nil; pair = pair.Next() {
 		response := pair.Value()
-		// TODO support other content type from spec.
-		media, ok := response.Content.Get("application/json")
+		// Bodies of other content types are returned as is, without schema.
+		media, ok := response.Content.Get(contentTypeJSON)
 		if !ok {
 			continue
 		}
@@ -161,8 +201,8 @@ func renderRequestSchema(name string, op *v3.Operation) ([]byte, error) {
 		return nil, nil
 	}
 
-	// TODO support other content type from spec.
-	media, ok := op.RequestBody.Content.Get("application/json")
+	// Bodies of other content types are sent as is, without schema.
+	media, ok := op.RequestBody.Content.Get(contentTypeJSON)
 	if !ok {
 		return nil, nil
 	}
@@ -425,6 +465,314 @@ func renderFindable(funcName string, s *base.SchemaProxy) ([]byte, error) {
 	return nil, nil
 }
 
+const iteratorTemplate = `
+// {{ .Name }} returns an iterator over the {{ .ItemType }} items returned by {{ .ListFuncName }}.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) {{ .Name }}({{ .Params }}) iter.Seq2[{{ .ItemType }}, error] {
+	return func(yield func({{ .ItemType }}, error) bool) {
+		resp, err := c.{{ .ListFuncName }}({{ .Args }})
+		if err != nil {
+			var zero {{ .ItemType }}
+			yield(zero, fmt.Errorf("{{ .Name }}: %w", err))
+			return
+		}
+
+		for _, item := range resp{{ .ListField }} {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+`
+
+type Iterator struct {
+	Name         string
+	ListFuncName string
+	Params       string
+	Args         string
+	ItemType     string
+	ListField    string
+}
+
+// renderIterator renders an All* iterator method for list operations.
+func renderIterator(it *Iterator) ([]byte, error) {
+	t, err := template.New("Iterator").Parse(iteratorTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBuffer([]byte{})
+	if err := t.Execute(output, it); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+// iteratorFor returns the All* iterator method of list operations.
+// It returns nil on operations not returning a list of items.
+func iteratorFor(funcName string, op *v3.Operation) (*Iterator, error) {
+	if !strings.HasPrefix(funcName, "List") {
+		return nil, nil
+	}
+
+	if orderedmap.Len(op.Responses.Codes) == 0 {
+		return nil, nil
+	}
+	resp, ok := op.Responses.Codes.Get("200")
+	if !ok {
+		return nil, nil
+	}
+	media, ok := resp.Content.Get(contentTypeJSON)
+	if !ok {
+		return nil, nil
+	}
+
+	it := Iterator{
+		Name:         "All" + strings.TrimPrefix(funcName, "List"),
+		ListFuncName: funcName,
+	}
+
+	if a, ok := isArrayReference(media.Schema); ok {
+		it.ItemType = strings.TrimPrefix(a, "[]")
+	} else {
+		typeName := funcName + "Response"
+		if media.Schema.IsReference() {
+			typeName = helpers.RenderReference(media.Schema.GetReference(), "")
+		}
+
+		itemType, field, err := listItemType(typeName, media.Schema)
+		if err != nil {
+			return nil, err
+		}
+		if itemType == "" {
+			return nil, nil
+		}
+		it.ItemType = itemType
+		it.ListField = "." + field
+	}
+
+	params := getParameters(op, funcName)
+	it.Params = strings.Join(params, ", ")
+	it.Args = strings.Join(getArguments(params), ", ")
+
+	return &it, nil
+}
+
+// listItemType returns the item type and the field name of the single array property
+// of a list response object.
+// It returns an empty item type if the response doesn't hold exactly one array of items.
+func listItemType(typeName string, s *base.SchemaProxy) (string, string, error) {
+	sc, err := s.BuildSchema()
+	if err != nil {
+		return "", "", err
+	}
+	schemas.InferType(sc)
+
+	if len(sc.Type) > 0 && sc.Type[0] != "object" {
+		return "", "", nil
+	}
+
+	var itemType, field string
+	for pair := sc.Properties.First(); pair != nil; pair = pair.Next() {
+		propName, propSc := pair.Key(), pair.Value()
+		prop, err := propSc.BuildSchema()
+		if err != nil {
+			return "", "", err
+		}
+		schemas.InferType(prop)
+
+		if len(prop.Type) == 0 || prop.Type[0] != "array" {
+			continue
+		}
+		if prop.Items == nil || !prop.Items.IsA() {
+			continue
+		}
+
+		// Ambiguous list response, more than one array of items.
+		if itemType != "" {
+			return "", "", nil
+		}
+
+		if overrides := helpers.SchemaPropertyOverrides[typeName]; overrides != nil && overrides.Props != nil {
+			if override, ok := overrides.Props[propName]; ok {
+				propName = override
+			}
+		}
+		field = helpers.ToCamel(propName)
+
+		if prop.Items.A.IsReference() {
+			itemType = helpers.RenderReference(prop.Items.A.GetReference(), typeName)
+			continue
+		}
+
+		item, err := prop.Items.A.BuildSchema()
+		if err != nil {
+			return "", "", err
+		}
+		schemas.InferType(item)
+		if item.AdditionalProperties != nil {
+			return "", "", nil
+		}
+
+		itemType = typeName + field
+		if schemas.IsSimpleSchema(item) {
+			itemType = schemas.RenderSimpleType(item)
+		}
+	}
+
+	return itemType, field, nil
+}
+
+// getArguments returns the arguments names to call a function with the given parameters.
+func getArguments(params []string) []string {
+	args := make([]string, 0, len(params))
+	for _, p := range params {
+		name, typ, _ := strings.Cut(p, " ")
+		if strings.HasPrefix(typ, "...") {
+			name += "..."
+		}
+		args = append(args, name)
+	}
+
+	return args
+}
+
+const andWaitTemplate = `
+// {{ .Name }} calls {{ .FuncName }}, waits for the resulting operation to succeed
+// and returns the {{ .ResourceType }} referenced by the operation.
+func (c Client) {{ .Name }}({{ .Params }}) (*{{ .ResourceType }}, error) {
+	op, err := c.{{ .FuncName }}({{ .Args }})
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "{{ .GetterOperationID }}", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("{{ .Name }}: %w", err)
+	}
+
+	return c.{{ .GetterName }}(ctx, ref.ID)
+}
+`
+
+type AndWait struct {
+	Name              string
+	FuncName          string
+	Params            string
+	Args              string
+	ResourceType      string
+	GetterName        string
+	GetterOperationID string
+}
+
+type resourceGetter struct {
+	FuncName     string
+	OperationID  string
+	ResourceType string
+}
+
+// andWaitIgnoredList lists the operations on a resource referencing another resource type.
+var andWaitIgnoredList = map[string]struct{}{
+	"copy-template":                 {},
+	"create-block-storage-snapshot": {},
+	"create-snapshot":               {},
+	"promote-snapshot-to-template":  {},
+}
+
+var (
+	// resourcePath matches a resource path with its optional ID and action: /resource/{id}:action.
+	resourcePath = regexp.MustCompile(`^/([a-z0-9-]+)(/\{[^}]+\}(:[a-z0-9-]+)?)?$`)
+	// resourceIDPath matches a resource path by ID: /resource/{id}.
+	resourceIDPath = regexp.MustCompile(`^/([a-z0-9-]+)/\{[^}]+\}$`)
+)
+
+// resourceGetters returns the GET operations fetching a resource by UUID, indexed by resource name.
+func resourceGetters(paths *v3.Paths) map[string]resourceGetter {
+	getters := map[string]resourceGetter{}
+
+	for pair := paths.PathItems.First(); pair != nil; pair = pair.Next() {
+		path, item := pair.Key(), pair.Value()
+		m := resourceIDPath.FindStringSubmatch(path)
+		if m == nil || item.Get == nil || len(item.Get.Parameters) != 1 {
+			continue
+		}
+
+		param := item.Get.Parameters[0]
+		if param.In != "path" || param.Schema.Schema() == nil || schemas.RenderSimpleType(param.Schema.Schema()) != "UUID" {
+			continue
+		}
+
+		values := getValuesReturn(item.Get, helpers.ToCamel(item.Get.OperationId))
+		if len(values) != 2 || !strings.HasPrefix(values[0], "*") {
+			continue
+		}
+
+		getters[m[1]] = resourceGetter{
+			FuncName:     helpers.ToCamel(item.Get.OperationId),
+			OperationID:  item.Get.OperationId,
+			ResourceType: strings.TrimPrefix(values[0], "*"),
+		}
+	}
+
+	return getters
+}
+
+// renderAndWait renders an *AndWait method for operations returning an async operation.
+func renderAndWait(andWait *AndWait) ([]byte, error) {
+	t, err := template.New("AndWait").Parse(andWaitTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBuffer([]byte{})
+	if err := t.Execute(output, andWait); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+// andWaitFor returns the *AndWait method of operations returning an async operation
+// referencing a resource which can be fetched by ID.
+// It returns nil on other operations.
+func andWaitFor(path string, req *RequestTmpl, op *v3.Operation, getters map[string]resourceGetter) *AndWait {
+	if req.ValueReturn != "(*Operation, error)" || req.HTTPMethod == "DELETE" || req.HTTPMethod == "GET" {
+		return nil
+	}
+
+	if _, ok := andWaitIgnoredList[req.OperationID]; ok {
+		return nil
+	}
+
+	m := resourcePath.FindStringSubmatch(path)
+	if m == nil {
+		return nil
+	}
+
+	getter, ok := getters[m[1]]
+	if !ok {
+		return nil
+	}
+
+	params := getParameters(op, req.Name)
+	// The wait options are variadic, operations with variadic query parameters are left out.
+	if strings.Contains(params[len(params)-1], " ...") {
+		return nil
+	}
+
+	return &AndWait{
+		Name:              req.Name + "AndWait",
+		FuncName:          req.Name,
+		Params:            strings.Join(append(params, "opts ...WaitOpt"), ", "),
+		Args:              strings.Join(getArguments(params), ", "),
+		ResourceType:      getter.ResourceType,
+		GetterName:        getter.FuncName,
+		GetterOperationID: getter.OperationID,
+	}
+}
+
 type RequestTmpl struct {
 	Comment            string
 	Name               string
@@ -437,21 +785,79 @@ type RequestTmpl struct {
 	BodyRespType       string
 	JSONResponseTarget string
 	ContentType        string
+	ResponseType       string
+	ZeroValue          string
 	QueryParams        map[string]string
 }
 
+// Content types of request and response bodies, other content types are streamed as is.
+const (
+	contentTypeJSON = "application/json"
+	contentTypeText = "text/plain"
+)
+
+// mediaType returns the content type and media of a request or response body,
+// JSON being preferred when several content types are allowed.
+func mediaType(content *orderedmap.Map[string, *v3.MediaType]) (string, *v3.MediaType, bool) {
+	if media, ok := content.Get(contentTypeJSON); ok {
+		return contentTypeJSON, media, true
+	}
+
+	pair := content.First()
+	if pair == nil {
+		return "", nil, false
+	}
+
+	return pair.Key(), pair.Value(), true
+}
+
+// successResponse returns the content type and media of the body returned by an operation on success:
+// the 200 response, or the first 2xx response with a body otherwise.
+func successResponse(op *v3.Operation) (string, *v3.MediaType, bool) {
+	if op.Responses == nil || orderedmap.Len(op.Responses.Codes) == 0 {
+		return "", nil, false
+	}
+
+	if response, ok := op.Responses.Codes.Get("200"); ok {
+		return mediaType(response.Content)
+	}
+
+	for pair := op.Responses.Codes.First(); pair != nil; pair = pair.Next() {
+		if !strings.HasPrefix(pair.Key(), "2") {
+			continue
+		}
+		if contentType, media, ok := mediaType(pair.Value().Content); ok {
+			return contentType, media, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// responseType returns the content type of the body returned by an operation on success.
+func responseType(op *v3.Operation) string {
+	contentType, _, _ := successResponse(op)
+
+	return contentType
+}
+
 // serializeRequest serializes the openAPI spec into the request template.
 func serializeRequest(path, httpMethod, funcName string, op *v3.Operation) (*RequestTmpl, error) {
 	p := RequestTmpl{
-		Name:        funcName,
-		OperationID: op.OperationId,
-		HTTPMethod:  strings.ToUpper(httpMethod),
+		Name:         funcName,
+		OperationID:  op.OperationId,
+		HTTPMethod:   strings.ToUpper(httpMethod),
+		ResponseType: responseType(op),
+		ZeroValue:    "nil",
 	}
 	p.Comment = renderDoc(op)
 	params := getParameters(op, funcName)
 	p.Params = strings.Join(params, ", ")
 	valuesReturn := getValuesReturn(op, funcName)
-	if len(valuesReturn) == 2 {
+	if p.ResponseType == contentTypeText {
+		p.ZeroValue = `""`
+	}
+	if len(valuesReturn) == 2 && p.ResponseType == contentTypeJSON {
 		p.BodyRespType = valuesReturn[0] + "{}"
 		p.JSONResponseTarget = "bodyresp"
 		if !strings.HasPrefix(valuesReturn[0], "[]") {
@@ -474,9 +880,7 @@ func serializeRequest(path, httpMethod, funcName string, op *v3.Operation) (*Req
 	p.URLPathBuilder = renderURLPathBuilder(path, op)
 
 	if op.RequestBody != nil {
-		p.BodyRequest = true
-		//TODO: manage other content type from spec.
-		p.ContentType = "application/json"
+		p.ContentType, _, p.BodyRequest = mediaType(op.RequestBody.Content)
 	}
 
 	p.QueryParams = getQueryParams(op)
@@ -484,9 +888,12 @@ func serializeRequest(path, httpMethod, funcName string, op *v3.Operation) (*Req
 	return &p, nil
 }
 
+//go:embed request.tmpl
+var requestTemplate string
+
 // renderRequest using the request.tmpl.
 func renderRequest(m *RequestTmpl) ([]byte, error) {
-	t, err := template.New("request.tmpl").ParseFiles("./operations/request.tmpl")
+	t, err := template.New("request.tmpl").Parse(requestTemplate)
 	if err != nil {
 		return nil, err
 	}
@@ -561,11 +968,18 @@ func getParameters(op *v3.Operation, funcName string) (params []string) {
 		return params
 	}
 
-	// TODO support other content type from OpenAPI spec.
-	media, ok := op.RequestBody.Content.Get("application/json")
+	contentType, media, ok := mediaType(op.RequestBody.Content)
 	if !ok {
 		return params
 	}
+	switch contentType {
+	case contentTypeJSON:
+	case contentTypeText:
+		return append(params, "req string")
+	default:
+		return append(params, "req io.Reader")
+	}
+
 	if media.Schema.IsReference() {
 		params = append(params, "req "+helpers.RenderReference(media.Schema.GetReference(), ""))
 		return params
@@ -582,38 +996,33 @@ func getValuesReturn(op *v3.Operation, funcName string) (values []string) {
 		values = append(values, "error")
 	}()
 
-	if orderedmap.Len(op.Responses.Codes) == 0 {
+	contentType, media, ok := successResponse(op)
+	if !ok {
 		return values
 	}
 
-	for pair := op.Responses.Codes.First(); pair != nil; pair = pair.Next() {
-		k, v := pair.Key(), pair.Value()
-		// We support only 200 return as body reply in our OpenAPI spec.
-		// Skip other HTTP response code.
-		if k != "200" {
-			continue
-		}
-
-		media, ok := v.Content.Get("application/json")
-		if !ok {
-			continue
-		}
-		if media.Schema.IsReference() {
-			values = append(values, "*"+helpers.RenderReference(media.Schema.GetReference(), ""))
-			return values
-		}
+	switch contentType {
+	case contentTypeJSON:
+	case contentTypeText:
+		return append(values, "string")
+	default:
+		// Binary and streamed bodies are returned unread, to be closed by the caller.
+		return append(values, "io.ReadCloser")
+	}
 
-		a, ok := isArrayReference(media.Schema)
-		if ok {
-			values = append(values, a)
-			return values
-		}
+	if media.Schema.IsReference() {
+		values = append(values, "*"+helpers.RenderReference(media.Schema.GetReference(), ""))
+		return values
+	}
 
-		values = append(values, "*"+funcName+"Response")
+	a, ok := isArrayReference(media.Schema)
+	if ok {
+		values = append(values, a)
 		return values
 	}
 
-	return
+	values = append(values, "*"+funcName+"Response")
+	return values
 }
 
 func renderDoc(op *v3.Operation) string {
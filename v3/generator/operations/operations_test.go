@@ -0,0 +1,151 @@
+package operations
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+)
+
+// runtimeStub declares the package v3 symbols the generated operations rely on.
+const runtimeStub = `package v3
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+)
+
+type Client struct{ serverEndpoint string }
+
+type Operation struct{}
+
+func (c Client) getUserAgent() string                                             { return "" }
+func (c Client) validateRequest(any) error                                        { return nil }
+func (c Client) validateResponse(any) error                                       { return nil }
+func (c Client) executeRequestInterceptors(context.Context, *http.Request) error { return nil }
+func (c Client) do(context.Context, string, *http.Request) (*http.Response, error) {
+	return nil, nil
+}
+
+func handleHTTPErrorResp(*http.Response) error               { return nil }
+func prepareJSONBody(any) (*bytes.Reader, error)             { return nil, nil }
+func prepareJSONResponse(*http.Response, any) error          { return nil }
+func prepareTextBody(string) *strings.Reader                 { return nil }
+func prepareTextResponse(*http.Response) (string, error)     { return "", nil }
+`
+
+func TestGenerateContentTypes(t *testing.T) {
+	spec, err := os.ReadFile("testdata/content-types.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := libopenapi.NewDocument(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := Generate(doc, filepath.Join(dir, "operations.go"), "v3"); err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	sources := map[string]string{"runtime.go": runtimeStub}
+	data, err := os.ReadFile(filepath.Join(dir, "operations.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources["operations.go"] = string(data)
+	for name, src := range sources {
+		f, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			// The generated header imports the packages of every operation kind, unused in this spec.
+			if !strings.Contains(err.Error(), "imported and not used") {
+				t.Errorf("the generated operations must compile: %v", err)
+			}
+		},
+	}
+	pkg, _ := conf.Check("v3", fset, files, nil)
+
+	client := pkg.Scope().Lookup("Client").Type()
+	for name, want := range map[string]string{
+		"GetDNSDomainZoneFile":    "func(ctx context.Context, id string) (string, error)",
+		"UpdateDNSDomainZoneFile": "func(ctx context.Context, id string, req string) (*v3.Operation, error)",
+		"UploadBlob":              "func(ctx context.Context, req io.Reader) (io.ReadCloser, error)",
+		"GetInstanceLogs":         "func(ctx context.Context, id string) (io.ReadCloser, error)",
+	} {
+		method, _, _ := types.LookupFieldOrMethod(client, false, pkg, name)
+		if method == nil {
+			t.Errorf("missing method %s", name)
+			continue
+		}
+		if got := method.Type().String(); got != want {
+			t.Errorf("%s: got %s, want %s", name, got, want)
+		}
+	}
+
+	for _, want := range []string{
+		`request.Header.Add("Content-Type", "text/plain")`,
+		`request.Header.Add("Accept", "application/octet-stream")`,
+		`request.Header.Add("Accept", "application/x-ndjson")`,
+	} {
+		if !strings.Contains(sources["operations.go"], want) {
+			t.Errorf("the generated operations must contain %s", want)
+		}
+	}
+}
+
+func TestGenerateTwice(t *testing.T) {
+	spec, err := os.ReadFile("testdata/content-types.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var outputs []string
+	for range 2 {
+		doc, err := libopenapi.NewDocument(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dir := t.TempDir()
+		if err := Generate(doc, filepath.Join(dir, "operations.go"), "v3"); err != nil {
+			t.Fatal(err)
+		}
+
+		var output string
+		for _, name := range []string{"client_api.go", "client_mock.go"} {
+			data, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			output += string(data)
+		}
+		outputs = append(outputs, output)
+	}
+
+	if outputs[0] != outputs[1] {
+		t.Errorf("generating twice must render the same ClientAPI, got:\n%s\nthen:\n%s", outputs[0], outputs[1])
+	}
+	if n := strings.Count(outputs[1], "GetInstanceLogs("); n != 2 {
+		t.Errorf("GetInstanceLogs must be declared once in ClientAPI and MockClient, got %d declarations", n)
+	}
+}
@@ -7,15 +7,20 @@ import (
 	"log/slog"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/exoscale/egoscale/v3/generator/helpers"
 	"github.com/pb33f/libopenapi"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 	"gopkg.in/yaml.v3"
 )
 
+// secretPropertyPattern matches the names of the properties holding secret values.
+var secretPropertyPattern = regexp.MustCompile(`(^|[-_])(password|passphrase|secrets?|token|private[-_]key|kubeconfig)$`)
+
 // TODO fix the OpenApi spec (duplicated resources)
 var ignoredList = map[string]struct{}{
 	"snapshot-export": {},
@@ -66,6 +71,12 @@ import (
 	}
 	output.WriteString("\n")
 
+	secrets, err := renderSecretProperties(result.Model)
+	if err != nil {
+		return fmt.Errorf("renderSecretProperties: %v", err)
+	}
+	output.Write(secrets)
+
 	if os.Getenv("GENERATOR_DEBUG") == "schemas" {
 		fmt.Println(output.String())
 	}
@@ -95,6 +106,115 @@ func RenderSchema(schemaName string, s *base.SchemaProxy) ([]byte, error) {
 	return output.Bytes(), nil
 }
 
+// renderSecretProperties renders the set of properties names holding secret values,
+// found in the spec schemas and operations bodies.
+func renderSecretProperties(model v3.Document) ([]byte, error) {
+	found := map[string]struct{}{}
+	visited := map[string]struct{}{}
+
+	for pair := model.Components.Schemas.First(); pair != nil; pair = pair.Next() {
+		if err := collectSecretProperties(pair.Value(), found, visited); err != nil {
+			return nil, err
+		}
+	}
+
+	if model.Paths != nil {
+		for pair := model.Paths.PathItems.First(); pair != nil; pair = pair.Next() {
+			for op := pair.Value().GetOperations().First(); op != nil; op = op.Next() {
+				var medias []*v3.MediaType
+				if body := op.Value().RequestBody; body != nil {
+					if media, ok := body.Content.Get("application/json"); ok {
+						medias = append(medias, media)
+					}
+				}
+				if responses := op.Value().Responses; responses != nil {
+					for code := responses.Codes.First(); code != nil; code = code.Next() {
+						if media, ok := code.Value().Content.Get("application/json"); ok {
+							medias = append(medias, media)
+						}
+					}
+				}
+
+				for _, media := range medias {
+					if err := collectSecretProperties(media.Schema, found, visited); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	output := bytes.NewBufferString("// secretProperties lists the schemas properties holding secret values.\n")
+	output.WriteString("var secretProperties = map[string]struct{}{\n")
+	for _, name := range names {
+		output.WriteString(fmt.Sprintf("%q: {},\n", name))
+	}
+	output.WriteString("}\n")
+
+	return output.Bytes(), nil
+}
+
+// collectSecretProperties walks a schema recursively to find the properties holding secret values.
+func collectSecretProperties(sp *base.SchemaProxy, found, visited map[string]struct{}) error {
+	if sp == nil {
+		return nil
+	}
+
+	if sp.IsReference() {
+		if _, ok := visited[sp.GetReference()]; ok {
+			return nil
+		}
+		visited[sp.GetReference()] = struct{}{}
+	}
+
+	s, err := sp.BuildSchema()
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return nil
+	}
+
+	for pair := s.Properties.First(); pair != nil; pair = pair.Next() {
+		if secretPropertyPattern.MatchString(pair.Key()) {
+			if prop := pair.Value().Schema(); prop != nil && IsSimpleSchema(prop) {
+				found[pair.Key()] = struct{}{}
+			}
+		}
+		if err := collectSecretProperties(pair.Value(), found, visited); err != nil {
+			return err
+		}
+	}
+
+	for _, sub := range [][]*base.SchemaProxy{s.AllOf, s.OneOf, s.AnyOf} {
+		for _, p := range sub {
+			if err := collectSecretProperties(p, found, visited); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.Items != nil && s.Items.IsA() {
+		if err := collectSecretProperties(s.Items.A, found, visited); err != nil {
+			return err
+		}
+	}
+
+	if s.AdditionalProperties != nil && s.AdditionalProperties.IsA() {
+		if err := collectSecretProperties(s.AdditionalProperties.A, found, visited); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // RenderSimpleType returns the type of a simple go type,
 // not an object, map, array...etc.
 // This function is called if you are sure IsSimpleSchema(s *base.Schema) return true.
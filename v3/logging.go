@@ -0,0 +1,202 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// redacted replaces secret values in logs.
+const redacted = "REDACTED"
+
+// redactedHeaders lists the HTTP headers never logged in clear.
+var redactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// requestLogger logs API requests and responses metadata at debug level.
+type requestLogger struct {
+	logger         *slog.Logger
+	bodies         bool
+	redactedFields map[string]struct{}
+}
+
+// LoggerOpt represents a function setting a request logger option.
+type LoggerOpt func(*requestLogger)
+
+// LoggerOptWithBodies returns a LoggerOpt enabling the logging of requests and responses JSON bodies.
+// Secret fields are redacted from logged bodies.
+func LoggerOptWithBodies() LoggerOpt {
+	return func(l *requestLogger) {
+		l.bodies = true
+	}
+}
+
+// LoggerOptWithRedactedFields returns a LoggerOpt redacting additional JSON fields from logged bodies.
+func LoggerOptWithRedactedFields(fields ...string) LoggerOpt {
+	return func(l *requestLogger) {
+		for _, f := range fields {
+			l.redactedFields[f] = struct{}{}
+		}
+	}
+}
+
+func newRequestLogger(logger *slog.Logger, opts ...LoggerOpt) *requestLogger {
+	l := &requestLogger{
+		logger:         logger,
+		redactedFields: make(map[string]struct{}, len(secretProperties)),
+	}
+	for f := range secretProperties {
+		l.redactedFields[f] = struct{}{}
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+func (l *requestLogger) enabled(ctx context.Context) bool {
+	return l != nil && l.logger.Enabled(ctx, slog.LevelDebug)
+}
+
+// logRequest logs an API request attempt, the request body is left untouched.
+func (l *requestLogger) logRequest(ctx context.Context, operationID string, attempt int, req *http.Request) {
+	if !l.enabled(ctx) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("operation", operationID),
+		slog.Int("attempt", attempt),
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		l.headersAttr(req.Header),
+	}
+
+	if l.bodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, err := io.ReadAll(body)
+			body.Close()
+			if err == nil {
+				attrs = append(attrs, l.bodyAttr(req.Header, data))
+			}
+		}
+	}
+
+	l.logger.LogAttrs(ctx, slog.LevelDebug, "exoscale api request", attrs...)
+}
+
+// logResponse logs an API response, the response body is restored to be read again by the caller.
+func (l *requestLogger) logResponse(
+	ctx context.Context,
+	operationID string,
+	attempt int,
+	resp *http.Response,
+	elapsed time.Duration,
+	err error,
+) {
+	if !l.enabled(ctx) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("operation", operationID),
+		slog.Int("attempt", attempt),
+		slog.Duration("duration", elapsed),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		l.logger.LogAttrs(ctx, slog.LevelDebug, "exoscale api response", attrs...)
+		return
+	}
+
+	attrs = append(attrs,
+		slog.Int("status", resp.StatusCode),
+		l.headersAttr(resp.Header),
+	)
+
+	switch {
+	case !l.bodies:
+	case !strings.Contains(resp.Header.Get("Content-Type"), "json"):
+		// Other bodies may be streamed, they are not read.
+		if resp.ContentLength >= 0 {
+			attrs = append(attrs, slog.Int64("body_size", resp.ContentLength))
+		}
+	default:
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if err == nil {
+			attrs = append(attrs, l.bodyAttr(resp.Header, data))
+		}
+	}
+
+	l.logger.LogAttrs(ctx, slog.LevelDebug, "exoscale api response", attrs...)
+}
+
+func (l *requestLogger) headersAttr(h http.Header) slog.Attr {
+	h = h.Clone()
+	for _, name := range redactedHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, redacted)
+		}
+	}
+
+	attrs := make([]any, 0, len(h))
+	for name, values := range h {
+		attrs = append(attrs, slog.String(name, strings.Join(values, ", ")))
+	}
+
+	return slog.Group("headers", attrs...)
+}
+
+// bodyAttr returns the body attribute of a JSON body with its secret fields redacted.
+// Non JSON bodies are not logged, only their size.
+func (l *requestLogger) bodyAttr(h http.Header, data []byte) slog.Attr {
+	if !strings.Contains(h.Get("Content-Type"), "json") {
+		return slog.Int("body_size", len(data))
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return slog.Int("body_size", len(data))
+	}
+
+	redacted, err := json.Marshal(l.redact(v))
+	if err != nil {
+		return slog.Int("body_size", len(data))
+	}
+
+	return slog.String("body", string(redacted))
+}
+
+// redact replaces recursively the values of the secret fields of a decoded JSON value.
+func (l *requestLogger) redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if _, ok := l.redactedFields[k]; ok {
+				v[k] = redacted
+				continue
+			}
+			v[k] = l.redact(val)
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = l.redact(val)
+		}
+	}
+
+	return v
+}
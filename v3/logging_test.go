@@ -0,0 +1,69 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLoggerRedaction(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			_, _ = w.Write([]byte(`{"id":"9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e","state":"pending"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"username":"admin","password":"response-s3cr3t"}`))
+	})
+
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client = client.WithLogger(logger, LoggerOptWithBodies(), LoggerOptWithRedactedFields("username"))
+
+	ctx := context.Background()
+	secrets, err := client.RevealDBAASPostgresUserPassword(ctx, "pg", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secrets.Password != "response-s3cr3t" {
+		t.Errorf("the response body must be restored, got %+v", secrets)
+	}
+
+	_, err = client.ResetDBAASPostgresUserPassword(ctx, "pg", "admin", ResetDBAASPostgresUserPasswordRequest{
+		Password: "request-s3cr3t",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logs := buf.String()
+	for _, leak := range []string{"s3cr3t", "EXO2-HMAC-SHA256", `"admin"`} {
+		if strings.Contains(logs, leak) {
+			t.Errorf("logs leak %q:\n%s", leak, logs)
+		}
+	}
+	for _, want := range []string{"reveal-dbaas-postgres-user-password", "reset-dbaas-postgres-user-password", redacted} {
+		if !strings.Contains(logs, want) {
+			t.Errorf("logs are missing %q:\n%s", want, logs)
+		}
+	}
+}
+
+func TestLoggerDisabled(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	})
+
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	if _, err := client.WithLogger(logger).ListZones(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("expected no debug logs, got %q", buf.String())
+	}
+}
@@ -0,0 +1,128 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// MultiZoneClient runs API operations concurrently in several zones.
+type MultiZoneClient struct {
+	clients map[ZoneName]*Client
+	zones   []ZoneName
+}
+
+// NewMultiZoneClient returns a MultiZoneClient running operations in the zones discovered with ListZones,
+// restricted to the given zones if any.
+// Zone clients are derived from client, sharing its configuration and refreshed ZoneRegistry.
+func NewMultiZoneClient(ctx context.Context, client *Client, zones ...ZoneName) (*MultiZoneClient, error) {
+	if err := client.zones.Refresh(ctx, client); err != nil {
+		return nil, fmt.Errorf("new multi-zone client: %w", err)
+	}
+
+	m := &MultiZoneClient{clients: make(map[ZoneName]*Client)}
+	for _, zone := range client.zones.Zones() {
+		if len(zones) > 0 && !slices.Contains(zones, zone) {
+			continue
+		}
+		endpoint, ok := client.zones.lookup(zone)
+		if !ok {
+			continue
+		}
+		m.clients[zone] = client.WithEndpoint(endpoint)
+		m.zones = append(m.zones, zone)
+	}
+
+	for _, zone := range zones {
+		if _, ok := m.clients[zone]; !ok {
+			return nil, fmt.Errorf("new multi-zone client: zone %q: %w", zone, ErrNotFound)
+		}
+	}
+
+	return m, nil
+}
+
+// Zones returns the zones of the MultiZoneClient.
+func (m *MultiZoneClient) Zones() []ZoneName {
+	return slices.Clone(m.zones)
+}
+
+// Client returns the client bound to the given zone.
+func (m *MultiZoneClient) Client(zone ZoneName) (*Client, error) {
+	c, ok := m.clients[zone]
+	if !ok {
+		return nil, fmt.Errorf("zone %q: %w", zone, ErrNotFound)
+	}
+
+	return c, nil
+}
+
+// ZoneResult is the result of an operation run in a zone.
+type ZoneResult[T any] struct {
+	Zone   ZoneName
+	Result T
+	Err    error
+}
+
+// ZoneItem is an item listed in a zone.
+type ZoneItem[T any] struct {
+	Zone ZoneName
+	Item T
+}
+
+// MultiZoneDo runs f concurrently in every zone of m.
+// It returns the results in the order of m zones, a failure in a zone does not abort the others.
+func MultiZoneDo[T any](
+	ctx context.Context,
+	m *MultiZoneClient,
+	f func(ctx context.Context, client *Client) (T, error),
+) []ZoneResult[T] {
+	results := make([]ZoneResult[T], len(m.zones))
+
+	var wg sync.WaitGroup
+	for i, zone := range m.zones {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			result, err := f(ctx, m.clients[zone])
+			results[i] = ZoneResult[T]{Zone: zone, Result: result, Err: err}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// MultiZoneList runs the list function concurrently in every zone of m,
+// and returns the listed items tagged with their zone.
+// Items of the zones where list fails are skipped, the returned error joins a ZoneError for each of them.
+//
+//	instances, err := v3.MultiZoneList(ctx, m, func(ctx context.Context, c *v3.Client) ([]v3.ListInstancesResponseInstances, error) {
+//		resp, err := c.ListInstances(ctx)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return resp.Instances, nil
+//	})
+func MultiZoneList[T any](
+	ctx context.Context,
+	m *MultiZoneClient,
+	list func(ctx context.Context, client *Client) ([]T, error),
+) ([]ZoneItem[T], error) {
+	var items []ZoneItem[T]
+	var errs []error
+	for _, r := range MultiZoneDo(ctx, m, list) {
+		if r.Err != nil {
+			errs = append(errs, &ZoneError{Zone: r.Zone, Err: r.Err})
+			continue
+		}
+		for _, item := range r.Result {
+			items = append(items, ZoneItem[T]{Zone: r.Zone, Item: item})
+		}
+	}
+
+	return items, errors.Join(errs...)
+}
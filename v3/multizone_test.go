@@ -0,0 +1,73 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func TestMultiZoneList(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/zone":
+			_, _ = w.Write([]byte(`{"zones":[` +
+				`{"name":"ch-gva-2","api-endpoint":"` + srv.URL + `/ch-gva-2"},` +
+				`{"name":"de-fra-1","api-endpoint":"` + srv.URL + `/de-fra-1"},` +
+				`{"name":"at-vie-1","api-endpoint":"` + srv.URL + `/at-vie-1"}]}`))
+		case strings.HasPrefix(r.URL.Path, "/at-vie-1/"):
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			zone := strings.Split(r.URL.Path, "/")[1]
+			_, _ = w.Write([]byte(`{"instances":[{"name":"` + zone + `-a"},{"name":"` + zone + `-b"}]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(credentials.NewStaticCredentials("EXOtest", "secret"),
+		ClientOptWithEndpoint(Endpoint(srv.URL)),
+		ClientOptWithHTTPClient(srv.Client()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err := NewMultiZoneClient(ctx, client, ZoneNameCHGva2, ZoneNameBGSof1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an unknown zone, got %v", err)
+	}
+
+	m, err := NewMultiZoneClient(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zones := m.Zones(); len(zones) != 3 || zones[0] != ZoneNameATVie1 {
+		t.Errorf("unexpected zones %v", zones)
+	}
+
+	items, err := MultiZoneList(ctx, m, func(ctx context.Context, c *Client) ([]ListInstancesResponseInstances, error) {
+		resp, err := c.ListInstances(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Instances, nil
+	})
+
+	var zoneErr *ZoneError
+	if !errors.As(err, &zoneErr) || zoneErr.Zone != ZoneNameATVie1 || !errors.Is(err, ErrServiceUnavailable) {
+		t.Errorf("expected a ZoneError for at-vie-1, got %v", err)
+	}
+	if len(items) != 4 {
+		t.Fatalf("expected 4 items, got %+v", items)
+	}
+	for _, item := range items {
+		if !strings.HasPrefix(item.Item.Name, string(item.Zone)) {
+			t.Errorf("item %q is tagged with zone %q", item.Item.Name, item.Zone)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package v3
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net"
 	"net/http"
 	"net/url"
@@ -46,23 +47,11 @@ func (c Client) ListAIAPIKeys(ctx context.Context) (*ListAIAPIKeysResponse, erro
 		return nil, fmt.Errorf("ListAIAPIKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAIAPIKeys: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-ai-api-keys")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-ai-api-keys", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListAIAPIKeys: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListAIAPIKeys: http response: %w", err)
 	}
@@ -72,13 +61,40 @@ func (c Client) ListAIAPIKeys(ctx context.Context) (*ListAIAPIKeysResponse, erro
 		return nil, fmt.Errorf("ListAIAPIKeys: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListAIAPIKeys: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllAIAPIKeys returns an iterator over the AIAPIKey items returned by ListAIAPIKeys.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllAIAPIKeys(ctx context.Context) iter.Seq2[AIAPIKey, error] {
+	return func(yield func(AIAPIKey, error) bool) {
+		resp, err := c.ListAIAPIKeys(ctx)
+		if err != nil {
+			var zero AIAPIKey
+			yield(zero, fmt.Errorf("AllAIAPIKeys: %w", err))
+			return
+		}
+
+		for _, item := range resp.AIAPIKeys {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Create a new AI API key
 func (c Client) CreateAIAPIKey(ctx context.Context, req CreateAIAPIKeyRequest) (*AIAPIKeyWithValue, error) {
 	path := "/ai/ai-api-key"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateAIAPIKey: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateAIAPIKey: prepare Json body: %w", err)
@@ -97,23 +113,11 @@ func (c Client) CreateAIAPIKey(ctx context.Context, req CreateAIAPIKeyRequest) (
 		return nil, fmt.Errorf("CreateAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-ai-api-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateAIAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateAIAPIKey: http response: %w", err)
 	}
@@ -123,6 +127,10 @@ func (c Client) CreateAIAPIKey(ctx context.Context, req CreateAIAPIKeyRequest) (
 		return nil, fmt.Errorf("CreateAIAPIKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateAIAPIKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -145,23 +153,11 @@ func (c Client) DeleteAIAPIKey(ctx context.Context, id UUID) (*DeleteAIAPIKeyRes
 		return nil, fmt.Errorf("DeleteAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-ai-api-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteAIAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteAIAPIKey: http response: %w", err)
 	}
@@ -171,6 +167,10 @@ func (c Client) DeleteAIAPIKey(ctx context.Context, id UUID) (*DeleteAIAPIKeyRes
 		return nil, fmt.Errorf("DeleteAIAPIKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteAIAPIKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -189,23 +189,11 @@ func (c Client) GetAIAPIKey(ctx context.Context, id UUID) (*AIAPIKey, error) {
 		return nil, fmt.Errorf("GetAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-ai-api-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetAIAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetAIAPIKey: http response: %w", err)
 	}
@@ -215,6 +203,10 @@ func (c Client) GetAIAPIKey(ctx context.Context, id UUID) (*AIAPIKey, error) {
 		return nil, fmt.Errorf("GetAIAPIKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetAIAPIKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -222,6 +214,10 @@ func (c Client) GetAIAPIKey(ctx context.Context, id UUID) (*AIAPIKey, error) {
 func (c Client) UpdateAIAPIKey(ctx context.Context, id UUID, req UpdateAIAPIKeyRequest) (*AIAPIKey, error) {
 	path := fmt.Sprintf("/ai/ai-api-key/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateAIAPIKey: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateAIAPIKey: prepare Json body: %w", err)
@@ -240,23 +236,11 @@ func (c Client) UpdateAIAPIKey(ctx context.Context, id UUID, req UpdateAIAPIKeyR
 		return nil, fmt.Errorf("UpdateAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-ai-api-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateAIAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateAIAPIKey: http response: %w", err)
 	}
@@ -266,6 +250,10 @@ func (c Client) UpdateAIAPIKey(ctx context.Context, id UUID, req UpdateAIAPIKeyR
 		return nil, fmt.Errorf("UpdateAIAPIKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateAIAPIKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -284,23 +272,11 @@ func (c Client) RotateAIAPIKey(ctx context.Context, id UUID) (*AIAPIKeyWithValue
 		return nil, fmt.Errorf("RotateAIAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateAIAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-ai-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "rotate-ai-api-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("RotateAIAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RotateAIAPIKey: http response: %w", err)
 	}
@@ -310,6 +286,10 @@ func (c Client) RotateAIAPIKey(ctx context.Context, id UUID) (*AIAPIKeyWithValue
 		return nil, fmt.Errorf("RotateAIAPIKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateAIAPIKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -363,23 +343,11 @@ func (c Client) ListDeployments(ctx context.Context, opts ...ListDeploymentsOpt)
 		return nil, fmt.Errorf("ListDeployments: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDeployments: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-deployments")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-deployments", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDeployments: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDeployments: http response: %w", err)
 	}
@@ -389,13 +357,40 @@ func (c Client) ListDeployments(ctx context.Context, opts ...ListDeploymentsOpt)
 		return nil, fmt.Errorf("ListDeployments: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDeployments: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDeployments returns an iterator over the ListDeploymentsResponseEntry items returned by ListDeployments.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDeployments(ctx context.Context, opts ...ListDeploymentsOpt) iter.Seq2[ListDeploymentsResponseEntry, error] {
+	return func(yield func(ListDeploymentsResponseEntry, error) bool) {
+		resp, err := c.ListDeployments(ctx, opts...)
+		if err != nil {
+			var zero ListDeploymentsResponseEntry
+			yield(zero, fmt.Errorf("AllDeployments: %w", err))
+			return
+		}
+
+		for _, item := range resp.Deployments {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Deploy a model on an inference server
 func (c Client) CreateDeployment(ctx context.Context, req CreateDeploymentRequest) (*Operation, error) {
 	path := "/ai/deployment"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDeployment: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDeployment: prepare Json body: %w", err)
@@ -414,23 +409,11 @@ func (c Client) CreateDeployment(ctx context.Context, req CreateDeploymentReques
 		return nil, fmt.Errorf("CreateDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDeployment: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-deployment")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-deployment", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDeployment: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDeployment: http response: %w", err)
 	}
@@ -440,6 +423,10 @@ func (c Client) CreateDeployment(ctx context.Context, req CreateDeploymentReques
 		return nil, fmt.Errorf("CreateDeployment: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDeployment: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -458,23 +445,11 @@ func (c Client) DeleteDeployment(ctx context.Context, id UUID) (*Operation, erro
 		return nil, fmt.Errorf("DeleteDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDeployment: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-deployment")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-deployment", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDeployment: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDeployment: http response: %w", err)
 	}
@@ -484,6 +459,10 @@ func (c Client) DeleteDeployment(ctx context.Context, id UUID) (*Operation, erro
 		return nil, fmt.Errorf("DeleteDeployment: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDeployment: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -502,23 +481,11 @@ func (c Client) GetDeployment(ctx context.Context, id UUID) (*GetDeploymentRespo
 		return nil, fmt.Errorf("GetDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDeployment: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-deployment")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-deployment", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDeployment: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDeployment: http response: %w", err)
 	}
@@ -528,6 +495,10 @@ func (c Client) GetDeployment(ctx context.Context, id UUID) (*GetDeploymentRespo
 		return nil, fmt.Errorf("GetDeployment: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDeployment: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -535,6 +506,10 @@ func (c Client) GetDeployment(ctx context.Context, id UUID) (*GetDeploymentRespo
 func (c Client) UpdateDeployment(ctx context.Context, id UUID, req UpdateDeploymentRequest) (*Operation, error) {
 	path := fmt.Sprintf("/ai/deployment/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDeployment: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDeployment: prepare Json body: %w", err)
@@ -553,23 +528,11 @@ func (c Client) UpdateDeployment(ctx context.Context, id UUID, req UpdateDeploym
 		return nil, fmt.Errorf("UpdateDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDeployment: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-deployment")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-deployment", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDeployment: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDeployment: http response: %w", err)
 	}
@@ -579,6 +542,10 @@ func (c Client) UpdateDeployment(ctx context.Context, id UUID, req UpdateDeploym
 		return nil, fmt.Errorf("UpdateDeployment: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDeployment: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -597,23 +564,11 @@ func (c Client) RevealDeploymentAPIKey(ctx context.Context, id UUID) (*RevealDep
 		return nil, fmt.Errorf("RevealDeploymentAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDeploymentAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-deployment-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-deployment-api-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealDeploymentAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealDeploymentAPIKey: http response: %w", err)
 	}
@@ -623,6 +578,10 @@ func (c Client) RevealDeploymentAPIKey(ctx context.Context, id UUID) (*RevealDep
 		return nil, fmt.Errorf("RevealDeploymentAPIKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDeploymentAPIKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -663,23 +622,11 @@ func (c Client) GetDeploymentLogs(ctx context.Context, id UUID, opts ...GetDeplo
 		return nil, fmt.Errorf("GetDeploymentLogs: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDeploymentLogs: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-deployment-logs")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-deployment-logs", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDeploymentLogs: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDeploymentLogs: http response: %w", err)
 	}
@@ -689,6 +636,10 @@ func (c Client) GetDeploymentLogs(ctx context.Context, id UUID, opts ...GetDeplo
 		return nil, fmt.Errorf("GetDeploymentLogs: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDeploymentLogs: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -696,6 +647,10 @@ func (c Client) GetDeploymentLogs(ctx context.Context, id UUID, opts ...GetDeplo
 func (c Client) ScaleDeployment(ctx context.Context, id UUID, req ScaleDeploymentRequest) (*Operation, error) {
 	path := fmt.Sprintf("/ai/deployment/%v/scale", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ScaleDeployment: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ScaleDeployment: prepare Json body: %w", err)
@@ -714,23 +669,11 @@ func (c Client) ScaleDeployment(ctx context.Context, id UUID, req ScaleDeploymen
 		return nil, fmt.Errorf("ScaleDeployment: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleDeployment: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-deployment")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "scale-deployment", request)
 	if err != nil {
 		return nil, fmt.Errorf("ScaleDeployment: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ScaleDeployment: http response: %w", err)
 	}
@@ -740,6 +683,10 @@ func (c Client) ScaleDeployment(ctx context.Context, id UUID, req ScaleDeploymen
 		return nil, fmt.Errorf("ScaleDeployment: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ScaleDeployment: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -774,23 +721,11 @@ func (c Client) GetInferenceEngineHelp(ctx context.Context, opts ...GetInference
 		return nil, fmt.Errorf("GetInferenceEngineHelp: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInferenceEngineHelp: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-inference-engine-help")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-inference-engine-help", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetInferenceEngineHelp: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetInferenceEngineHelp: http response: %w", err)
 	}
@@ -800,6 +735,10 @@ func (c Client) GetInferenceEngineHelp(ctx context.Context, opts ...GetInference
 		return nil, fmt.Errorf("GetInferenceEngineHelp: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetInferenceEngineHelp: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -818,23 +757,11 @@ func (c Client) ListAIInstanceTypes(ctx context.Context) (*ListAIInstanceTypesRe
 		return nil, fmt.Errorf("ListAIInstanceTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAIInstanceTypes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-ai-instance-types")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-ai-instance-types", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListAIInstanceTypes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListAIInstanceTypes: http response: %w", err)
 	}
@@ -844,9 +771,32 @@ func (c Client) ListAIInstanceTypes(ctx context.Context) (*ListAIInstanceTypesRe
 		return nil, fmt.Errorf("ListAIInstanceTypes: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListAIInstanceTypes: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllAIInstanceTypes returns an iterator over the InstanceTypeEntry items returned by ListAIInstanceTypes.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllAIInstanceTypes(ctx context.Context) iter.Seq2[InstanceTypeEntry, error] {
+	return func(yield func(InstanceTypeEntry, error) bool) {
+		resp, err := c.ListAIInstanceTypes(ctx)
+		if err != nil {
+			var zero InstanceTypeEntry
+			yield(zero, fmt.Errorf("AllAIInstanceTypes: %w", err))
+			return
+		}
+
+		for _, item := range resp.InstanceTypes {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // FindListModelsResponseEntry attempts to find an ListModelsResponseEntry by nameOrID.
 func (l ListModelsResponse) FindListModelsResponseEntry(nameOrID string) (ListModelsResponseEntry, error) {
 	var result []ListModelsResponseEntry
@@ -897,23 +847,11 @@ func (c Client) ListModels(ctx context.Context, opts ...ListModelsOpt) (*ListMod
 		return nil, fmt.Errorf("ListModels: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListModels: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-models")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-models", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListModels: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListModels: http response: %w", err)
 	}
@@ -923,15 +861,42 @@ func (c Client) ListModels(ctx context.Context, opts ...ListModelsOpt) (*ListMod
 		return nil, fmt.Errorf("ListModels: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListModels: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllModels returns an iterator over the ListModelsResponseEntry items returned by ListModels.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllModels(ctx context.Context, opts ...ListModelsOpt) iter.Seq2[ListModelsResponseEntry, error] {
+	return func(yield func(ListModelsResponseEntry, error) bool) {
+		resp, err := c.ListModels(ctx, opts...)
+		if err != nil {
+			var zero ListModelsResponseEntry
+			yield(zero, fmt.Errorf("AllModels: %w", err))
+			return
+		}
+
+		for _, item := range resp.Models {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Model files will be downloaded from Huggingface.
 // If the model is under a license then you must provide a Huggingface access token for an account that signed the license agreement
 // If the model is under a license then you must provide a Huggingface access token for an account that signed the license agreement
 func (c Client) CreateModel(ctx context.Context, req CreateModelRequest) (*Operation, error) {
 	path := "/ai/model"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateModel: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateModel: prepare Json body: %w", err)
@@ -950,23 +915,11 @@ func (c Client) CreateModel(ctx context.Context, req CreateModelRequest) (*Opera
 		return nil, fmt.Errorf("CreateModel: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateModel: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-model")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-model", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateModel: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateModel: http response: %w", err)
 	}
@@ -976,6 +929,10 @@ func (c Client) CreateModel(ctx context.Context, req CreateModelRequest) (*Opera
 		return nil, fmt.Errorf("CreateModel: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateModel: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -994,23 +951,11 @@ func (c Client) DeleteModel(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("DeleteModel: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteModel: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-model")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-model", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteModel: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteModel: http response: %w", err)
 	}
@@ -1020,6 +965,10 @@ func (c Client) DeleteModel(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("DeleteModel: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteModel: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1038,23 +987,11 @@ func (c Client) GetModel(ctx context.Context, id UUID) (*GetModelResponse, error
 		return nil, fmt.Errorf("GetModel: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetModel: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-model")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-model", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetModel: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetModel: http response: %w", err)
 	}
@@ -1064,6 +1001,10 @@ func (c Client) GetModel(ctx context.Context, id UUID) (*GetModelResponse, error
 		return nil, fmt.Errorf("GetModel: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetModel: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1105,23 +1046,11 @@ func (c Client) ListAntiAffinityGroups(ctx context.Context) (*ListAntiAffinityGr
 		return nil, fmt.Errorf("ListAntiAffinityGroups: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAntiAffinityGroups: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-anti-affinity-groups")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-anti-affinity-groups", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListAntiAffinityGroups: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListAntiAffinityGroups: http response: %w", err)
 	}
@@ -1131,9 +1060,32 @@ func (c Client) ListAntiAffinityGroups(ctx context.Context) (*ListAntiAffinityGr
 		return nil, fmt.Errorf("ListAntiAffinityGroups: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListAntiAffinityGroups: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllAntiAffinityGroups returns an iterator over the AntiAffinityGroup items returned by ListAntiAffinityGroups.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllAntiAffinityGroups(ctx context.Context) iter.Seq2[AntiAffinityGroup, error] {
+	return func(yield func(AntiAffinityGroup, error) bool) {
+		resp, err := c.ListAntiAffinityGroups(ctx)
+		if err != nil {
+			var zero AntiAffinityGroup
+			yield(zero, fmt.Errorf("AllAntiAffinityGroups: %w", err))
+			return
+		}
+
+		for _, item := range resp.AntiAffinityGroups {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateAntiAffinityGroupRequest struct {
 	// Anti-affinity Group description
 	Description string `json:"description,omitempty" validate:"omitempty,lte=255"`
@@ -1145,6 +1097,10 @@ type CreateAntiAffinityGroupRequest struct {
 func (c Client) CreateAntiAffinityGroup(ctx context.Context, req CreateAntiAffinityGroupRequest) (*Operation, error) {
 	path := "/anti-affinity-group"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateAntiAffinityGroup: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateAntiAffinityGroup: prepare Json body: %w", err)
@@ -1163,23 +1119,11 @@ func (c Client) CreateAntiAffinityGroup(ctx context.Context, req CreateAntiAffin
 		return nil, fmt.Errorf("CreateAntiAffinityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateAntiAffinityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-anti-affinity-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-anti-affinity-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateAntiAffinityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateAntiAffinityGroup: http response: %w", err)
 	}
@@ -1189,9 +1133,29 @@ func (c Client) CreateAntiAffinityGroup(ctx context.Context, req CreateAntiAffin
 		return nil, fmt.Errorf("CreateAntiAffinityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateAntiAffinityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateAntiAffinityGroupAndWait calls CreateAntiAffinityGroup, waits for the resulting operation to succeed
+// and returns the AntiAffinityGroup referenced by the operation.
+func (c Client) CreateAntiAffinityGroupAndWait(ctx context.Context, req CreateAntiAffinityGroupRequest, opts ...WaitOpt) (*AntiAffinityGroup, error) {
+	op, err := c.CreateAntiAffinityGroup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-anti-affinity-group", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateAntiAffinityGroupAndWait: %w", err)
+	}
+
+	return c.GetAntiAffinityGroup(ctx, ref.ID)
+}
+
 // Delete an Anti-affinity Group
 func (c Client) DeleteAntiAffinityGroup(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/anti-affinity-group/%v", id)
@@ -1207,23 +1171,11 @@ func (c Client) DeleteAntiAffinityGroup(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("DeleteAntiAffinityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteAntiAffinityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-anti-affinity-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-anti-affinity-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteAntiAffinityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteAntiAffinityGroup: http response: %w", err)
 	}
@@ -1233,6 +1185,10 @@ func (c Client) DeleteAntiAffinityGroup(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("DeleteAntiAffinityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteAntiAffinityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1251,23 +1207,11 @@ func (c Client) GetAntiAffinityGroup(ctx context.Context, id UUID) (*AntiAffinit
 		return nil, fmt.Errorf("GetAntiAffinityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetAntiAffinityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-anti-affinity-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-anti-affinity-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetAntiAffinityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetAntiAffinityGroup: http response: %w", err)
 	}
@@ -1277,6 +1221,10 @@ func (c Client) GetAntiAffinityGroup(ctx context.Context, id UUID) (*AntiAffinit
 		return nil, fmt.Errorf("GetAntiAffinityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetAntiAffinityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1317,23 +1265,11 @@ func (c Client) ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error) {
 		return nil, fmt.Errorf("ListAPIKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListAPIKeys: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-api-keys")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-api-keys", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListAPIKeys: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListAPIKeys: http response: %w", err)
 	}
@@ -1343,19 +1279,46 @@ func (c Client) ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error) {
 		return nil, fmt.Errorf("ListAPIKeys: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListAPIKeys: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
-type CreateAPIKeyRequest struct {
-	// IAM API Key Name
-	Name string `json:"name" validate:"required,gte=1,lte=255"`
-	// IAM API Key Role ID
+// AllAPIKeys returns an iterator over the IAMAPIKey items returned by ListAPIKeys.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllAPIKeys(ctx context.Context) iter.Seq2[IAMAPIKey, error] {
+	return func(yield func(IAMAPIKey, error) bool) {
+		resp, err := c.ListAPIKeys(ctx)
+		if err != nil {
+			var zero IAMAPIKey
+			yield(zero, fmt.Errorf("AllAPIKeys: %w", err))
+			return
+		}
+
+		for _, item := range resp.APIKeys {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+type CreateAPIKeyRequest struct {
+	// IAM API Key Name
+	Name string `json:"name" validate:"required,gte=1,lte=255"`
+	// IAM API Key Role ID
 	RoleID UUID `json:"role-id" validate:"required"`
 }
 
 func (c Client) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*IAMAPIKeyCreated, error) {
 	path := "/api-key"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateAPIKey: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateAPIKey: prepare Json body: %w", err)
@@ -1374,23 +1337,11 @@ func (c Client) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*IAM
 		return nil, fmt.Errorf("CreateAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-api-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateAPIKey: http response: %w", err)
 	}
@@ -1400,6 +1351,10 @@ func (c Client) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*IAM
 		return nil, fmt.Errorf("CreateAPIKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateAPIKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1417,23 +1372,11 @@ func (c Client) DeleteAPIKey(ctx context.Context, id string) (*Operation, error)
 		return nil, fmt.Errorf("DeleteAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-api-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteAPIKey: http response: %w", err)
 	}
@@ -1443,6 +1386,10 @@ func (c Client) DeleteAPIKey(ctx context.Context, id string) (*Operation, error)
 		return nil, fmt.Errorf("DeleteAPIKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteAPIKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1460,23 +1407,11 @@ func (c Client) GetAPIKey(ctx context.Context, id string) (*IAMAPIKey, error) {
 		return nil, fmt.Errorf("GetAPIKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetAPIKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-api-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-api-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetAPIKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetAPIKey: http response: %w", err)
 	}
@@ -1486,6 +1421,10 @@ func (c Client) GetAPIKey(ctx context.Context, id string) (*IAMAPIKey, error) {
 		return nil, fmt.Errorf("GetAPIKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetAPIKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1543,23 +1482,11 @@ func (c Client) ListBlockStorageVolumes(ctx context.Context, opts ...ListBlockSt
 		return nil, fmt.Errorf("ListBlockStorageVolumes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageVolumes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-block-storage-volumes")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-block-storage-volumes", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListBlockStorageVolumes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListBlockStorageVolumes: http response: %w", err)
 	}
@@ -1569,9 +1496,32 @@ func (c Client) ListBlockStorageVolumes(ctx context.Context, opts ...ListBlockSt
 		return nil, fmt.Errorf("ListBlockStorageVolumes: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListBlockStorageVolumes: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllBlockStorageVolumes returns an iterator over the BlockStorageVolume items returned by ListBlockStorageVolumes.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllBlockStorageVolumes(ctx context.Context, opts ...ListBlockStorageVolumesOpt) iter.Seq2[BlockStorageVolume, error] {
+	return func(yield func(BlockStorageVolume, error) bool) {
+		resp, err := c.ListBlockStorageVolumes(ctx, opts...)
+		if err != nil {
+			var zero BlockStorageVolume
+			yield(zero, fmt.Errorf("AllBlockStorageVolumes: %w", err))
+			return
+		}
+
+		for _, item := range resp.BlockStorageVolumes {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateBlockStorageVolumeRequest struct {
 	// Target block storage snapshot
 	BlockStorageSnapshot *BlockStorageSnapshotTarget `json:"block-storage-snapshot,omitempty"`
@@ -1587,6 +1537,10 @@ type CreateBlockStorageVolumeRequest struct {
 func (c Client) CreateBlockStorageVolume(ctx context.Context, req CreateBlockStorageVolumeRequest) (*Operation, error) {
 	path := "/block-storage"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateBlockStorageVolume: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageVolume: prepare Json body: %w", err)
@@ -1605,23 +1559,11 @@ func (c Client) CreateBlockStorageVolume(ctx context.Context, req CreateBlockSto
 		return nil, fmt.Errorf("CreateBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-block-storage-volume", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageVolume: http response: %w", err)
 	}
@@ -1631,9 +1573,29 @@ func (c Client) CreateBlockStorageVolume(ctx context.Context, req CreateBlockSto
 		return nil, fmt.Errorf("CreateBlockStorageVolume: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateBlockStorageVolume: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateBlockStorageVolumeAndWait calls CreateBlockStorageVolume, waits for the resulting operation to succeed
+// and returns the BlockStorageVolume referenced by the operation.
+func (c Client) CreateBlockStorageVolumeAndWait(ctx context.Context, req CreateBlockStorageVolumeRequest, opts ...WaitOpt) (*BlockStorageVolume, error) {
+	op, err := c.CreateBlockStorageVolume(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-block-storage-volume", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateBlockStorageVolumeAndWait: %w", err)
+	}
+
+	return c.GetBlockStorageVolume(ctx, ref.ID)
+}
+
 type ListBlockStorageSnapshotsResponse struct {
 	BlockStorageSnapshots []BlockStorageSnapshot `json:"block-storage-snapshots,omitempty"`
 }
@@ -1672,23 +1634,11 @@ func (c Client) ListBlockStorageSnapshots(ctx context.Context) (*ListBlockStorag
 		return nil, fmt.Errorf("ListBlockStorageSnapshots: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListBlockStorageSnapshots: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-block-storage-snapshots")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-block-storage-snapshots", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListBlockStorageSnapshots: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListBlockStorageSnapshots: http response: %w", err)
 	}
@@ -1698,9 +1648,32 @@ func (c Client) ListBlockStorageSnapshots(ctx context.Context) (*ListBlockStorag
 		return nil, fmt.Errorf("ListBlockStorageSnapshots: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListBlockStorageSnapshots: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllBlockStorageSnapshots returns an iterator over the BlockStorageSnapshot items returned by ListBlockStorageSnapshots.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllBlockStorageSnapshots(ctx context.Context) iter.Seq2[BlockStorageSnapshot, error] {
+	return func(yield func(BlockStorageSnapshot, error) bool) {
+		resp, err := c.ListBlockStorageSnapshots(ctx)
+		if err != nil {
+			var zero BlockStorageSnapshot
+			yield(zero, fmt.Errorf("AllBlockStorageSnapshots: %w", err))
+			return
+		}
+
+		for _, item := range resp.BlockStorageSnapshots {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Delete a block storage snapshot, data will be unrecoverable
 func (c Client) DeleteBlockStorageSnapshot(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/block-storage-snapshot/%v", id)
@@ -1716,23 +1689,11 @@ func (c Client) DeleteBlockStorageSnapshot(ctx context.Context, id UUID) (*Opera
 		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-block-storage-snapshot", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: http response: %w", err)
 	}
@@ -1742,6 +1703,10 @@ func (c Client) DeleteBlockStorageSnapshot(ctx context.Context, id UUID) (*Opera
 		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteBlockStorageSnapshot: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1760,23 +1725,11 @@ func (c Client) GetBlockStorageSnapshot(ctx context.Context, id UUID) (*BlockSto
 		return nil, fmt.Errorf("GetBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-block-storage-snapshot", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetBlockStorageSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetBlockStorageSnapshot: http response: %w", err)
 	}
@@ -1786,6 +1739,10 @@ func (c Client) GetBlockStorageSnapshot(ctx context.Context, id UUID) (*BlockSto
 		return nil, fmt.Errorf("GetBlockStorageSnapshot: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetBlockStorageSnapshot: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1799,6 +1756,10 @@ type UpdateBlockStorageSnapshotRequest struct {
 func (c Client) UpdateBlockStorageSnapshot(ctx context.Context, id UUID, req UpdateBlockStorageSnapshotRequest) (*Operation, error) {
 	path := fmt.Sprintf("/block-storage-snapshot/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: prepare Json body: %w", err)
@@ -1817,23 +1778,11 @@ func (c Client) UpdateBlockStorageSnapshot(ctx context.Context, id UUID, req Upd
 		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-block-storage-snapshot", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: http response: %w", err)
 	}
@@ -1843,9 +1792,29 @@ func (c Client) UpdateBlockStorageSnapshot(ctx context.Context, id UUID, req Upd
 		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageSnapshot: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateBlockStorageSnapshotAndWait calls UpdateBlockStorageSnapshot, waits for the resulting operation to succeed
+// and returns the BlockStorageSnapshot referenced by the operation.
+func (c Client) UpdateBlockStorageSnapshotAndWait(ctx context.Context, id UUID, req UpdateBlockStorageSnapshotRequest, opts ...WaitOpt) (*BlockStorageSnapshot, error) {
+	op, err := c.UpdateBlockStorageSnapshot(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-block-storage-snapshot", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageSnapshotAndWait: %w", err)
+	}
+
+	return c.GetBlockStorageSnapshot(ctx, ref.ID)
+}
+
 // Delete a block storage volume, data will be unrecoverable
 func (c Client) DeleteBlockStorageVolume(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/block-storage/%v", id)
@@ -1861,23 +1830,11 @@ func (c Client) DeleteBlockStorageVolume(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DeleteBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-block-storage-volume", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteBlockStorageVolume: http response: %w", err)
 	}
@@ -1887,6 +1844,10 @@ func (c Client) DeleteBlockStorageVolume(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DeleteBlockStorageVolume: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteBlockStorageVolume: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1905,23 +1866,11 @@ func (c Client) GetBlockStorageVolume(ctx context.Context, id UUID) (*BlockStora
 		return nil, fmt.Errorf("GetBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-block-storage-volume", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetBlockStorageVolume: http response: %w", err)
 	}
@@ -1931,6 +1880,10 @@ func (c Client) GetBlockStorageVolume(ctx context.Context, id UUID) (*BlockStora
 		return nil, fmt.Errorf("GetBlockStorageVolume: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetBlockStorageVolume: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -1944,6 +1897,10 @@ type UpdateBlockStorageVolumeRequest struct {
 func (c Client) UpdateBlockStorageVolume(ctx context.Context, id UUID, req UpdateBlockStorageVolumeRequest) (*Operation, error) {
 	path := fmt.Sprintf("/block-storage/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageVolume: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageVolume: prepare Json body: %w", err)
@@ -1962,23 +1919,11 @@ func (c Client) UpdateBlockStorageVolume(ctx context.Context, id UUID, req Updat
 		return nil, fmt.Errorf("UpdateBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-block-storage-volume", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateBlockStorageVolume: http response: %w", err)
 	}
@@ -1988,9 +1933,29 @@ func (c Client) UpdateBlockStorageVolume(ctx context.Context, id UUID, req Updat
 		return nil, fmt.Errorf("UpdateBlockStorageVolume: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageVolume: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateBlockStorageVolumeAndWait calls UpdateBlockStorageVolume, waits for the resulting operation to succeed
+// and returns the BlockStorageVolume referenced by the operation.
+func (c Client) UpdateBlockStorageVolumeAndWait(ctx context.Context, id UUID, req UpdateBlockStorageVolumeRequest, opts ...WaitOpt) (*BlockStorageVolume, error) {
+	op, err := c.UpdateBlockStorageVolume(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-block-storage-volume", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateBlockStorageVolumeAndWait: %w", err)
+	}
+
+	return c.GetBlockStorageVolume(ctx, ref.ID)
+}
+
 type AttachBlockStorageVolumeToInstanceRequest struct {
 	// Target Instance
 	Instance *InstanceTarget `json:"instance" validate:"required"`
@@ -2000,6 +1965,10 @@ type AttachBlockStorageVolumeToInstanceRequest struct {
 func (c Client) AttachBlockStorageVolumeToInstance(ctx context.Context, id UUID, req AttachBlockStorageVolumeToInstanceRequest) (*Operation, error) {
 	path := fmt.Sprintf("/block-storage/%v:attach", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: prepare Json body: %w", err)
@@ -2018,23 +1987,11 @@ func (c Client) AttachBlockStorageVolumeToInstance(ctx context.Context, id UUID,
 		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-block-storage-volume-to-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "attach-block-storage-volume-to-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: http response: %w", err)
 	}
@@ -2044,9 +2001,29 @@ func (c Client) AttachBlockStorageVolumeToInstance(ctx context.Context, id UUID,
 		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AttachBlockStorageVolumeToInstanceAndWait calls AttachBlockStorageVolumeToInstance, waits for the resulting operation to succeed
+// and returns the BlockStorageVolume referenced by the operation.
+func (c Client) AttachBlockStorageVolumeToInstanceAndWait(ctx context.Context, id UUID, req AttachBlockStorageVolumeToInstanceRequest, opts ...WaitOpt) (*BlockStorageVolume, error) {
+	op, err := c.AttachBlockStorageVolumeToInstance(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-block-storage-volume", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("AttachBlockStorageVolumeToInstanceAndWait: %w", err)
+	}
+
+	return c.GetBlockStorageVolume(ctx, ref.ID)
+}
+
 type CreateBlockStorageSnapshotRequest struct {
 	Labels Labels `json:"labels,omitempty"`
 	// Snapshot name
@@ -2057,6 +2034,10 @@ type CreateBlockStorageSnapshotRequest struct {
 func (c Client) CreateBlockStorageSnapshot(ctx context.Context, id UUID, req CreateBlockStorageSnapshotRequest) (*Operation, error) {
 	path := fmt.Sprintf("/block-storage/%v:create-snapshot", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateBlockStorageSnapshot: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageSnapshot: prepare Json body: %w", err)
@@ -2075,23 +2056,11 @@ func (c Client) CreateBlockStorageSnapshot(ctx context.Context, id UUID, req Cre
 		return nil, fmt.Errorf("CreateBlockStorageSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateBlockStorageSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-block-storage-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-block-storage-snapshot", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateBlockStorageSnapshot: http response: %w", err)
 	}
@@ -2101,6 +2070,10 @@ func (c Client) CreateBlockStorageSnapshot(ctx context.Context, id UUID, req Cre
 		return nil, fmt.Errorf("CreateBlockStorageSnapshot: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateBlockStorageSnapshot: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2119,23 +2092,11 @@ func (c Client) DetachBlockStorageVolume(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DetachBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "detach-block-storage-volume", request)
 	if err != nil {
 		return nil, fmt.Errorf("DetachBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DetachBlockStorageVolume: http response: %w", err)
 	}
@@ -2145,9 +2106,29 @@ func (c Client) DetachBlockStorageVolume(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DetachBlockStorageVolume: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachBlockStorageVolume: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// DetachBlockStorageVolumeAndWait calls DetachBlockStorageVolume, waits for the resulting operation to succeed
+// and returns the BlockStorageVolume referenced by the operation.
+func (c Client) DetachBlockStorageVolumeAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*BlockStorageVolume, error) {
+	op, err := c.DetachBlockStorageVolume(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-block-storage-volume", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("DetachBlockStorageVolumeAndWait: %w", err)
+	}
+
+	return c.GetBlockStorageVolume(ctx, ref.ID)
+}
+
 type ResizeBlockStorageVolumeRequest struct {
 	// Volume size in GiB
 	Size int64 `json:"size" validate:"required,gt=0"`
@@ -2157,6 +2138,10 @@ type ResizeBlockStorageVolumeRequest struct {
 func (c Client) ResizeBlockStorageVolume(ctx context.Context, id UUID, req ResizeBlockStorageVolumeRequest) (*BlockStorageVolume, error) {
 	path := fmt.Sprintf("/block-storage/%v:resize-volume", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ResizeBlockStorageVolume: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ResizeBlockStorageVolume: prepare Json body: %w", err)
@@ -2175,23 +2160,11 @@ func (c Client) ResizeBlockStorageVolume(ctx context.Context, id UUID, req Resiz
 		return nil, fmt.Errorf("ResizeBlockStorageVolume: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResizeBlockStorageVolume: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "resize-block-storage-volume")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "resize-block-storage-volume", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResizeBlockStorageVolume: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResizeBlockStorageVolume: http response: %w", err)
 	}
@@ -2201,6 +2174,10 @@ func (c Client) ResizeBlockStorageVolume(ctx context.Context, id UUID, req Resiz
 		return nil, fmt.Errorf("ResizeBlockStorageVolume: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResizeBlockStorageVolume: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2225,23 +2202,11 @@ func (c Client) GetConsoleProxyURL(ctx context.Context, id UUID) (*GetConsolePro
 		return nil, fmt.Errorf("GetConsoleProxyURL: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetConsoleProxyURL: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-console-proxy-url")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-console-proxy-url", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetConsoleProxyURL: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetConsoleProxyURL: http response: %w", err)
 	}
@@ -2251,6 +2216,10 @@ func (c Client) GetConsoleProxyURL(ctx context.Context, id UUID) (*GetConsolePro
 		return nil, fmt.Errorf("GetConsoleProxyURL: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetConsoleProxyURL: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2273,23 +2242,11 @@ func (c Client) GetDBAASCACertificate(ctx context.Context) (*GetDBAASCACertifica
 		return nil, fmt.Errorf("GetDBAASCACertificate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASCACertificate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-ca-certificate")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-ca-certificate", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASCACertificate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASCACertificate: http response: %w", err)
 	}
@@ -2299,6 +2256,10 @@ func (c Client) GetDBAASCACertificate(ctx context.Context) (*GetDBAASCACertifica
 		return nil, fmt.Errorf("GetDBAASCACertificate: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASCACertificate: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2317,23 +2278,11 @@ func (c Client) DeleteDBAASExternalEndpointDatadog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-external-endpoint-datadog", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: http response: %w", err)
 	}
@@ -2343,6 +2292,10 @@ func (c Client) DeleteDBAASExternalEndpointDatadog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointDatadog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2361,23 +2314,11 @@ func (c Client) GetDBAASExternalEndpointDatadog(ctx context.Context, endpointID
 		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-external-endpoint-datadog", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: http response: %w", err)
 	}
@@ -2387,6 +2328,10 @@ func (c Client) GetDBAASExternalEndpointDatadog(ctx context.Context, endpointID
 		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointDatadog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2394,6 +2339,10 @@ func (c Client) GetDBAASExternalEndpointDatadog(ctx context.Context, endpointID
 func (c Client) UpdateDBAASExternalEndpointDatadog(ctx context.Context, endpointID UUID, req DBAASEndpointDatadogInputUpdate) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-datadog/%v", endpointID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: prepare Json body: %w", err)
@@ -2412,23 +2361,11 @@ func (c Client) UpdateDBAASExternalEndpointDatadog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-external-endpoint-datadog", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: http response: %w", err)
 	}
@@ -2438,13 +2375,37 @@ func (c Client) UpdateDBAASExternalEndpointDatadog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateDBAASExternalEndpointDatadogAndWait calls UpdateDBAASExternalEndpointDatadog, waits for the resulting operation to succeed
+// and returns the DBAASExternalEndpointDatadogOutput referenced by the operation.
+func (c Client) UpdateDBAASExternalEndpointDatadogAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointDatadogInputUpdate, opts ...WaitOpt) (*DBAASExternalEndpointDatadogOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointDatadog(ctx, endpointID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-datadog", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointDatadogAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointDatadog(ctx, ref.ID)
+}
+
 // [BETA] Create DataDog external integration endpoint
 func (c Client) CreateDBAASExternalEndpointDatadog(ctx context.Context, name string, req DBAASEndpointDatadogInputCreate) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-datadog/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: prepare Json body: %w", err)
@@ -2463,23 +2424,11 @@ func (c Client) CreateDBAASExternalEndpointDatadog(ctx context.Context, name str
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-external-endpoint-datadog", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: http response: %w", err)
 	}
@@ -2489,9 +2438,29 @@ func (c Client) CreateDBAASExternalEndpointDatadog(ctx context.Context, name str
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateDBAASExternalEndpointDatadogAndWait calls CreateDBAASExternalEndpointDatadog, waits for the resulting operation to succeed
+// and returns the DBAASExternalEndpointDatadogOutput referenced by the operation.
+func (c Client) CreateDBAASExternalEndpointDatadogAndWait(ctx context.Context, name string, req DBAASEndpointDatadogInputCreate, opts ...WaitOpt) (*DBAASExternalEndpointDatadogOutput, error) {
+	op, err := c.CreateDBAASExternalEndpointDatadog(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-datadog", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointDatadogAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointDatadog(ctx, ref.ID)
+}
+
 // [BETA] Delete ElasticSearch logs external integration endpoint
 func (c Client) DeleteDBAASExternalEndpointElasticsearch(ctx context.Context, endpointID UUID) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-elasticsearch/%v", endpointID)
@@ -2507,23 +2476,11 @@ func (c Client) DeleteDBAASExternalEndpointElasticsearch(ctx context.Context, en
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-elasticsearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-external-endpoint-elasticsearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: http response: %w", err)
 	}
@@ -2533,6 +2490,10 @@ func (c Client) DeleteDBAASExternalEndpointElasticsearch(ctx context.Context, en
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointElasticsearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2551,23 +2512,11 @@ func (c Client) GetDBAASExternalEndpointElasticsearch(ctx context.Context, endpo
 		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-elasticsearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-external-endpoint-elasticsearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: http response: %w", err)
 	}
@@ -2577,6 +2526,10 @@ func (c Client) GetDBAASExternalEndpointElasticsearch(ctx context.Context, endpo
 		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointElasticsearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2584,6 +2537,10 @@ func (c Client) GetDBAASExternalEndpointElasticsearch(ctx context.Context, endpo
 func (c Client) UpdateDBAASExternalEndpointElasticsearch(ctx context.Context, endpointID UUID, req DBAASEndpointElasticsearchInputUpdate) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-elasticsearch/%v", endpointID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: prepare Json body: %w", err)
@@ -2602,23 +2559,11 @@ func (c Client) UpdateDBAASExternalEndpointElasticsearch(ctx context.Context, en
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-elasticsearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-external-endpoint-elasticsearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: http response: %w", err)
 	}
@@ -2628,13 +2573,37 @@ func (c Client) UpdateDBAASExternalEndpointElasticsearch(ctx context.Context, en
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateDBAASExternalEndpointElasticsearchAndWait calls UpdateDBAASExternalEndpointElasticsearch, waits for the resulting operation to succeed
+// and returns the DBAASEndpointElasticsearchOutput referenced by the operation.
+func (c Client) UpdateDBAASExternalEndpointElasticsearchAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointElasticsearchInputUpdate, opts ...WaitOpt) (*DBAASEndpointElasticsearchOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointElasticsearch(ctx, endpointID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-elasticsearch", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointElasticsearchAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointElasticsearch(ctx, ref.ID)
+}
+
 // [BETA] Create ElasticSearch Logs external integration endpoint
 func (c Client) CreateDBAASExternalEndpointElasticsearch(ctx context.Context, name string, req DBAASEndpointElasticsearchInputCreate) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-elasticsearch/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: prepare Json body: %w", err)
@@ -2653,23 +2622,11 @@ func (c Client) CreateDBAASExternalEndpointElasticsearch(ctx context.Context, na
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-elasticsearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-external-endpoint-elasticsearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: http response: %w", err)
 	}
@@ -2679,9 +2636,29 @@ func (c Client) CreateDBAASExternalEndpointElasticsearch(ctx context.Context, na
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateDBAASExternalEndpointElasticsearchAndWait calls CreateDBAASExternalEndpointElasticsearch, waits for the resulting operation to succeed
+// and returns the DBAASEndpointElasticsearchOutput referenced by the operation.
+func (c Client) CreateDBAASExternalEndpointElasticsearchAndWait(ctx context.Context, name string, req DBAASEndpointElasticsearchInputCreate, opts ...WaitOpt) (*DBAASEndpointElasticsearchOutput, error) {
+	op, err := c.CreateDBAASExternalEndpointElasticsearch(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-elasticsearch", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointElasticsearchAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointElasticsearch(ctx, ref.ID)
+}
+
 // [BETA] Delete OpenSearch logs external integration endpoint
 func (c Client) DeleteDBAASExternalEndpointOpensearch(ctx context.Context, endpointID UUID) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-opensearch/%v", endpointID)
@@ -2697,23 +2674,11 @@ func (c Client) DeleteDBAASExternalEndpointOpensearch(ctx context.Context, endpo
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-external-endpoint-opensearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: http response: %w", err)
 	}
@@ -2723,6 +2688,10 @@ func (c Client) DeleteDBAASExternalEndpointOpensearch(ctx context.Context, endpo
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointOpensearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2741,23 +2710,11 @@ func (c Client) GetDBAASExternalEndpointOpensearch(ctx context.Context, endpoint
 		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-external-endpoint-opensearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: http response: %w", err)
 	}
@@ -2767,6 +2724,10 @@ func (c Client) GetDBAASExternalEndpointOpensearch(ctx context.Context, endpoint
 		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointOpensearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2774,6 +2735,10 @@ func (c Client) GetDBAASExternalEndpointOpensearch(ctx context.Context, endpoint
 func (c Client) UpdateDBAASExternalEndpointOpensearch(ctx context.Context, endpointID UUID, req DBAASEndpointOpensearchInputUpdate) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-opensearch/%v", endpointID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: prepare Json body: %w", err)
@@ -2792,23 +2757,11 @@ func (c Client) UpdateDBAASExternalEndpointOpensearch(ctx context.Context, endpo
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-external-endpoint-opensearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: http response: %w", err)
 	}
@@ -2818,13 +2771,37 @@ func (c Client) UpdateDBAASExternalEndpointOpensearch(ctx context.Context, endpo
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateDBAASExternalEndpointOpensearchAndWait calls UpdateDBAASExternalEndpointOpensearch, waits for the resulting operation to succeed
+// and returns the DBAASEndpointOpensearchOutput referenced by the operation.
+func (c Client) UpdateDBAASExternalEndpointOpensearchAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointOpensearchInputUpdate, opts ...WaitOpt) (*DBAASEndpointOpensearchOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointOpensearch(ctx, endpointID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-opensearch", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointOpensearchAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointOpensearch(ctx, ref.ID)
+}
+
 // [BETA] Create OpenSearch Logs external integration endpoint
 func (c Client) CreateDBAASExternalEndpointOpensearch(ctx context.Context, name string, req DBAASEndpointOpensearchInputCreate) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-opensearch/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: prepare Json body: %w", err)
@@ -2843,23 +2820,11 @@ func (c Client) CreateDBAASExternalEndpointOpensearch(ctx context.Context, name
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-external-endpoint-opensearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: http response: %w", err)
 	}
@@ -2869,9 +2834,29 @@ func (c Client) CreateDBAASExternalEndpointOpensearch(ctx context.Context, name
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateDBAASExternalEndpointOpensearchAndWait calls CreateDBAASExternalEndpointOpensearch, waits for the resulting operation to succeed
+// and returns the DBAASEndpointOpensearchOutput referenced by the operation.
+func (c Client) CreateDBAASExternalEndpointOpensearchAndWait(ctx context.Context, name string, req DBAASEndpointOpensearchInputCreate, opts ...WaitOpt) (*DBAASEndpointOpensearchOutput, error) {
+	op, err := c.CreateDBAASExternalEndpointOpensearch(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-opensearch", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointOpensearchAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointOpensearch(ctx, ref.ID)
+}
+
 // [BETA] Delete Prometheus external integration endpoint
 func (c Client) DeleteDBAASExternalEndpointPrometheus(ctx context.Context, endpointID UUID) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-prometheus/%v", endpointID)
@@ -2887,23 +2872,11 @@ func (c Client) DeleteDBAASExternalEndpointPrometheus(ctx context.Context, endpo
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-prometheus")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-external-endpoint-prometheus", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: http response: %w", err)
 	}
@@ -2913,6 +2886,10 @@ func (c Client) DeleteDBAASExternalEndpointPrometheus(ctx context.Context, endpo
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointPrometheus: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2931,23 +2908,11 @@ func (c Client) GetDBAASExternalEndpointPrometheus(ctx context.Context, endpoint
 		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-prometheus")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-external-endpoint-prometheus", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: http response: %w", err)
 	}
@@ -2957,6 +2922,10 @@ func (c Client) GetDBAASExternalEndpointPrometheus(ctx context.Context, endpoint
 		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointPrometheus: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -2964,6 +2933,10 @@ func (c Client) GetDBAASExternalEndpointPrometheus(ctx context.Context, endpoint
 func (c Client) UpdateDBAASExternalEndpointPrometheus(ctx context.Context, endpointID UUID, req DBAASEndpointPrometheusPayload) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-prometheus/%v", endpointID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: prepare Json body: %w", err)
@@ -2982,23 +2955,11 @@ func (c Client) UpdateDBAASExternalEndpointPrometheus(ctx context.Context, endpo
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-prometheus")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-external-endpoint-prometheus", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: http response: %w", err)
 	}
@@ -3008,13 +2969,37 @@ func (c Client) UpdateDBAASExternalEndpointPrometheus(ctx context.Context, endpo
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheus: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateDBAASExternalEndpointPrometheusAndWait calls UpdateDBAASExternalEndpointPrometheus, waits for the resulting operation to succeed
+// and returns the DBAASEndpointExternalPrometheusOutput referenced by the operation.
+func (c Client) UpdateDBAASExternalEndpointPrometheusAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointPrometheusPayload, opts ...WaitOpt) (*DBAASEndpointExternalPrometheusOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointPrometheus(ctx, endpointID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-prometheus", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointPrometheusAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointPrometheus(ctx, ref.ID)
+}
+
 // [BETA] Create Prometheus external integration endpoint
 func (c Client) CreateDBAASExternalEndpointPrometheus(ctx context.Context, name string, req DBAASEndpointPrometheusPayload) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-prometheus/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: prepare Json body: %w", err)
@@ -3033,23 +3018,11 @@ func (c Client) CreateDBAASExternalEndpointPrometheus(ctx context.Context, name
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-prometheus")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-external-endpoint-prometheus", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: http response: %w", err)
 	}
@@ -3059,9 +3032,29 @@ func (c Client) CreateDBAASExternalEndpointPrometheus(ctx context.Context, name
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheus: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateDBAASExternalEndpointPrometheusAndWait calls CreateDBAASExternalEndpointPrometheus, waits for the resulting operation to succeed
+// and returns the DBAASEndpointExternalPrometheusOutput referenced by the operation.
+func (c Client) CreateDBAASExternalEndpointPrometheusAndWait(ctx context.Context, name string, req DBAASEndpointPrometheusPayload, opts ...WaitOpt) (*DBAASEndpointExternalPrometheusOutput, error) {
+	op, err := c.CreateDBAASExternalEndpointPrometheus(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-prometheus", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointPrometheusAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointPrometheus(ctx, ref.ID)
+}
+
 // [BETA] Delete RSyslog external integration endpoint
 func (c Client) DeleteDBAASExternalEndpointRsyslog(ctx context.Context, endpointID UUID) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-rsyslog/%v", endpointID)
@@ -3077,23 +3070,11 @@ func (c Client) DeleteDBAASExternalEndpointRsyslog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-external-endpoint-rsyslog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-external-endpoint-rsyslog", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: http response: %w", err)
 	}
@@ -3103,6 +3084,10 @@ func (c Client) DeleteDBAASExternalEndpointRsyslog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASExternalEndpointRsyslog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3121,23 +3106,11 @@ func (c Client) GetDBAASExternalEndpointRsyslog(ctx context.Context, endpointID
 		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-endpoint-rsyslog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-external-endpoint-rsyslog", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: http response: %w", err)
 	}
@@ -3147,6 +3120,10 @@ func (c Client) GetDBAASExternalEndpointRsyslog(ctx context.Context, endpointID
 		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalEndpointRsyslog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3154,6 +3131,10 @@ func (c Client) GetDBAASExternalEndpointRsyslog(ctx context.Context, endpointID
 func (c Client) UpdateDBAASExternalEndpointRsyslog(ctx context.Context, endpointID UUID, req DBAASEndpointRsyslogInputUpdate) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-rsyslog/%v", endpointID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: prepare Json body: %w", err)
@@ -3172,23 +3153,11 @@ func (c Client) UpdateDBAASExternalEndpointRsyslog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-endpoint-rsyslog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-external-endpoint-rsyslog", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: http response: %w", err)
 	}
@@ -3198,13 +3167,37 @@ func (c Client) UpdateDBAASExternalEndpointRsyslog(ctx context.Context, endpoint
 		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateDBAASExternalEndpointRsyslogAndWait calls UpdateDBAASExternalEndpointRsyslog, waits for the resulting operation to succeed
+// and returns the DBAASExternalEndpointRsyslogOutput referenced by the operation.
+func (c Client) UpdateDBAASExternalEndpointRsyslogAndWait(ctx context.Context, endpointID UUID, req DBAASEndpointRsyslogInputUpdate, opts ...WaitOpt) (*DBAASExternalEndpointRsyslogOutput, error) {
+	op, err := c.UpdateDBAASExternalEndpointRsyslog(ctx, endpointID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-rsyslog", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalEndpointRsyslogAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointRsyslog(ctx, ref.ID)
+}
+
 // [BETA] Create RSyslog external integration endpoint
 func (c Client) CreateDBAASExternalEndpointRsyslog(ctx context.Context, name string, req DBAASEndpointRsyslogInputCreate) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint-rsyslog/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: prepare Json body: %w", err)
@@ -3223,23 +3216,11 @@ func (c Client) CreateDBAASExternalEndpointRsyslog(ctx context.Context, name str
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-external-endpoint-rsyslog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-external-endpoint-rsyslog", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: http response: %w", err)
 	}
@@ -3249,9 +3230,29 @@ func (c Client) CreateDBAASExternalEndpointRsyslog(ctx context.Context, name str
 		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateDBAASExternalEndpointRsyslogAndWait calls CreateDBAASExternalEndpointRsyslog, waits for the resulting operation to succeed
+// and returns the DBAASExternalEndpointRsyslogOutput referenced by the operation.
+func (c Client) CreateDBAASExternalEndpointRsyslogAndWait(ctx context.Context, name string, req DBAASEndpointRsyslogInputCreate, opts ...WaitOpt) (*DBAASExternalEndpointRsyslogOutput, error) {
+	op, err := c.CreateDBAASExternalEndpointRsyslog(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-endpoint-rsyslog", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASExternalEndpointRsyslogAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalEndpointRsyslog(ctx, ref.ID)
+}
+
 type ListDBAASExternalEndpointTypesResponseEndpointTypes struct {
 	ServiceTypes []string                  `json:"service-types,omitempty"`
 	Title        string                    `json:"title,omitempty"`
@@ -3277,23 +3278,11 @@ func (c Client) ListDBAASExternalEndpointTypes(ctx context.Context) (*ListDBAASE
 		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-external-endpoint-types")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dbaas-external-endpoint-types", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: http response: %w", err)
 	}
@@ -3303,9 +3292,32 @@ func (c Client) ListDBAASExternalEndpointTypes(ctx context.Context) (*ListDBAASE
 		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASExternalEndpointTypes: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDBAASExternalEndpointTypes returns an iterator over the ListDBAASExternalEndpointTypesResponseEndpointTypes items returned by ListDBAASExternalEndpointTypes.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDBAASExternalEndpointTypes(ctx context.Context) iter.Seq2[ListDBAASExternalEndpointTypesResponseEndpointTypes, error] {
+	return func(yield func(ListDBAASExternalEndpointTypesResponseEndpointTypes, error) bool) {
+		resp, err := c.ListDBAASExternalEndpointTypes(ctx)
+		if err != nil {
+			var zero ListDBAASExternalEndpointTypesResponseEndpointTypes
+			yield(zero, fmt.Errorf("AllDBAASExternalEndpointTypes: %w", err))
+			return
+		}
+
+		for _, item := range resp.EndpointTypes {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type AttachDBAASServiceToEndpointRequest struct {
 	// External endpoint id
 	DestEndpointID UUID                      `json:"dest-endpoint-id" validate:"required"`
@@ -3316,6 +3328,10 @@ type AttachDBAASServiceToEndpointRequest struct {
 func (c Client) AttachDBAASServiceToEndpoint(ctx context.Context, sourceServiceName string, req AttachDBAASServiceToEndpointRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint/%v/attach", sourceServiceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: prepare Json body: %w", err)
@@ -3334,23 +3350,11 @@ func (c Client) AttachDBAASServiceToEndpoint(ctx context.Context, sourceServiceN
 		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-dbaas-service-to-endpoint")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "attach-dbaas-service-to-endpoint", request)
 	if err != nil {
 		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: http response: %w", err)
 	}
@@ -3360,6 +3364,10 @@ func (c Client) AttachDBAASServiceToEndpoint(ctx context.Context, sourceServiceN
 		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachDBAASServiceToEndpoint: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3372,6 +3380,10 @@ type DetachDBAASServiceFromEndpointRequest struct {
 func (c Client) DetachDBAASServiceFromEndpoint(ctx context.Context, sourceServiceName string, req DetachDBAASServiceFromEndpointRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-endpoint/%v/detach", sourceServiceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: prepare Json body: %w", err)
@@ -3390,23 +3402,11 @@ func (c Client) DetachDBAASServiceFromEndpoint(ctx context.Context, sourceServic
 		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-dbaas-service-from-endpoint")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "detach-dbaas-service-from-endpoint", request)
 	if err != nil {
 		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: http response: %w", err)
 	}
@@ -3416,6 +3416,10 @@ func (c Client) DetachDBAASServiceFromEndpoint(ctx context.Context, sourceServic
 		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachDBAASServiceFromEndpoint: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3457,23 +3461,11 @@ func (c Client) ListDBAASExternalEndpoints(ctx context.Context) (*ListDBAASExter
 		return nil, fmt.Errorf("ListDBAASExternalEndpoints: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalEndpoints: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-external-endpoints")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dbaas-external-endpoints", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASExternalEndpoints: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDBAASExternalEndpoints: http response: %w", err)
 	}
@@ -3483,9 +3475,32 @@ func (c Client) ListDBAASExternalEndpoints(ctx context.Context) (*ListDBAASExter
 		return nil, fmt.Errorf("ListDBAASExternalEndpoints: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASExternalEndpoints: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDBAASExternalEndpoints returns an iterator over the DBAASExternalEndpoint items returned by ListDBAASExternalEndpoints.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDBAASExternalEndpoints(ctx context.Context) iter.Seq2[DBAASExternalEndpoint, error] {
+	return func(yield func(DBAASExternalEndpoint, error) bool) {
+		resp, err := c.ListDBAASExternalEndpoints(ctx)
+		if err != nil {
+			var zero DBAASExternalEndpoint
+			yield(zero, fmt.Errorf("AllDBAASExternalEndpoints: %w", err))
+			return
+		}
+
+		for _, item := range resp.DBAASEndpoints {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type GetDBAASExternalIntegrationSettingsDatadogResponse struct {
 	Settings *DBAASIntegrationSettingsDatadog `json:"settings,omitempty"`
 }
@@ -3505,23 +3520,11 @@ func (c Client) GetDBAASExternalIntegrationSettingsDatadog(ctx context.Context,
 		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-integration-settings-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-external-integration-settings-datadog", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: http response: %w", err)
 	}
@@ -3531,6 +3534,10 @@ func (c Client) GetDBAASExternalIntegrationSettingsDatadog(ctx context.Context,
 		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalIntegrationSettingsDatadog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3542,6 +3549,10 @@ type UpdateDBAASExternalIntegrationSettingsDatadogRequest struct {
 func (c Client) UpdateDBAASExternalIntegrationSettingsDatadog(ctx context.Context, integrationID UUID, req UpdateDBAASExternalIntegrationSettingsDatadogRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-external-integration-settings-datadog/%v", integrationID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: prepare Json body: %w", err)
@@ -3560,23 +3571,11 @@ func (c Client) UpdateDBAASExternalIntegrationSettingsDatadog(ctx context.Contex
 		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-external-integration-settings-datadog")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-external-integration-settings-datadog", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: http response: %w", err)
 	}
@@ -3586,9 +3585,29 @@ func (c Client) UpdateDBAASExternalIntegrationSettingsDatadog(ctx context.Contex
 		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadog: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateDBAASExternalIntegrationSettingsDatadogAndWait calls UpdateDBAASExternalIntegrationSettingsDatadog, waits for the resulting operation to succeed
+// and returns the GetDBAASExternalIntegrationSettingsDatadogResponse referenced by the operation.
+func (c Client) UpdateDBAASExternalIntegrationSettingsDatadogAndWait(ctx context.Context, integrationID UUID, req UpdateDBAASExternalIntegrationSettingsDatadogRequest, opts ...WaitOpt) (*GetDBAASExternalIntegrationSettingsDatadogResponse, error) {
+	op, err := c.UpdateDBAASExternalIntegrationSettingsDatadog(ctx, integrationID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-external-integration-settings-datadog", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASExternalIntegrationSettingsDatadogAndWait: %w", err)
+	}
+
+	return c.GetDBAASExternalIntegrationSettingsDatadog(ctx, ref.ID)
+}
+
 // [BETA] Get a DBaaS external integration
 func (c Client) GetDBAASExternalIntegration(ctx context.Context, integrationID UUID) (*DBAASExternalIntegration, error) {
 	path := fmt.Sprintf("/dbaas-external-integration/%v", integrationID)
@@ -3604,23 +3623,11 @@ func (c Client) GetDBAASExternalIntegration(ctx context.Context, integrationID U
 		return nil, fmt.Errorf("GetDBAASExternalIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASExternalIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-external-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-external-integration", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalIntegration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASExternalIntegration: http response: %w", err)
 	}
@@ -3630,6 +3637,10 @@ func (c Client) GetDBAASExternalIntegration(ctx context.Context, integrationID U
 		return nil, fmt.Errorf("GetDBAASExternalIntegration: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASExternalIntegration: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3652,23 +3663,11 @@ func (c Client) ListDBAASExternalIntegrations(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("ListDBAASExternalIntegrations: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASExternalIntegrations: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-external-integrations")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dbaas-external-integrations", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASExternalIntegrations: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDBAASExternalIntegrations: http response: %w", err)
 	}
@@ -3678,9 +3677,32 @@ func (c Client) ListDBAASExternalIntegrations(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("ListDBAASExternalIntegrations: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASExternalIntegrations: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDBAASExternalIntegrations returns an iterator over the DBAASExternalIntegration items returned by ListDBAASExternalIntegrations.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDBAASExternalIntegrations(ctx context.Context, serviceName string) iter.Seq2[DBAASExternalIntegration, error] {
+	return func(yield func(DBAASExternalIntegration, error) bool) {
+		resp, err := c.ListDBAASExternalIntegrations(ctx, serviceName)
+		if err != nil {
+			var zero DBAASExternalIntegration
+			yield(zero, fmt.Errorf("AllDBAASExternalIntegrations: %w", err))
+			return
+		}
+
+		for _, item := range resp.ExternalIntegrations {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 func (c Client) DeleteDBAASServiceGrafana(ctx context.Context, name string) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-grafana/%v", name)
 
@@ -3695,23 +3717,11 @@ func (c Client) DeleteDBAASServiceGrafana(ctx context.Context, name string) (*Op
 		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-service-grafana", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: http response: %w", err)
 	}
@@ -3721,6 +3731,10 @@ func (c Client) DeleteDBAASServiceGrafana(ctx context.Context, name string) (*Op
 		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceGrafana: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3738,23 +3752,11 @@ func (c Client) GetDBAASServiceGrafana(ctx context.Context, name string) (*DBAAS
 		return nil, fmt.Errorf("GetDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-grafana", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceGrafana: http response: %w", err)
 	}
@@ -3764,6 +3766,10 @@ func (c Client) GetDBAASServiceGrafana(ctx context.Context, name string) (*DBAAS
 		return nil, fmt.Errorf("GetDBAASServiceGrafana: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceGrafana: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3806,6 +3812,10 @@ type CreateDBAASServiceGrafanaRequest struct {
 func (c Client) CreateDBAASServiceGrafana(ctx context.Context, name string, req CreateDBAASServiceGrafanaRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-grafana/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceGrafana: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceGrafana: prepare Json body: %w", err)
@@ -3824,23 +3834,11 @@ func (c Client) CreateDBAASServiceGrafana(ctx context.Context, name string, req
 		return nil, fmt.Errorf("CreateDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-service-grafana", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceGrafana: http response: %w", err)
 	}
@@ -3850,6 +3848,10 @@ func (c Client) CreateDBAASServiceGrafana(ctx context.Context, name string, req
 		return nil, fmt.Errorf("CreateDBAASServiceGrafana: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceGrafana: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3890,6 +3892,10 @@ type UpdateDBAASServiceGrafanaRequest struct {
 func (c Client) UpdateDBAASServiceGrafana(ctx context.Context, name string, req UpdateDBAASServiceGrafanaRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-grafana/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: prepare Json body: %w", err)
@@ -3908,23 +3914,11 @@ func (c Client) UpdateDBAASServiceGrafana(ctx context.Context, name string, req
 		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-service-grafana", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: http response: %w", err)
 	}
@@ -3934,6 +3928,10 @@ func (c Client) UpdateDBAASServiceGrafana(ctx context.Context, name string, req
 		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceGrafana: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3951,23 +3949,11 @@ func (c Client) StartDBAASGrafanaMaintenance(ctx context.Context, name string) (
 		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-grafana-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "start-dbaas-grafana-maintenance", request)
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: http response: %w", err)
 	}
@@ -3977,6 +3963,10 @@ func (c Client) StartDBAASGrafanaMaintenance(ctx context.Context, name string) (
 		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASGrafanaMaintenance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -3988,6 +3978,10 @@ type ResetDBAASGrafanaUserPasswordRequest struct {
 func (c Client) ResetDBAASGrafanaUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASGrafanaUserPasswordRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-grafana/%v/user/%v/password/reset", serviceName, username)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: prepare Json body: %w", err)
@@ -4006,23 +4000,11 @@ func (c Client) ResetDBAASGrafanaUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-grafana-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-dbaas-grafana-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: http response: %w", err)
 	}
@@ -4032,6 +4014,10 @@ func (c Client) ResetDBAASGrafanaUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASGrafanaUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4049,23 +4035,11 @@ func (c Client) RevealDBAASGrafanaUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-grafana-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-dbaas-grafana-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: http response: %w", err)
 	}
@@ -4075,6 +4049,10 @@ func (c Client) RevealDBAASGrafanaUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASGrafanaUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4090,6 +4068,10 @@ type CreateDBAASIntegrationRequest struct {
 func (c Client) CreateDBAASIntegration(ctx context.Context, req CreateDBAASIntegrationRequest) (*Operation, error) {
 	path := "/dbaas-integration"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASIntegration: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASIntegration: prepare Json body: %w", err)
@@ -4108,23 +4090,11 @@ func (c Client) CreateDBAASIntegration(ctx context.Context, req CreateDBAASInteg
 		return nil, fmt.Errorf("CreateDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-integration", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASIntegration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASIntegration: http response: %w", err)
 	}
@@ -4134,9 +4104,29 @@ func (c Client) CreateDBAASIntegration(ctx context.Context, req CreateDBAASInteg
 		return nil, fmt.Errorf("CreateDBAASIntegration: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASIntegration: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateDBAASIntegrationAndWait calls CreateDBAASIntegration, waits for the resulting operation to succeed
+// and returns the DBAASIntegration referenced by the operation.
+func (c Client) CreateDBAASIntegrationAndWait(ctx context.Context, req CreateDBAASIntegrationRequest, opts ...WaitOpt) (*DBAASIntegration, error) {
+	op, err := c.CreateDBAASIntegration(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-integration", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDBAASIntegrationAndWait: %w", err)
+	}
+
+	return c.GetDBAASIntegration(ctx, ref.ID)
+}
+
 // The JSON schema representing the settings for the given integration type, source, and destination service types.
 type ListDBAASIntegrationSettingsResponseSettings struct {
 	AdditionalProperties *bool          `json:"additionalProperties,omitempty"`
@@ -4161,27 +4151,15 @@ func (c Client) ListDBAASIntegrationSettings(ctx context.Context, integrationTyp
 
 	request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationSettings: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationSettings: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-integration-settings")
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("ListDBAASIntegrationSettings: execute request editors: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dbaas-integration-settings", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASIntegrationSettings: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDBAASIntegrationSettings: http response: %w", err)
 	}
@@ -4191,6 +4169,10 @@ func (c Client) ListDBAASIntegrationSettings(ctx context.Context, integrationTyp
 		return nil, fmt.Errorf("ListDBAASIntegrationSettings: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASIntegrationSettings: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4213,23 +4195,11 @@ func (c Client) ListDBAASIntegrationTypes(ctx context.Context) (*ListDBAASIntegr
 		return nil, fmt.Errorf("ListDBAASIntegrationTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASIntegrationTypes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-integration-types")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dbaas-integration-types", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASIntegrationTypes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDBAASIntegrationTypes: http response: %w", err)
 	}
@@ -4239,9 +4209,32 @@ func (c Client) ListDBAASIntegrationTypes(ctx context.Context) (*ListDBAASIntegr
 		return nil, fmt.Errorf("ListDBAASIntegrationTypes: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASIntegrationTypes: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDBAASIntegrationTypes returns an iterator over the DBAASIntegrationType items returned by ListDBAASIntegrationTypes.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDBAASIntegrationTypes(ctx context.Context) iter.Seq2[DBAASIntegrationType, error] {
+	return func(yield func(DBAASIntegrationType, error) bool) {
+		resp, err := c.ListDBAASIntegrationTypes(ctx)
+		if err != nil {
+			var zero DBAASIntegrationType
+			yield(zero, fmt.Errorf("AllDBAASIntegrationTypes: %w", err))
+			return
+		}
+
+		for _, item := range resp.DBAASIntegrationTypes {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // [BETA] Delete a DBaaS Integration
 func (c Client) DeleteDBAASIntegration(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-integration/%v", id)
@@ -4257,23 +4250,11 @@ func (c Client) DeleteDBAASIntegration(ctx context.Context, id UUID) (*Operation
 		return nil, fmt.Errorf("DeleteDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-integration", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASIntegration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASIntegration: http response: %w", err)
 	}
@@ -4283,6 +4264,10 @@ func (c Client) DeleteDBAASIntegration(ctx context.Context, id UUID) (*Operation
 		return nil, fmt.Errorf("DeleteDBAASIntegration: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASIntegration: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4301,23 +4286,11 @@ func (c Client) GetDBAASIntegration(ctx context.Context, id UUID) (*DBAASIntegra
 		return nil, fmt.Errorf("GetDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-integration", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASIntegration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASIntegration: http response: %w", err)
 	}
@@ -4327,6 +4300,10 @@ func (c Client) GetDBAASIntegration(ctx context.Context, id UUID) (*DBAASIntegra
 		return nil, fmt.Errorf("GetDBAASIntegration: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASIntegration: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4339,6 +4316,10 @@ type UpdateDBAASIntegrationRequest struct {
 func (c Client) UpdateDBAASIntegration(ctx context.Context, id UUID, req UpdateDBAASIntegrationRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-integration/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASIntegration: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASIntegration: prepare Json body: %w", err)
@@ -4357,23 +4338,11 @@ func (c Client) UpdateDBAASIntegration(ctx context.Context, id UUID, req UpdateD
 		return nil, fmt.Errorf("UpdateDBAASIntegration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASIntegration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-integration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-integration", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASIntegration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASIntegration: http response: %w", err)
 	}
@@ -4383,9 +4352,29 @@ func (c Client) UpdateDBAASIntegration(ctx context.Context, id UUID, req UpdateD
 		return nil, fmt.Errorf("UpdateDBAASIntegration: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASIntegration: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateDBAASIntegrationAndWait calls UpdateDBAASIntegration, waits for the resulting operation to succeed
+// and returns the DBAASIntegration referenced by the operation.
+func (c Client) UpdateDBAASIntegrationAndWait(ctx context.Context, id UUID, req UpdateDBAASIntegrationRequest, opts ...WaitOpt) (*DBAASIntegration, error) {
+	op, err := c.UpdateDBAASIntegration(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dbaas-integration", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateDBAASIntegrationAndWait: %w", err)
+	}
+
+	return c.GetDBAASIntegration(ctx, ref.ID)
+}
+
 func (c Client) DeleteDBAASServiceKafka(ctx context.Context, name string) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-kafka/%v", name)
 
@@ -4400,23 +4389,11 @@ func (c Client) DeleteDBAASServiceKafka(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("DeleteDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-service-kafka", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceKafka: http response: %w", err)
 	}
@@ -4426,6 +4403,10 @@ func (c Client) DeleteDBAASServiceKafka(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("DeleteDBAASServiceKafka: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceKafka: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4443,23 +4424,11 @@ func (c Client) GetDBAASServiceKafka(ctx context.Context, name string) (*DBAASSe
 		return nil, fmt.Errorf("GetDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-kafka", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceKafka: http response: %w", err)
 	}
@@ -4469,6 +4438,10 @@ func (c Client) GetDBAASServiceKafka(ctx context.Context, name string) (*DBAASSe
 		return nil, fmt.Errorf("GetDBAASServiceKafka: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceKafka: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4534,6 +4507,10 @@ type CreateDBAASServiceKafkaRequest struct {
 func (c Client) CreateDBAASServiceKafka(ctx context.Context, name string, req CreateDBAASServiceKafkaRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-kafka/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceKafka: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceKafka: prepare Json body: %w", err)
@@ -4552,23 +4529,11 @@ func (c Client) CreateDBAASServiceKafka(ctx context.Context, name string, req Cr
 		return nil, fmt.Errorf("CreateDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-service-kafka", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceKafka: http response: %w", err)
 	}
@@ -4578,6 +4543,10 @@ func (c Client) CreateDBAASServiceKafka(ctx context.Context, name string, req Cr
 		return nil, fmt.Errorf("CreateDBAASServiceKafka: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceKafka: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4643,6 +4612,10 @@ type UpdateDBAASServiceKafkaRequest struct {
 func (c Client) UpdateDBAASServiceKafka(ctx context.Context, name string, req UpdateDBAASServiceKafkaRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-kafka/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceKafka: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceKafka: prepare Json body: %w", err)
@@ -4661,23 +4634,11 @@ func (c Client) UpdateDBAASServiceKafka(ctx context.Context, name string, req Up
 		return nil, fmt.Errorf("UpdateDBAASServiceKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-service-kafka", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceKafka: http response: %w", err)
 	}
@@ -4687,6 +4648,10 @@ func (c Client) UpdateDBAASServiceKafka(ctx context.Context, name string, req Up
 		return nil, fmt.Errorf("UpdateDBAASServiceKafka: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceKafka: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4704,23 +4669,11 @@ func (c Client) GetDBAASKafkaAclConfig(ctx context.Context, name string) (*DBAAS
 		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-kafka-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-kafka-acl-config", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: http response: %w", err)
 	}
@@ -4730,6 +4683,10 @@ func (c Client) GetDBAASKafkaAclConfig(ctx context.Context, name string) (*DBAAS
 		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASKafkaAclConfig: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4747,23 +4704,11 @@ func (c Client) StartDBAASKafkaMaintenance(ctx context.Context, name string) (*O
 		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-kafka-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "start-dbaas-kafka-maintenance", request)
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: http response: %w", err)
 	}
@@ -4773,12 +4718,20 @@ func (c Client) StartDBAASKafkaMaintenance(ctx context.Context, name string) (*O
 		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASKafkaMaintenance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
 func (c Client) CreateDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, name string, req DBAASKafkaSchemaRegistryAclEntry) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-kafka/%v/schema-registry/acl-config", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: prepare Json body: %w", err)
@@ -4797,23 +4750,11 @@ func (c Client) CreateDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, nam
 		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-kafka-schema-registry-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-kafka-schema-registry-acl-config", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: http response: %w", err)
 	}
@@ -4823,6 +4764,10 @@ func (c Client) CreateDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, nam
 		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASKafkaSchemaRegistryAclConfig: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4840,23 +4785,11 @@ func (c Client) DeleteDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, nam
 		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-kafka-schema-registry-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-kafka-schema-registry-acl-config", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: http response: %w", err)
 	}
@@ -4866,12 +4799,20 @@ func (c Client) DeleteDBAASKafkaSchemaRegistryAclConfig(ctx context.Context, nam
 		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASKafkaSchemaRegistryAclConfig: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
 func (c Client) CreateDBAASKafkaTopicAclConfig(ctx context.Context, name string, req DBAASKafkaTopicAclEntry) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-kafka/%v/topic/acl-config", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: prepare Json body: %w", err)
@@ -4890,23 +4831,11 @@ func (c Client) CreateDBAASKafkaTopicAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-kafka-topic-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-kafka-topic-acl-config", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: http response: %w", err)
 	}
@@ -4916,6 +4845,10 @@ func (c Client) CreateDBAASKafkaTopicAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASKafkaTopicAclConfig: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4933,23 +4866,11 @@ func (c Client) DeleteDBAASKafkaTopicAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-kafka-topic-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-kafka-topic-acl-config", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: http response: %w", err)
 	}
@@ -4959,6 +4880,10 @@ func (c Client) DeleteDBAASKafkaTopicAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASKafkaTopicAclConfig: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -4976,23 +4901,11 @@ func (c Client) RevealDBAASKafkaConnectPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-kafka-connect-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-dbaas-kafka-connect-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: http response: %w", err)
 	}
@@ -5002,6 +4915,10 @@ func (c Client) RevealDBAASKafkaConnectPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASKafkaConnectPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5012,6 +4929,10 @@ type CreateDBAASKafkaUserRequest struct {
 func (c Client) CreateDBAASKafkaUser(ctx context.Context, serviceName string, req CreateDBAASKafkaUserRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-kafka/%v/user", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASKafkaUser: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaUser: prepare Json body: %w", err)
@@ -5030,23 +4951,11 @@ func (c Client) CreateDBAASKafkaUser(ctx context.Context, serviceName string, re
 		return nil, fmt.Errorf("CreateDBAASKafkaUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASKafkaUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-kafka-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-kafka-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASKafkaUser: http response: %w", err)
 	}
@@ -5056,6 +4965,10 @@ func (c Client) CreateDBAASKafkaUser(ctx context.Context, serviceName string, re
 		return nil, fmt.Errorf("CreateDBAASKafkaUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASKafkaUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5073,23 +4986,11 @@ func (c Client) DeleteDBAASKafkaUser(ctx context.Context, serviceName string, us
 		return nil, fmt.Errorf("DeleteDBAASKafkaUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASKafkaUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-kafka-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-kafka-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASKafkaUser: http response: %w", err)
 	}
@@ -5099,6 +5000,10 @@ func (c Client) DeleteDBAASKafkaUser(ctx context.Context, serviceName string, us
 		return nil, fmt.Errorf("DeleteDBAASKafkaUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASKafkaUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5110,6 +5015,10 @@ type ResetDBAASKafkaUserPasswordRequest struct {
 func (c Client) ResetDBAASKafkaUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASKafkaUserPasswordRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-kafka/%v/user/%v/password/reset", serviceName, username)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: prepare Json body: %w", err)
@@ -5128,23 +5037,11 @@ func (c Client) ResetDBAASKafkaUserPassword(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-kafka-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-dbaas-kafka-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: http response: %w", err)
 	}
@@ -5154,6 +5051,10 @@ func (c Client) ResetDBAASKafkaUserPassword(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASKafkaUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5171,23 +5072,11 @@ func (c Client) RevealDBAASKafkaUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-kafka-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-dbaas-kafka-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: http response: %w", err)
 	}
@@ -5197,6 +5086,10 @@ func (c Client) RevealDBAASKafkaUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASKafkaUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5215,23 +5108,11 @@ func (c Client) GetDBAASMigrationStatus(ctx context.Context, name string) (*DBAA
 		return nil, fmt.Errorf("GetDBAASMigrationStatus: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASMigrationStatus: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-migration-status")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-migration-status", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASMigrationStatus: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASMigrationStatus: http response: %w", err)
 	}
@@ -5241,6 +5122,10 @@ func (c Client) GetDBAASMigrationStatus(ctx context.Context, name string) (*DBAA
 		return nil, fmt.Errorf("GetDBAASMigrationStatus: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASMigrationStatus: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5258,23 +5143,11 @@ func (c Client) DeleteDBAASServiceMysql(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("DeleteDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-service-mysql", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceMysql: http response: %w", err)
 	}
@@ -5284,6 +5157,10 @@ func (c Client) DeleteDBAASServiceMysql(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("DeleteDBAASServiceMysql: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceMysql: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5302,23 +5179,11 @@ func (c Client) GetDBAASServiceMysql(ctx context.Context, name string) (*DBAASSe
 		return nil, fmt.Errorf("GetDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-mysql", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceMysql: http response: %w", err)
 	}
@@ -5328,6 +5193,10 @@ func (c Client) GetDBAASServiceMysql(ctx context.Context, name string) (*DBAASSe
 		return nil, fmt.Errorf("GetDBAASServiceMysql: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceMysql: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5425,6 +5294,10 @@ type CreateDBAASServiceMysqlRequest struct {
 func (c Client) CreateDBAASServiceMysql(ctx context.Context, name string, req CreateDBAASServiceMysqlRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-mysql/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceMysql: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceMysql: prepare Json body: %w", err)
@@ -5443,23 +5316,11 @@ func (c Client) CreateDBAASServiceMysql(ctx context.Context, name string, req Cr
 		return nil, fmt.Errorf("CreateDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-service-mysql", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceMysql: http response: %w", err)
 	}
@@ -5469,6 +5330,10 @@ func (c Client) CreateDBAASServiceMysql(ctx context.Context, name string, req Cr
 		return nil, fmt.Errorf("CreateDBAASServiceMysql: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceMysql: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5541,6 +5406,10 @@ type UpdateDBAASServiceMysqlRequest struct {
 func (c Client) UpdateDBAASServiceMysql(ctx context.Context, name string, req UpdateDBAASServiceMysqlRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-mysql/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceMysql: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceMysql: prepare Json body: %w", err)
@@ -5559,23 +5428,11 @@ func (c Client) UpdateDBAASServiceMysql(ctx context.Context, name string, req Up
 		return nil, fmt.Errorf("UpdateDBAASServiceMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-service-mysql", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceMysql: http response: %w", err)
 	}
@@ -5585,6 +5442,10 @@ func (c Client) UpdateDBAASServiceMysql(ctx context.Context, name string, req Up
 		return nil, fmt.Errorf("UpdateDBAASServiceMysql: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceMysql: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5602,23 +5463,11 @@ func (c Client) EnableDBAASMysqlWrites(ctx context.Context, name string) (*Opera
 		return nil, fmt.Errorf("EnableDBAASMysqlWrites: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EnableDBAASMysqlWrites: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "enable-dbaas-mysql-writes")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "enable-dbaas-mysql-writes", request)
 	if err != nil {
 		return nil, fmt.Errorf("EnableDBAASMysqlWrites: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("EnableDBAASMysqlWrites: http response: %w", err)
 	}
@@ -5628,6 +5477,10 @@ func (c Client) EnableDBAASMysqlWrites(ctx context.Context, name string) (*Opera
 		return nil, fmt.Errorf("EnableDBAASMysqlWrites: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("EnableDBAASMysqlWrites: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5645,23 +5498,11 @@ func (c Client) StartDBAASMysqlMaintenance(ctx context.Context, name string) (*O
 		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-mysql-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "start-dbaas-mysql-maintenance", request)
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: http response: %w", err)
 	}
@@ -5671,6 +5512,10 @@ func (c Client) StartDBAASMysqlMaintenance(ctx context.Context, name string) (*O
 		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASMysqlMaintenance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5688,23 +5533,11 @@ func (c Client) StopDBAASMysqlMigration(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("StopDBAASMysqlMigration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopDBAASMysqlMigration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-dbaas-mysql-migration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "stop-dbaas-mysql-migration", request)
 	if err != nil {
 		return nil, fmt.Errorf("StopDBAASMysqlMigration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StopDBAASMysqlMigration: http response: %w", err)
 	}
@@ -5714,6 +5547,10 @@ func (c Client) StopDBAASMysqlMigration(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("StopDBAASMysqlMigration: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StopDBAASMysqlMigration: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5724,6 +5561,10 @@ type CreateDBAASMysqlDatabaseRequest struct {
 func (c Client) CreateDBAASMysqlDatabase(ctx context.Context, serviceName string, req CreateDBAASMysqlDatabaseRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-mysql/%v/database", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: prepare Json body: %w", err)
@@ -5742,23 +5583,11 @@ func (c Client) CreateDBAASMysqlDatabase(ctx context.Context, serviceName string
 		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-mysql-database")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-mysql-database", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: http response: %w", err)
 	}
@@ -5768,6 +5597,10 @@ func (c Client) CreateDBAASMysqlDatabase(ctx context.Context, serviceName string
 		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASMysqlDatabase: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5785,23 +5618,11 @@ func (c Client) DeleteDBAASMysqlDatabase(ctx context.Context, serviceName string
 		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-mysql-database")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-mysql-database", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: http response: %w", err)
 	}
@@ -5811,6 +5632,10 @@ func (c Client) DeleteDBAASMysqlDatabase(ctx context.Context, serviceName string
 		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASMysqlDatabase: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5822,6 +5647,10 @@ type CreateDBAASMysqlUserRequest struct {
 func (c Client) CreateDBAASMysqlUser(ctx context.Context, serviceName string, req CreateDBAASMysqlUserRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-mysql/%v/user", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASMysqlUser: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlUser: prepare Json body: %w", err)
@@ -5840,23 +5669,11 @@ func (c Client) CreateDBAASMysqlUser(ctx context.Context, serviceName string, re
 		return nil, fmt.Errorf("CreateDBAASMysqlUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASMysqlUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-mysql-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-mysql-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASMysqlUser: http response: %w", err)
 	}
@@ -5866,6 +5683,10 @@ func (c Client) CreateDBAASMysqlUser(ctx context.Context, serviceName string, re
 		return nil, fmt.Errorf("CreateDBAASMysqlUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASMysqlUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5883,23 +5704,11 @@ func (c Client) DeleteDBAASMysqlUser(ctx context.Context, serviceName string, us
 		return nil, fmt.Errorf("DeleteDBAASMysqlUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASMysqlUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-mysql-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-mysql-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASMysqlUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASMysqlUser: http response: %w", err)
 	}
@@ -5909,6 +5718,10 @@ func (c Client) DeleteDBAASMysqlUser(ctx context.Context, serviceName string, us
 		return nil, fmt.Errorf("DeleteDBAASMysqlUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASMysqlUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5921,6 +5734,10 @@ type ResetDBAASMysqlUserPasswordRequest struct {
 func (c Client) ResetDBAASMysqlUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASMysqlUserPasswordRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-mysql/%v/user/%v/password/reset", serviceName, username)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: prepare Json body: %w", err)
@@ -5939,23 +5756,11 @@ func (c Client) ResetDBAASMysqlUserPassword(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-mysql-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-dbaas-mysql-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: http response: %w", err)
 	}
@@ -5965,6 +5770,10 @@ func (c Client) ResetDBAASMysqlUserPassword(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASMysqlUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -5982,23 +5791,11 @@ func (c Client) RevealDBAASMysqlUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-mysql-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-dbaas-mysql-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: http response: %w", err)
 	}
@@ -6008,6 +5805,10 @@ func (c Client) RevealDBAASMysqlUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASMysqlUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6025,23 +5826,11 @@ func (c Client) DeleteDBAASServiceOpensearch(ctx context.Context, name string) (
 		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-service-opensearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: http response: %w", err)
 	}
@@ -6051,6 +5840,10 @@ func (c Client) DeleteDBAASServiceOpensearch(ctx context.Context, name string) (
 		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceOpensearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6069,23 +5862,11 @@ func (c Client) GetDBAASServiceOpensearch(ctx context.Context, name string) (*DB
 		return nil, fmt.Errorf("GetDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-opensearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceOpensearch: http response: %w", err)
 	}
@@ -6095,6 +5876,10 @@ func (c Client) GetDBAASServiceOpensearch(ctx context.Context, name string) (*DB
 		return nil, fmt.Errorf("GetDBAASServiceOpensearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceOpensearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6187,6 +5972,10 @@ type CreateDBAASServiceOpensearchRequest struct {
 func (c Client) CreateDBAASServiceOpensearch(ctx context.Context, name string, req CreateDBAASServiceOpensearchRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-opensearch/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: prepare Json body: %w", err)
@@ -6205,23 +5994,11 @@ func (c Client) CreateDBAASServiceOpensearch(ctx context.Context, name string, r
 		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-service-opensearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: http response: %w", err)
 	}
@@ -6231,6 +6008,10 @@ func (c Client) CreateDBAASServiceOpensearch(ctx context.Context, name string, r
 		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceOpensearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6320,6 +6101,10 @@ type UpdateDBAASServiceOpensearchRequest struct {
 func (c Client) UpdateDBAASServiceOpensearch(ctx context.Context, name string, req UpdateDBAASServiceOpensearchRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-opensearch/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: prepare Json body: %w", err)
@@ -6338,23 +6123,11 @@ func (c Client) UpdateDBAASServiceOpensearch(ctx context.Context, name string, r
 		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-service-opensearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: http response: %w", err)
 	}
@@ -6364,6 +6137,10 @@ func (c Client) UpdateDBAASServiceOpensearch(ctx context.Context, name string, r
 		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceOpensearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6381,23 +6158,11 @@ func (c Client) GetDBAASOpensearchAclConfig(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-opensearch-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-opensearch-acl-config", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: http response: %w", err)
 	}
@@ -6407,12 +6172,20 @@ func (c Client) GetDBAASOpensearchAclConfig(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASOpensearchAclConfig: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
 func (c Client) UpdateDBAASOpensearchAclConfig(ctx context.Context, name string, req DBAASOpensearchAclConfig) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-opensearch/%v/acl-config", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: prepare Json body: %w", err)
@@ -6431,23 +6204,11 @@ func (c Client) UpdateDBAASOpensearchAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-opensearch-acl-config")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-opensearch-acl-config", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: http response: %w", err)
 	}
@@ -6457,6 +6218,10 @@ func (c Client) UpdateDBAASOpensearchAclConfig(ctx context.Context, name string,
 		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASOpensearchAclConfig: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6474,23 +6239,11 @@ func (c Client) StartDBAASOpensearchMaintenance(ctx context.Context, name string
 		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-opensearch-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "start-dbaas-opensearch-maintenance", request)
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: http response: %w", err)
 	}
@@ -6500,6 +6253,10 @@ func (c Client) StartDBAASOpensearchMaintenance(ctx context.Context, name string
 		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASOpensearchMaintenance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6510,6 +6267,10 @@ type CreateDBAASOpensearchUserRequest struct {
 func (c Client) CreateDBAASOpensearchUser(ctx context.Context, serviceName string, req CreateDBAASOpensearchUserRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-opensearch/%v/user", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASOpensearchUser: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASOpensearchUser: prepare Json body: %w", err)
@@ -6528,23 +6289,11 @@ func (c Client) CreateDBAASOpensearchUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("CreateDBAASOpensearchUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASOpensearchUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-opensearch-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-opensearch-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASOpensearchUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASOpensearchUser: http response: %w", err)
 	}
@@ -6554,6 +6303,10 @@ func (c Client) CreateDBAASOpensearchUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("CreateDBAASOpensearchUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASOpensearchUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6571,23 +6324,11 @@ func (c Client) DeleteDBAASOpensearchUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-opensearch-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-opensearch-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: http response: %w", err)
 	}
@@ -6597,6 +6338,10 @@ func (c Client) DeleteDBAASOpensearchUser(ctx context.Context, serviceName strin
 		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASOpensearchUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6608,6 +6353,10 @@ type ResetDBAASOpensearchUserPasswordRequest struct {
 func (c Client) ResetDBAASOpensearchUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASOpensearchUserPasswordRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-opensearch/%v/user/%v/password/reset", serviceName, username)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: prepare Json body: %w", err)
@@ -6626,23 +6375,11 @@ func (c Client) ResetDBAASOpensearchUserPassword(ctx context.Context, serviceNam
 		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-opensearch-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-dbaas-opensearch-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: http response: %w", err)
 	}
@@ -6652,6 +6389,10 @@ func (c Client) ResetDBAASOpensearchUserPassword(ctx context.Context, serviceNam
 		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASOpensearchUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6669,23 +6410,11 @@ func (c Client) RevealDBAASOpensearchUserPassword(ctx context.Context, serviceNa
 		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-opensearch-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-dbaas-opensearch-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: http response: %w", err)
 	}
@@ -6695,6 +6424,10 @@ func (c Client) RevealDBAASOpensearchUserPassword(ctx context.Context, serviceNa
 		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASOpensearchUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6712,23 +6445,11 @@ func (c Client) DeleteDBAASServicePG(ctx context.Context, name string) (*Operati
 		return nil, fmt.Errorf("DeleteDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServicePG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-service-pg", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServicePG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServicePG: http response: %w", err)
 	}
@@ -6738,6 +6459,10 @@ func (c Client) DeleteDBAASServicePG(ctx context.Context, name string) (*Operati
 		return nil, fmt.Errorf("DeleteDBAASServicePG: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServicePG: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6756,23 +6481,11 @@ func (c Client) GetDBAASServicePG(ctx context.Context, name string) (*DBAASServi
 		return nil, fmt.Errorf("GetDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServicePG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-pg", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServicePG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServicePG: http response: %w", err)
 	}
@@ -6782,6 +6495,10 @@ func (c Client) GetDBAASServicePG(ctx context.Context, name string) (*DBAASServi
 		return nil, fmt.Errorf("GetDBAASServicePG: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServicePG: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -6888,6 +6605,10 @@ type CreateDBAASServicePGRequest struct {
 func (c Client) CreateDBAASServicePG(ctx context.Context, name string, req CreateDBAASServicePGRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-postgres/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServicePG: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServicePG: prepare Json body: %w", err)
@@ -6906,23 +6627,11 @@ func (c Client) CreateDBAASServicePG(ctx context.Context, name string, req Creat
 		return nil, fmt.Errorf("CreateDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServicePG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-service-pg", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServicePG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServicePG: http response: %w", err)
 	}
@@ -6932,6 +6641,10 @@ func (c Client) CreateDBAASServicePG(ctx context.Context, name string, req Creat
 		return nil, fmt.Errorf("CreateDBAASServicePG: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServicePG: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7016,6 +6729,10 @@ type UpdateDBAASServicePGRequest struct {
 func (c Client) UpdateDBAASServicePG(ctx context.Context, name string, req UpdateDBAASServicePGRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-postgres/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServicePG: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServicePG: prepare Json body: %w", err)
@@ -7034,23 +6751,11 @@ func (c Client) UpdateDBAASServicePG(ctx context.Context, name string, req Updat
 		return nil, fmt.Errorf("UpdateDBAASServicePG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServicePG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-service-pg", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServicePG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServicePG: http response: %w", err)
 	}
@@ -7060,6 +6765,10 @@ func (c Client) UpdateDBAASServicePG(ctx context.Context, name string, req Updat
 		return nil, fmt.Errorf("UpdateDBAASServicePG: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServicePG: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7077,23 +6786,11 @@ func (c Client) StartDBAASPGMaintenance(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("StartDBAASPGMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASPGMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-pg-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "start-dbaas-pg-maintenance", request)
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASPGMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StartDBAASPGMaintenance: http response: %w", err)
 	}
@@ -7103,6 +6800,10 @@ func (c Client) StartDBAASPGMaintenance(ctx context.Context, name string) (*Oper
 		return nil, fmt.Errorf("StartDBAASPGMaintenance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASPGMaintenance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7120,23 +6821,11 @@ func (c Client) StopDBAASPGMigration(ctx context.Context, name string) (*Operati
 		return nil, fmt.Errorf("StopDBAASPGMigration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopDBAASPGMigration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-dbaas-pg-migration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "stop-dbaas-pg-migration", request)
 	if err != nil {
 		return nil, fmt.Errorf("StopDBAASPGMigration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StopDBAASPGMigration: http response: %w", err)
 	}
@@ -7146,6 +6835,10 @@ func (c Client) StopDBAASPGMigration(ctx context.Context, name string) (*Operati
 		return nil, fmt.Errorf("StopDBAASPGMigration: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StopDBAASPGMigration: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7161,6 +6854,10 @@ type CreateDBAASPGConnectionPoolRequest struct {
 func (c Client) CreateDBAASPGConnectionPool(ctx context.Context, serviceName string, req CreateDBAASPGConnectionPoolRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-postgres/%v/connection-pool", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: prepare Json body: %w", err)
@@ -7179,23 +6876,11 @@ func (c Client) CreateDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-pg-connection-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-pg-connection-pool", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: http response: %w", err)
 	}
@@ -7205,6 +6890,10 @@ func (c Client) CreateDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPGConnectionPool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7223,23 +6912,11 @@ func (c Client) DeleteDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-pg-connection-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-pg-connection-pool", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: http response: %w", err)
 	}
@@ -7249,6 +6926,10 @@ func (c Client) DeleteDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASPGConnectionPool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7263,6 +6944,10 @@ type UpdateDBAASPGConnectionPoolRequest struct {
 func (c Client) UpdateDBAASPGConnectionPool(ctx context.Context, serviceName string, connectionPoolName string, req UpdateDBAASPGConnectionPoolRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-postgres/%v/connection-pool/%v", serviceName, connectionPoolName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: prepare Json body: %w", err)
@@ -7281,23 +6966,11 @@ func (c Client) UpdateDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-pg-connection-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-pg-connection-pool", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: http response: %w", err)
 	}
@@ -7307,6 +6980,10 @@ func (c Client) UpdateDBAASPGConnectionPool(ctx context.Context, serviceName str
 		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASPGConnectionPool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7321,6 +6998,10 @@ type CreateDBAASPGDatabaseRequest struct {
 func (c Client) CreateDBAASPGDatabase(ctx context.Context, serviceName string, req CreateDBAASPGDatabaseRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-postgres/%v/database", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPGDatabase: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGDatabase: prepare Json body: %w", err)
@@ -7339,23 +7020,11 @@ func (c Client) CreateDBAASPGDatabase(ctx context.Context, serviceName string, r
 		return nil, fmt.Errorf("CreateDBAASPGDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-pg-database")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-pg-database", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGDatabase: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGDatabase: http response: %w", err)
 	}
@@ -7365,6 +7034,10 @@ func (c Client) CreateDBAASPGDatabase(ctx context.Context, serviceName string, r
 		return nil, fmt.Errorf("CreateDBAASPGDatabase: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPGDatabase: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7382,23 +7055,11 @@ func (c Client) DeleteDBAASPGDatabase(ctx context.Context, serviceName string, d
 		return nil, fmt.Errorf("DeleteDBAASPGDatabase: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPGDatabase: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-pg-database")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-pg-database", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPGDatabase: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPGDatabase: http response: %w", err)
 	}
@@ -7408,6 +7069,10 @@ func (c Client) DeleteDBAASPGDatabase(ctx context.Context, serviceName string, d
 		return nil, fmt.Errorf("DeleteDBAASPGDatabase: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASPGDatabase: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7419,6 +7084,10 @@ type CreateDBAASPostgresUserRequest struct {
 func (c Client) CreateDBAASPostgresUser(ctx context.Context, serviceName string, req CreateDBAASPostgresUserRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-postgres/%v/user", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPostgresUser: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPostgresUser: prepare Json body: %w", err)
@@ -7433,27 +7102,15 @@ func (c Client) CreateDBAASPostgresUser(ctx context.Context, serviceName string,
 
 	request.Header.Add("Content-Type", "application/json")
 
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPostgresUser: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPostgresUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-postgres-user")
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPostgresUser: execute request editors: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-postgres-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPostgresUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASPostgresUser: http response: %w", err)
 	}
@@ -7463,6 +7120,10 @@ func (c Client) CreateDBAASPostgresUser(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("CreateDBAASPostgresUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPostgresUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7480,23 +7141,11 @@ func (c Client) DeleteDBAASPostgresUser(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("DeleteDBAASPostgresUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASPostgresUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-postgres-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-postgres-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPostgresUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASPostgresUser: http response: %w", err)
 	}
@@ -7506,6 +7155,10 @@ func (c Client) DeleteDBAASPostgresUser(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("DeleteDBAASPostgresUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASPostgresUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7516,6 +7169,10 @@ type UpdateDBAASPostgresAllowReplicationRequest struct {
 func (c Client) UpdateDBAASPostgresAllowReplication(ctx context.Context, serviceName string, username string, req UpdateDBAASPostgresAllowReplicationRequest) (*DBAASPostgresUsers, error) {
 	path := fmt.Sprintf("/dbaas-postgres/%v/user/%v/allow-replication", serviceName, username)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: prepare Json body: %w", err)
@@ -7534,23 +7191,11 @@ func (c Client) UpdateDBAASPostgresAllowReplication(ctx context.Context, service
 		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-postgres-allow-replication")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-postgres-allow-replication", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: http response: %w", err)
 	}
@@ -7560,6 +7205,10 @@ func (c Client) UpdateDBAASPostgresAllowReplication(ctx context.Context, service
 		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASPostgresAllowReplication: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7571,6 +7220,10 @@ type ResetDBAASPostgresUserPasswordRequest struct {
 func (c Client) ResetDBAASPostgresUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASPostgresUserPasswordRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-postgres/%v/user/%v/password/reset", serviceName, username)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: prepare Json body: %w", err)
@@ -7589,23 +7242,11 @@ func (c Client) ResetDBAASPostgresUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-postgres-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-dbaas-postgres-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: http response: %w", err)
 	}
@@ -7615,6 +7256,10 @@ func (c Client) ResetDBAASPostgresUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASPostgresUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7632,23 +7277,11 @@ func (c Client) RevealDBAASPostgresUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-postgres-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-dbaas-postgres-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: http response: %w", err)
 	}
@@ -7658,6 +7291,10 @@ func (c Client) RevealDBAASPostgresUserPassword(ctx context.Context, serviceName
 		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASPostgresUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7669,6 +7306,10 @@ type CreateDBAASPGUpgradeCheckRequest struct {
 func (c Client) CreateDBAASPGUpgradeCheck(ctx context.Context, service string, req CreateDBAASPGUpgradeCheckRequest) (*DBAASTask, error) {
 	path := fmt.Sprintf("/dbaas-postgres/%v/upgrade-check", service)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: prepare Json body: %w", err)
@@ -7687,23 +7328,11 @@ func (c Client) CreateDBAASPGUpgradeCheck(ctx context.Context, service string, r
 		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-pg-upgrade-check")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-pg-upgrade-check", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: http response: %w", err)
 	}
@@ -7713,6 +7342,10 @@ func (c Client) CreateDBAASPGUpgradeCheck(ctx context.Context, service string, r
 		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASPGUpgradeCheck: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7754,23 +7387,11 @@ func (c Client) ListDBAASServices(ctx context.Context) (*ListDBAASServicesRespon
 		return nil, fmt.Errorf("ListDBAASServices: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASServices: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-services")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dbaas-services", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASServices: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDBAASServices: http response: %w", err)
 	}
@@ -7780,9 +7401,32 @@ func (c Client) ListDBAASServices(ctx context.Context) (*ListDBAASServicesRespon
 		return nil, fmt.Errorf("ListDBAASServices: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASServices: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDBAASServices returns an iterator over the DBAASServiceCommon items returned by ListDBAASServices.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDBAASServices(ctx context.Context) iter.Seq2[DBAASServiceCommon, error] {
+	return func(yield func(DBAASServiceCommon, error) bool) {
+		resp, err := c.ListDBAASServices(ctx)
+		if err != nil {
+			var zero DBAASServiceCommon
+			yield(zero, fmt.Errorf("AllDBAASServices: %w", err))
+			return
+		}
+
+		for _, item := range resp.DBAASServices {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type GetDBAASServiceLogsRequest struct {
 	// How many log entries to receive at most, up to 500 (default: 100)
 	Limit int64 `json:"limit,omitempty" validate:"omitempty,gte=1,lte=500"`
@@ -7795,6 +7439,10 @@ type GetDBAASServiceLogsRequest struct {
 func (c Client) GetDBAASServiceLogs(ctx context.Context, serviceName string, req GetDBAASServiceLogsRequest) (*DBAASServiceLogs, error) {
 	path := fmt.Sprintf("/dbaas-service-logs/%v", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceLogs: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceLogs: prepare Json body: %w", err)
@@ -7813,23 +7461,11 @@ func (c Client) GetDBAASServiceLogs(ctx context.Context, serviceName string, req
 		return nil, fmt.Errorf("GetDBAASServiceLogs: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceLogs: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-logs")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-logs", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceLogs: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceLogs: http response: %w", err)
 	}
@@ -7839,6 +7475,10 @@ func (c Client) GetDBAASServiceLogs(ctx context.Context, serviceName string, req
 		return nil, fmt.Errorf("GetDBAASServiceLogs: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceLogs: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7865,6 +7505,10 @@ type GetDBAASServiceMetricsRequest struct {
 func (c Client) GetDBAASServiceMetrics(ctx context.Context, serviceName string, req GetDBAASServiceMetricsRequest) (*GetDBAASServiceMetricsResponse, error) {
 	path := fmt.Sprintf("/dbaas-service-metrics/%v", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceMetrics: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceMetrics: prepare Json body: %w", err)
@@ -7883,23 +7527,11 @@ func (c Client) GetDBAASServiceMetrics(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("GetDBAASServiceMetrics: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceMetrics: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-metrics")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-metrics", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceMetrics: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceMetrics: http response: %w", err)
 	}
@@ -7909,6 +7541,10 @@ func (c Client) GetDBAASServiceMetrics(ctx context.Context, serviceName string,
 		return nil, fmt.Errorf("GetDBAASServiceMetrics: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceMetrics: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -7950,23 +7586,11 @@ func (c Client) ListDBAASServiceTypes(ctx context.Context) (*ListDBAASServiceTyp
 		return nil, fmt.Errorf("ListDBAASServiceTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASServiceTypes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-service-types")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dbaas-service-types", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASServiceTypes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDBAASServiceTypes: http response: %w", err)
 	}
@@ -7976,9 +7600,32 @@ func (c Client) ListDBAASServiceTypes(ctx context.Context) (*ListDBAASServiceTyp
 		return nil, fmt.Errorf("ListDBAASServiceTypes: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASServiceTypes: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDBAASServiceTypes returns an iterator over the DBAASServiceType items returned by ListDBAASServiceTypes.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDBAASServiceTypes(ctx context.Context) iter.Seq2[DBAASServiceType, error] {
+	return func(yield func(DBAASServiceType, error) bool) {
+		resp, err := c.ListDBAASServiceTypes(ctx)
+		if err != nil {
+			var zero DBAASServiceType
+			yield(zero, fmt.Errorf("AllDBAASServiceTypes: %w", err))
+			return
+		}
+
+		for _, item := range resp.DBAASServiceTypes {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Get a DBaaS service type
 func (c Client) GetDBAASServiceType(ctx context.Context, serviceTypeName string) (*DBAASServiceType, error) {
 	path := fmt.Sprintf("/dbaas-service-type/%v", serviceTypeName)
@@ -7994,23 +7641,11 @@ func (c Client) GetDBAASServiceType(ctx context.Context, serviceTypeName string)
 		return nil, fmt.Errorf("GetDBAASServiceType: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceType: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-type")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-type", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceType: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceType: http response: %w", err)
 	}
@@ -8020,6 +7655,10 @@ func (c Client) GetDBAASServiceType(ctx context.Context, serviceTypeName string)
 		return nil, fmt.Errorf("GetDBAASServiceType: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceType: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8038,23 +7677,11 @@ func (c Client) DeleteDBAASService(ctx context.Context, name string) (*Operation
 		return nil, fmt.Errorf("DeleteDBAASService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-service", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASService: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASService: http response: %w", err)
 	}
@@ -8064,6 +7691,10 @@ func (c Client) DeleteDBAASService(ctx context.Context, name string) (*Operation
 		return nil, fmt.Errorf("DeleteDBAASService: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASService: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8099,23 +7730,11 @@ func (c Client) GetDBAASSettingsGrafana(ctx context.Context) (*GetDBAASSettingsG
 		return nil, fmt.Errorf("GetDBAASSettingsGrafana: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsGrafana: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-grafana")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-settings-grafana", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsGrafana: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsGrafana: http response: %w", err)
 	}
@@ -8125,6 +7744,10 @@ func (c Client) GetDBAASSettingsGrafana(ctx context.Context) (*GetDBAASSettingsG
 		return nil, fmt.Errorf("GetDBAASSettingsGrafana: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsGrafana: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8190,23 +7813,11 @@ func (c Client) GetDBAASSettingsKafka(ctx context.Context) (*GetDBAASSettingsKaf
 		return nil, fmt.Errorf("GetDBAASSettingsKafka: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsKafka: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-kafka")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-settings-kafka", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsKafka: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsKafka: http response: %w", err)
 	}
@@ -8216,6 +7827,10 @@ func (c Client) GetDBAASSettingsKafka(ctx context.Context) (*GetDBAASSettingsKaf
 		return nil, fmt.Errorf("GetDBAASSettingsKafka: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsKafka: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8251,23 +7866,11 @@ func (c Client) GetDBAASSettingsMysql(ctx context.Context) (*GetDBAASSettingsMys
 		return nil, fmt.Errorf("GetDBAASSettingsMysql: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsMysql: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-mysql")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-settings-mysql", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsMysql: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsMysql: http response: %w", err)
 	}
@@ -8277,6 +7880,10 @@ func (c Client) GetDBAASSettingsMysql(ctx context.Context) (*GetDBAASSettingsMys
 		return nil, fmt.Errorf("GetDBAASSettingsMysql: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsMysql: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8312,23 +7919,11 @@ func (c Client) GetDBAASSettingsOpensearch(ctx context.Context) (*GetDBAASSettin
 		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-opensearch")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-settings-opensearch", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: http response: %w", err)
 	}
@@ -8338,6 +7933,10 @@ func (c Client) GetDBAASSettingsOpensearch(ctx context.Context) (*GetDBAASSettin
 		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsOpensearch: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8403,23 +8002,11 @@ func (c Client) GetDBAASSettingsPG(ctx context.Context) (*GetDBAASSettingsPGResp
 		return nil, fmt.Errorf("GetDBAASSettingsPG: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsPG: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-pg")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-settings-pg", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsPG: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsPG: http response: %w", err)
 	}
@@ -8429,6 +8016,10 @@ func (c Client) GetDBAASSettingsPG(ctx context.Context) (*GetDBAASSettingsPGResp
 		return nil, fmt.Errorf("GetDBAASSettingsPG: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsPG: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8464,23 +8055,11 @@ func (c Client) GetDBAASSettingsThanos(ctx context.Context) (*GetDBAASSettingsTh
 		return nil, fmt.Errorf("GetDBAASSettingsThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsThanos: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-thanos")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-settings-thanos", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsThanos: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsThanos: http response: %w", err)
 	}
@@ -8490,6 +8069,10 @@ func (c Client) GetDBAASSettingsThanos(ctx context.Context) (*GetDBAASSettingsTh
 		return nil, fmt.Errorf("GetDBAASSettingsThanos: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsThanos: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8525,23 +8108,11 @@ func (c Client) GetDBAASSettingsValkey(ctx context.Context) (*GetDBAASSettingsVa
 		return nil, fmt.Errorf("GetDBAASSettingsValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASSettingsValkey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-settings-valkey")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-settings-valkey", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsValkey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASSettingsValkey: http response: %w", err)
 	}
@@ -8551,6 +8122,10 @@ func (c Client) GetDBAASSettingsValkey(ctx context.Context) (*GetDBAASSettingsVa
 		return nil, fmt.Errorf("GetDBAASSettingsValkey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASSettingsValkey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8566,6 +8141,10 @@ type CreateDBAASTaskMigrationCheckRequest struct {
 func (c Client) CreateDBAASTaskMigrationCheck(ctx context.Context, service string, req CreateDBAASTaskMigrationCheckRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-task-migration-check/%v", service)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: prepare Json body: %w", err)
@@ -8584,23 +8163,11 @@ func (c Client) CreateDBAASTaskMigrationCheck(ctx context.Context, service strin
 		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-task-migration-check")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-task-migration-check", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: http response: %w", err)
 	}
@@ -8610,6 +8177,10 @@ func (c Client) CreateDBAASTaskMigrationCheck(ctx context.Context, service strin
 		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASTaskMigrationCheck: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8628,23 +8199,11 @@ func (c Client) GetDBAASTask(ctx context.Context, service string, id UUID) (*DBA
 		return nil, fmt.Errorf("GetDBAASTask: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASTask: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-task")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-task", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASTask: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASTask: http response: %w", err)
 	}
@@ -8654,6 +8213,10 @@ func (c Client) GetDBAASTask(ctx context.Context, service string, id UUID) (*DBA
 		return nil, fmt.Errorf("GetDBAASTask: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASTask: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8671,23 +8234,11 @@ func (c Client) DeleteDBAASServiceThanos(ctx context.Context, name string) (*Ope
 		return nil, fmt.Errorf("DeleteDBAASServiceThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceThanos: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-thanos")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-service-thanos", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceThanos: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceThanos: http response: %w", err)
 	}
@@ -8697,6 +8248,10 @@ func (c Client) DeleteDBAASServiceThanos(ctx context.Context, name string) (*Ope
 		return nil, fmt.Errorf("DeleteDBAASServiceThanos: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceThanos: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8715,23 +8270,11 @@ func (c Client) GetDBAASServiceThanos(ctx context.Context, name string) (*DBAASS
 		return nil, fmt.Errorf("GetDBAASServiceThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceThanos: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-thanos")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-thanos", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceThanos: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceThanos: http response: %w", err)
 	}
@@ -8741,6 +8284,10 @@ func (c Client) GetDBAASServiceThanos(ctx context.Context, name string) (*DBAASS
 		return nil, fmt.Errorf("GetDBAASServiceThanos: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceThanos: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8782,6 +8329,10 @@ type CreateDBAASServiceThanosRequest struct {
 func (c Client) CreateDBAASServiceThanos(ctx context.Context, name string, req CreateDBAASServiceThanosRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-thanos/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceThanos: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceThanos: prepare Json body: %w", err)
@@ -8800,23 +8351,11 @@ func (c Client) CreateDBAASServiceThanos(ctx context.Context, name string, req C
 		return nil, fmt.Errorf("CreateDBAASServiceThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceThanos: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-thanos")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-service-thanos", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceThanos: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceThanos: http response: %w", err)
 	}
@@ -8826,6 +8365,10 @@ func (c Client) CreateDBAASServiceThanos(ctx context.Context, name string, req C
 		return nil, fmt.Errorf("CreateDBAASServiceThanos: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceThanos: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8867,6 +8410,10 @@ type UpdateDBAASServiceThanosRequest struct {
 func (c Client) UpdateDBAASServiceThanos(ctx context.Context, name string, req UpdateDBAASServiceThanosRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-thanos/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceThanos: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceThanos: prepare Json body: %w", err)
@@ -8885,23 +8432,11 @@ func (c Client) UpdateDBAASServiceThanos(ctx context.Context, name string, req U
 		return nil, fmt.Errorf("UpdateDBAASServiceThanos: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceThanos: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-thanos")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-service-thanos", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceThanos: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceThanos: http response: %w", err)
 	}
@@ -8911,6 +8446,10 @@ func (c Client) UpdateDBAASServiceThanos(ctx context.Context, name string, req U
 		return nil, fmt.Errorf("UpdateDBAASServiceThanos: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceThanos: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8928,23 +8467,11 @@ func (c Client) StartDBAASThanosMaintenance(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("StartDBAASThanosMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASThanosMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-thanos-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "start-dbaas-thanos-maintenance", request)
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASThanosMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StartDBAASThanosMaintenance: http response: %w", err)
 	}
@@ -8954,6 +8481,10 @@ func (c Client) StartDBAASThanosMaintenance(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("StartDBAASThanosMaintenance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASThanosMaintenance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -8971,23 +8502,11 @@ func (c Client) RevealDBAASThanosUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-thanos-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-dbaas-thanos-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: http response: %w", err)
 	}
@@ -8997,6 +8516,10 @@ func (c Client) RevealDBAASThanosUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASThanosUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9014,23 +8537,11 @@ func (c Client) DeleteDBAASServiceValkey(ctx context.Context, name string) (*Ope
 		return nil, fmt.Errorf("DeleteDBAASServiceValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASServiceValkey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-service-valkey")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-service-valkey", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceValkey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASServiceValkey: http response: %w", err)
 	}
@@ -9040,6 +8551,10 @@ func (c Client) DeleteDBAASServiceValkey(ctx context.Context, name string) (*Ope
 		return nil, fmt.Errorf("DeleteDBAASServiceValkey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASServiceValkey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9058,23 +8573,11 @@ func (c Client) GetDBAASServiceValkey(ctx context.Context, name string) (*DBAASS
 		return nil, fmt.Errorf("GetDBAASServiceValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDBAASServiceValkey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dbaas-service-valkey")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dbaas-service-valkey", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceValkey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDBAASServiceValkey: http response: %w", err)
 	}
@@ -9084,6 +8587,10 @@ func (c Client) GetDBAASServiceValkey(ctx context.Context, name string) (*DBAASS
 		return nil, fmt.Errorf("GetDBAASServiceValkey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDBAASServiceValkey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9149,6 +8656,10 @@ type CreateDBAASServiceValkeyRequest struct {
 func (c Client) CreateDBAASServiceValkey(ctx context.Context, name string, req CreateDBAASServiceValkeyRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-valkey/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceValkey: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceValkey: prepare Json body: %w", err)
@@ -9167,23 +8678,11 @@ func (c Client) CreateDBAASServiceValkey(ctx context.Context, name string, req C
 		return nil, fmt.Errorf("CreateDBAASServiceValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASServiceValkey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-service-valkey")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-service-valkey", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceValkey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASServiceValkey: http response: %w", err)
 	}
@@ -9193,6 +8692,10 @@ func (c Client) CreateDBAASServiceValkey(ctx context.Context, name string, req C
 		return nil, fmt.Errorf("CreateDBAASServiceValkey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASServiceValkey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9255,6 +8758,10 @@ type UpdateDBAASServiceValkeyRequest struct {
 func (c Client) UpdateDBAASServiceValkey(ctx context.Context, name string, req UpdateDBAASServiceValkeyRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-valkey/%v", name)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceValkey: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceValkey: prepare Json body: %w", err)
@@ -9273,23 +8780,11 @@ func (c Client) UpdateDBAASServiceValkey(ctx context.Context, name string, req U
 		return nil, fmt.Errorf("UpdateDBAASServiceValkey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASServiceValkey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-service-valkey")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-service-valkey", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceValkey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASServiceValkey: http response: %w", err)
 	}
@@ -9299,6 +8794,10 @@ func (c Client) UpdateDBAASServiceValkey(ctx context.Context, name string, req U
 		return nil, fmt.Errorf("UpdateDBAASServiceValkey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASServiceValkey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9316,23 +8815,11 @@ func (c Client) StartDBAASValkeyMaintenance(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-dbaas-valkey-maintenance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "start-dbaas-valkey-maintenance", request)
 	if err != nil {
 		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: http response: %w", err)
 	}
@@ -9342,6 +8829,10 @@ func (c Client) StartDBAASValkeyMaintenance(ctx context.Context, name string) (*
 		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StartDBAASValkeyMaintenance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9359,23 +8850,11 @@ func (c Client) StopDBAASValkeyMigration(ctx context.Context, name string) (*Ope
 		return nil, fmt.Errorf("StopDBAASValkeyMigration: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopDBAASValkeyMigration: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-dbaas-valkey-migration")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "stop-dbaas-valkey-migration", request)
 	if err != nil {
 		return nil, fmt.Errorf("StopDBAASValkeyMigration: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StopDBAASValkeyMigration: http response: %w", err)
 	}
@@ -9385,6 +8864,10 @@ func (c Client) StopDBAASValkeyMigration(ctx context.Context, name string) (*Ope
 		return nil, fmt.Errorf("StopDBAASValkeyMigration: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StopDBAASValkeyMigration: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9402,23 +8885,11 @@ func (c Client) ListDBAASValkeyUsers(ctx context.Context, serviceName string) (*
 		return nil, fmt.Errorf("ListDBAASValkeyUsers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDBAASValkeyUsers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dbaas-valkey-users")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dbaas-valkey-users", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDBAASValkeyUsers: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDBAASValkeyUsers: http response: %w", err)
 	}
@@ -9428,9 +8899,32 @@ func (c Client) ListDBAASValkeyUsers(ctx context.Context, serviceName string) (*
 		return nil, fmt.Errorf("ListDBAASValkeyUsers: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDBAASValkeyUsers: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDBAASValkeyUsers returns an iterator over the DBAASValkeyUser items returned by ListDBAASValkeyUsers.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDBAASValkeyUsers(ctx context.Context, serviceName string) iter.Seq2[DBAASValkeyUser, error] {
+	return func(yield func(DBAASValkeyUser, error) bool) {
+		resp, err := c.ListDBAASValkeyUsers(ctx, serviceName)
+		if err != nil {
+			var zero DBAASValkeyUser
+			yield(zero, fmt.Errorf("AllDBAASValkeyUsers: %w", err))
+			return
+		}
+
+		for _, item := range resp.Users {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateDBAASValkeyUserRequest struct {
 	AccessControl *DBAASValkeyUserAccessControl `json:"access-control,omitempty"`
 	Username      DBAASUserUsername             `json:"username" validate:"required,gte=1,lte=64"`
@@ -9439,6 +8933,10 @@ type CreateDBAASValkeyUserRequest struct {
 func (c Client) CreateDBAASValkeyUser(ctx context.Context, serviceName string, req CreateDBAASValkeyUserRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-valkey/%v/user", serviceName)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDBAASValkeyUser: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASValkeyUser: prepare Json body: %w", err)
@@ -9457,23 +8955,11 @@ func (c Client) CreateDBAASValkeyUser(ctx context.Context, serviceName string, r
 		return nil, fmt.Errorf("CreateDBAASValkeyUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDBAASValkeyUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dbaas-valkey-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dbaas-valkey-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDBAASValkeyUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDBAASValkeyUser: http response: %w", err)
 	}
@@ -9483,6 +8969,10 @@ func (c Client) CreateDBAASValkeyUser(ctx context.Context, serviceName string, r
 		return nil, fmt.Errorf("CreateDBAASValkeyUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDBAASValkeyUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9500,23 +8990,11 @@ func (c Client) DeleteDBAASValkeyUser(ctx context.Context, serviceName string, u
 		return nil, fmt.Errorf("DeleteDBAASValkeyUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDBAASValkeyUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dbaas-valkey-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dbaas-valkey-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDBAASValkeyUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDBAASValkeyUser: http response: %w", err)
 	}
@@ -9526,6 +9004,10 @@ func (c Client) DeleteDBAASValkeyUser(ctx context.Context, serviceName string, u
 		return nil, fmt.Errorf("DeleteDBAASValkeyUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDBAASValkeyUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9536,6 +9018,10 @@ type UpdateDBAASValkeyUserAccessControlRequest struct {
 func (c Client) UpdateDBAASValkeyUserAccessControl(ctx context.Context, serviceName string, username string, req UpdateDBAASValkeyUserAccessControlRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-valkey/%v/user/%v", serviceName, username)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: prepare Json body: %w", err)
@@ -9554,23 +9040,11 @@ func (c Client) UpdateDBAASValkeyUserAccessControl(ctx context.Context, serviceN
 		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dbaas-valkey-user-access-control")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dbaas-valkey-user-access-control", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: http response: %w", err)
 	}
@@ -9580,6 +9054,10 @@ func (c Client) UpdateDBAASValkeyUserAccessControl(ctx context.Context, serviceN
 		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDBAASValkeyUserAccessControl: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9591,6 +9069,10 @@ type ResetDBAASValkeyUserPasswordRequest struct {
 func (c Client) ResetDBAASValkeyUserPassword(ctx context.Context, serviceName string, username string, req ResetDBAASValkeyUserPasswordRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dbaas-valkey/%v/user/%v/password/reset", serviceName, username)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: prepare Json body: %w", err)
@@ -9609,23 +9091,11 @@ func (c Client) ResetDBAASValkeyUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-dbaas-valkey-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-dbaas-valkey-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: http response: %w", err)
 	}
@@ -9635,6 +9105,10 @@ func (c Client) ResetDBAASValkeyUserPassword(ctx context.Context, serviceName st
 		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetDBAASValkeyUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9652,23 +9126,11 @@ func (c Client) RevealDBAASValkeyUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-dbaas-valkey-user-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-dbaas-valkey-user-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: http response: %w", err)
 	}
@@ -9678,6 +9140,10 @@ func (c Client) RevealDBAASValkeyUserPassword(ctx context.Context, serviceName s
 		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealDBAASValkeyUserPassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9719,23 +9185,11 @@ func (c Client) ListDeployTargets(ctx context.Context) (*ListDeployTargetsRespon
 		return nil, fmt.Errorf("ListDeployTargets: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDeployTargets: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-deploy-targets")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-deploy-targets", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDeployTargets: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDeployTargets: http response: %w", err)
 	}
@@ -9745,9 +9199,32 @@ func (c Client) ListDeployTargets(ctx context.Context) (*ListDeployTargetsRespon
 		return nil, fmt.Errorf("ListDeployTargets: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDeployTargets: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDeployTargets returns an iterator over the DeployTarget items returned by ListDeployTargets.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDeployTargets(ctx context.Context) iter.Seq2[DeployTarget, error] {
+	return func(yield func(DeployTarget, error) bool) {
+		resp, err := c.ListDeployTargets(ctx)
+		if err != nil {
+			var zero DeployTarget
+			yield(zero, fmt.Errorf("AllDeployTargets: %w", err))
+			return
+		}
+
+		for _, item := range resp.DeployTargets {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Retrieve Deploy Target details
 func (c Client) GetDeployTarget(ctx context.Context, id UUID) (*DeployTarget, error) {
 	path := fmt.Sprintf("/deploy-target/%v", id)
@@ -9763,23 +9240,11 @@ func (c Client) GetDeployTarget(ctx context.Context, id UUID) (*DeployTarget, er
 		return nil, fmt.Errorf("GetDeployTarget: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDeployTarget: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-deploy-target")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-deploy-target", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDeployTarget: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDeployTarget: http response: %w", err)
 	}
@@ -9789,6 +9254,10 @@ func (c Client) GetDeployTarget(ctx context.Context, id UUID) (*DeployTarget, er
 		return nil, fmt.Errorf("GetDeployTarget: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDeployTarget: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -9830,23 +9299,11 @@ func (c Client) ListDNSDomains(ctx context.Context) (*ListDNSDomainsResponse, er
 		return nil, fmt.Errorf("ListDNSDomains: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDNSDomains: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dns-domains")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dns-domains", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDNSDomains: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDNSDomains: http response: %w", err)
 	}
@@ -9856,9 +9313,32 @@ func (c Client) ListDNSDomains(ctx context.Context) (*ListDNSDomainsResponse, er
 		return nil, fmt.Errorf("ListDNSDomains: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDNSDomains: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDNSDomains returns an iterator over the DNSDomain items returned by ListDNSDomains.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDNSDomains(ctx context.Context) iter.Seq2[DNSDomain, error] {
+	return func(yield func(DNSDomain, error) bool) {
+		resp, err := c.ListDNSDomains(ctx)
+		if err != nil {
+			var zero DNSDomain
+			yield(zero, fmt.Errorf("AllDNSDomains: %w", err))
+			return
+		}
+
+		for _, item := range resp.DNSDomains {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // DNS Domain
 type CreateDNSDomainRequest struct {
 	// Domain name
@@ -9869,6 +9349,10 @@ type CreateDNSDomainRequest struct {
 func (c Client) CreateDNSDomain(ctx context.Context, req CreateDNSDomainRequest) (*Operation, error) {
 	path := "/dns-domain"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDNSDomain: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDNSDomain: prepare Json body: %w", err)
@@ -9887,23 +9371,11 @@ func (c Client) CreateDNSDomain(ctx context.Context, req CreateDNSDomainRequest)
 		return nil, fmt.Errorf("CreateDNSDomain: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomain: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dns-domain")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dns-domain", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDNSDomain: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDNSDomain: http response: %w", err)
 	}
@@ -9913,9 +9385,29 @@ func (c Client) CreateDNSDomain(ctx context.Context, req CreateDNSDomainRequest)
 		return nil, fmt.Errorf("CreateDNSDomain: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDNSDomain: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateDNSDomainAndWait calls CreateDNSDomain, waits for the resulting operation to succeed
+// and returns the DNSDomain referenced by the operation.
+func (c Client) CreateDNSDomainAndWait(ctx context.Context, req CreateDNSDomainRequest, opts ...WaitOpt) (*DNSDomain, error) {
+	op, err := c.CreateDNSDomain(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-dns-domain", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateDNSDomainAndWait: %w", err)
+	}
+
+	return c.GetDNSDomain(ctx, ref.ID)
+}
+
 type ListDNSDomainRecordsResponse struct {
 	DNSDomainRecords []DNSDomainRecord `json:"dns-domain-records,omitempty"`
 }
@@ -9954,23 +9446,11 @@ func (c Client) ListDNSDomainRecords(ctx context.Context, domainID UUID) (*ListD
 		return nil, fmt.Errorf("ListDNSDomainRecords: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListDNSDomainRecords: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-dns-domain-records")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-dns-domain-records", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListDNSDomainRecords: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListDNSDomainRecords: http response: %w", err)
 	}
@@ -9980,9 +9460,32 @@ func (c Client) ListDNSDomainRecords(ctx context.Context, domainID UUID) (*ListD
 		return nil, fmt.Errorf("ListDNSDomainRecords: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListDNSDomainRecords: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllDNSDomainRecords returns an iterator over the DNSDomainRecord items returned by ListDNSDomainRecords.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllDNSDomainRecords(ctx context.Context, domainID UUID) iter.Seq2[DNSDomainRecord, error] {
+	return func(yield func(DNSDomainRecord, error) bool) {
+		resp, err := c.ListDNSDomainRecords(ctx, domainID)
+		if err != nil {
+			var zero DNSDomainRecord
+			yield(zero, fmt.Errorf("AllDNSDomainRecords: %w", err))
+			return
+		}
+
+		for _, item := range resp.DNSDomainRecords {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateDNSDomainRecordRequestType string
 
 const (
@@ -10019,6 +9522,10 @@ type CreateDNSDomainRecordRequest struct {
 func (c Client) CreateDNSDomainRecord(ctx context.Context, domainID UUID, req CreateDNSDomainRecordRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dns-domain/%v/record", domainID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateDNSDomainRecord: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDNSDomainRecord: prepare Json body: %w", err)
@@ -10037,23 +9544,11 @@ func (c Client) CreateDNSDomainRecord(ctx context.Context, domainID UUID, req Cr
 		return nil, fmt.Errorf("CreateDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-dns-domain-record", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateDNSDomainRecord: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateDNSDomainRecord: http response: %w", err)
 	}
@@ -10063,6 +9558,10 @@ func (c Client) CreateDNSDomainRecord(ctx context.Context, domainID UUID, req Cr
 		return nil, fmt.Errorf("CreateDNSDomainRecord: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateDNSDomainRecord: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10081,23 +9580,11 @@ func (c Client) DeleteDNSDomainRecord(ctx context.Context, domainID UUID, record
 		return nil, fmt.Errorf("DeleteDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dns-domain-record", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDNSDomainRecord: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDNSDomainRecord: http response: %w", err)
 	}
@@ -10107,6 +9594,10 @@ func (c Client) DeleteDNSDomainRecord(ctx context.Context, domainID UUID, record
 		return nil, fmt.Errorf("DeleteDNSDomainRecord: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDNSDomainRecord: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10125,23 +9616,11 @@ func (c Client) GetDNSDomainRecord(ctx context.Context, domainID UUID, recordID
 		return nil, fmt.Errorf("GetDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dns-domain-record", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDNSDomainRecord: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDNSDomainRecord: http response: %w", err)
 	}
@@ -10151,6 +9630,10 @@ func (c Client) GetDNSDomainRecord(ctx context.Context, domainID UUID, recordID
 		return nil, fmt.Errorf("GetDNSDomainRecord: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDNSDomainRecord: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10169,6 +9652,10 @@ type UpdateDNSDomainRecordRequest struct {
 func (c Client) UpdateDNSDomainRecord(ctx context.Context, domainID UUID, recordID UUID, req UpdateDNSDomainRecordRequest) (*Operation, error) {
 	path := fmt.Sprintf("/dns-domain/%v/record/%v", domainID, recordID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateDNSDomainRecord: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDNSDomainRecord: prepare Json body: %w", err)
@@ -10187,23 +9674,11 @@ func (c Client) UpdateDNSDomainRecord(ctx context.Context, domainID UUID, record
 		return nil, fmt.Errorf("UpdateDNSDomainRecord: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateDNSDomainRecord: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-dns-domain-record")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-dns-domain-record", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateDNSDomainRecord: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateDNSDomainRecord: http response: %w", err)
 	}
@@ -10213,6 +9688,10 @@ func (c Client) UpdateDNSDomainRecord(ctx context.Context, domainID UUID, record
 		return nil, fmt.Errorf("UpdateDNSDomainRecord: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateDNSDomainRecord: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10231,23 +9710,11 @@ func (c Client) DeleteDNSDomain(ctx context.Context, id UUID) (*Operation, error
 		return nil, fmt.Errorf("DeleteDNSDomain: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteDNSDomain: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-dns-domain")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-dns-domain", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteDNSDomain: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteDNSDomain: http response: %w", err)
 	}
@@ -10257,6 +9724,10 @@ func (c Client) DeleteDNSDomain(ctx context.Context, id UUID) (*Operation, error
 		return nil, fmt.Errorf("DeleteDNSDomain: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteDNSDomain: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10275,23 +9746,11 @@ func (c Client) GetDNSDomain(ctx context.Context, id UUID) (*DNSDomain, error) {
 		return nil, fmt.Errorf("GetDNSDomain: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDNSDomain: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dns-domain")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dns-domain", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDNSDomain: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDNSDomain: http response: %w", err)
 	}
@@ -10301,6 +9760,10 @@ func (c Client) GetDNSDomain(ctx context.Context, id UUID) (*DNSDomain, error) {
 		return nil, fmt.Errorf("GetDNSDomain: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDNSDomain: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10323,23 +9786,11 @@ func (c Client) GetDNSDomainZoneFile(ctx context.Context, id UUID) (*GetDNSDomai
 		return nil, fmt.Errorf("GetDNSDomainZoneFile: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetDNSDomainZoneFile: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-dns-domain-zone-file")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-dns-domain-zone-file", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetDNSDomainZoneFile: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetDNSDomainZoneFile: http response: %w", err)
 	}
@@ -10349,6 +9800,10 @@ func (c Client) GetDNSDomainZoneFile(ctx context.Context, id UUID) (*GetDNSDomai
 		return nil, fmt.Errorf("GetDNSDomainZoneFile: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetDNSDomainZoneFile: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10390,23 +9845,11 @@ func (c Client) ListElasticIPS(ctx context.Context) (*ListElasticIPSResponse, er
 		return nil, fmt.Errorf("ListElasticIPS: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListElasticIPS: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-elastic-ips")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-elastic-ips", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListElasticIPS: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListElasticIPS: http response: %w", err)
 	}
@@ -10416,9 +9859,32 @@ func (c Client) ListElasticIPS(ctx context.Context) (*ListElasticIPSResponse, er
 		return nil, fmt.Errorf("ListElasticIPS: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListElasticIPS: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllElasticIPS returns an iterator over the ElasticIP items returned by ListElasticIPS.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllElasticIPS(ctx context.Context) iter.Seq2[ElasticIP, error] {
+	return func(yield func(ElasticIP, error) bool) {
+		resp, err := c.ListElasticIPS(ctx)
+		if err != nil {
+			var zero ElasticIP
+			yield(zero, fmt.Errorf("AllElasticIPS: %w", err))
+			return
+		}
+
+		for _, item := range resp.ElasticIPS {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateElasticIPRequestAddressfamily string
 
 const (
@@ -10440,6 +9906,10 @@ type CreateElasticIPRequest struct {
 func (c Client) CreateElasticIP(ctx context.Context, req CreateElasticIPRequest) (*Operation, error) {
 	path := "/elastic-ip"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateElasticIP: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateElasticIP: prepare Json body: %w", err)
@@ -10458,33 +9928,41 @@ func (c Client) CreateElasticIP(ctx context.Context, req CreateElasticIPRequest)
 		return nil, fmt.Errorf("CreateElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateElasticIP: sign request: %w", err)
+	response, err := c.do(ctx, "create-elastic-ip", request)
+	if err != nil {
+		return nil, fmt.Errorf("CreateElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "create-elastic-ip")
+	if err := handleHTTPErrorResp(response); err != nil {
+		return nil, fmt.Errorf("CreateElasticIP: http response: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("CreateElasticIP: http client do: %w", err)
+	bodyresp := new(Operation)
+	if err := prepareJSONResponse(response, bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateElasticIP: prepare Json response: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateElasticIP: validate response: %w", err)
 	}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("CreateElasticIP: http response: %w", err)
+	return bodyresp, nil
+}
+
+// CreateElasticIPAndWait calls CreateElasticIP, waits for the resulting operation to succeed
+// and returns the ElasticIP referenced by the operation.
+func (c Client) CreateElasticIPAndWait(ctx context.Context, req CreateElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error) {
+	op, err := c.CreateElasticIP(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("CreateElasticIP: prepare Json response: %w", err)
+	ref, err := c.waitForReference(ctx, op, "get-elastic-ip", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateElasticIPAndWait: %w", err)
 	}
 
-	return bodyresp, nil
+	return c.GetElasticIP(ctx, ref.ID)
 }
 
 // Delete an Elastic IP
@@ -10502,23 +9980,11 @@ func (c Client) DeleteElasticIP(ctx context.Context, id UUID) (*Operation, error
 		return nil, fmt.Errorf("DeleteElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-elastic-ip", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteElasticIP: http response: %w", err)
 	}
@@ -10528,6 +9994,10 @@ func (c Client) DeleteElasticIP(ctx context.Context, id UUID) (*Operation, error
 		return nil, fmt.Errorf("DeleteElasticIP: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteElasticIP: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10546,23 +10016,11 @@ func (c Client) GetElasticIP(ctx context.Context, id UUID) (*ElasticIP, error) {
 		return nil, fmt.Errorf("GetElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-elastic-ip", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetElasticIP: http response: %w", err)
 	}
@@ -10572,6 +10030,10 @@ func (c Client) GetElasticIP(ctx context.Context, id UUID) (*ElasticIP, error) {
 		return nil, fmt.Errorf("GetElasticIP: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetElasticIP: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10587,6 +10049,10 @@ type UpdateElasticIPRequest struct {
 func (c Client) UpdateElasticIP(ctx context.Context, id UUID, req UpdateElasticIPRequest) (*Operation, error) {
 	path := fmt.Sprintf("/elastic-ip/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateElasticIP: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateElasticIP: prepare Json body: %w", err)
@@ -10605,23 +10071,11 @@ func (c Client) UpdateElasticIP(ctx context.Context, id UUID, req UpdateElasticI
 		return nil, fmt.Errorf("UpdateElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-elastic-ip", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateElasticIP: http response: %w", err)
 	}
@@ -10631,9 +10085,29 @@ func (c Client) UpdateElasticIP(ctx context.Context, id UUID, req UpdateElasticI
 		return nil, fmt.Errorf("UpdateElasticIP: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateElasticIP: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateElasticIPAndWait calls UpdateElasticIP, waits for the resulting operation to succeed
+// and returns the ElasticIP referenced by the operation.
+func (c Client) UpdateElasticIPAndWait(ctx context.Context, id UUID, req UpdateElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error) {
+	op, err := c.UpdateElasticIP(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-elastic-ip", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateElasticIPAndWait: %w", err)
+	}
+
+	return c.GetElasticIP(ctx, ref.ID)
+}
+
 type ResetElasticIPFieldField string
 
 const (
@@ -10655,23 +10129,11 @@ func (c Client) ResetElasticIPField(ctx context.Context, id UUID, field ResetEla
 		return nil, fmt.Errorf("ResetElasticIPField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetElasticIPField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-elastic-ip-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-elastic-ip-field", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetElasticIPField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetElasticIPField: http response: %w", err)
 	}
@@ -10681,6 +10143,10 @@ func (c Client) ResetElasticIPField(ctx context.Context, id UUID, field ResetEla
 		return nil, fmt.Errorf("ResetElasticIPField: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetElasticIPField: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10693,6 +10159,10 @@ type AttachInstanceToElasticIPRequest struct {
 func (c Client) AttachInstanceToElasticIP(ctx context.Context, id UUID, req AttachInstanceToElasticIPRequest) (*Operation, error) {
 	path := fmt.Sprintf("/elastic-ip/%v:attach", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToElasticIP: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("AttachInstanceToElasticIP: prepare Json body: %w", err)
@@ -10711,23 +10181,11 @@ func (c Client) AttachInstanceToElasticIP(ctx context.Context, id UUID, req Atta
 		return nil, fmt.Errorf("AttachInstanceToElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "attach-instance-to-elastic-ip", request)
 	if err != nil {
 		return nil, fmt.Errorf("AttachInstanceToElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AttachInstanceToElasticIP: http response: %w", err)
 	}
@@ -10737,9 +10195,29 @@ func (c Client) AttachInstanceToElasticIP(ctx context.Context, id UUID, req Atta
 		return nil, fmt.Errorf("AttachInstanceToElasticIP: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToElasticIP: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AttachInstanceToElasticIPAndWait calls AttachInstanceToElasticIP, waits for the resulting operation to succeed
+// and returns the ElasticIP referenced by the operation.
+func (c Client) AttachInstanceToElasticIPAndWait(ctx context.Context, id UUID, req AttachInstanceToElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error) {
+	op, err := c.AttachInstanceToElasticIP(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-elastic-ip", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("AttachInstanceToElasticIPAndWait: %w", err)
+	}
+
+	return c.GetElasticIP(ctx, ref.ID)
+}
+
 type DetachInstanceFromElasticIPRequest struct {
 	// Target Instance
 	Instance *InstanceTarget `json:"instance" validate:"required"`
@@ -10749,6 +10227,10 @@ type DetachInstanceFromElasticIPRequest struct {
 func (c Client) DetachInstanceFromElasticIP(ctx context.Context, id UUID, req DetachInstanceFromElasticIPRequest) (*Operation, error) {
 	path := fmt.Sprintf("/elastic-ip/%v:detach", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromElasticIP: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromElasticIP: prepare Json body: %w", err)
@@ -10767,23 +10249,11 @@ func (c Client) DetachInstanceFromElasticIP(ctx context.Context, id UUID, req De
 		return nil, fmt.Errorf("DetachInstanceFromElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "detach-instance-from-elastic-ip", request)
 	if err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromElasticIP: http response: %w", err)
 	}
@@ -10793,9 +10263,29 @@ func (c Client) DetachInstanceFromElasticIP(ctx context.Context, id UUID, req De
 		return nil, fmt.Errorf("DetachInstanceFromElasticIP: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromElasticIP: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// DetachInstanceFromElasticIPAndWait calls DetachInstanceFromElasticIP, waits for the resulting operation to succeed
+// and returns the ElasticIP referenced by the operation.
+func (c Client) DetachInstanceFromElasticIPAndWait(ctx context.Context, id UUID, req DetachInstanceFromElasticIPRequest, opts ...WaitOpt) (*ElasticIP, error) {
+	op, err := c.DetachInstanceFromElasticIP(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-elastic-ip", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromElasticIPAndWait: %w", err)
+	}
+
+	return c.GetElasticIP(ctx, ref.ID)
+}
+
 // [BETA] Returns environmental impact reports for an organization
 func (c Client) GetEnvImpact(ctx context.Context, period string) (*EnvImpactReport, error) {
 	path := fmt.Sprintf("/env-impact/%v", period)
@@ -10811,23 +10301,11 @@ func (c Client) GetEnvImpact(ctx context.Context, period string) (*EnvImpactRepo
 		return nil, fmt.Errorf("GetEnvImpact: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetEnvImpact: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-env-impact")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-env-impact", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetEnvImpact: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetEnvImpact: http response: %w", err)
 	}
@@ -10837,6 +10315,10 @@ func (c Client) GetEnvImpact(ctx context.Context, period string) (*EnvImpactRepo
 		return nil, fmt.Errorf("GetEnvImpact: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetEnvImpact: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10879,23 +10361,11 @@ func (c Client) ListEvents(ctx context.Context, opts ...ListEventsOpt) ([]Event,
 		return nil, fmt.Errorf("ListEvents: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListEvents: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-events")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-events", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListEvents: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListEvents: http response: %w", err)
 	}
@@ -10905,9 +10375,32 @@ func (c Client) ListEvents(ctx context.Context, opts ...ListEventsOpt) ([]Event,
 		return nil, fmt.Errorf("ListEvents: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListEvents: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllEvents returns an iterator over the Event items returned by ListEvents.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllEvents(ctx context.Context, opts ...ListEventsOpt) iter.Seq2[Event, error] {
+	return func(yield func(Event, error) bool) {
+		resp, err := c.ListEvents(ctx, opts...)
+		if err != nil {
+			var zero Event
+			yield(zero, fmt.Errorf("AllEvents: %w", err))
+			return
+		}
+
+		for _, item := range resp {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Retrieve IAM Organization Policy
 func (c Client) GetIAMOrganizationPolicy(ctx context.Context) (*IAMPolicy, error) {
 	path := "/iam-organization-policy"
@@ -10923,23 +10416,11 @@ func (c Client) GetIAMOrganizationPolicy(ctx context.Context) (*IAMPolicy, error
 		return nil, fmt.Errorf("GetIAMOrganizationPolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetIAMOrganizationPolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-iam-organization-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-iam-organization-policy", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetIAMOrganizationPolicy: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetIAMOrganizationPolicy: http response: %w", err)
 	}
@@ -10949,6 +10430,10 @@ func (c Client) GetIAMOrganizationPolicy(ctx context.Context) (*IAMPolicy, error
 		return nil, fmt.Errorf("GetIAMOrganizationPolicy: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetIAMOrganizationPolicy: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -10956,6 +10441,10 @@ func (c Client) GetIAMOrganizationPolicy(ctx context.Context) (*IAMPolicy, error
 func (c Client) UpdateIAMOrganizationPolicy(ctx context.Context, req IAMPolicy) (*Operation, error) {
 	path := "/iam-organization-policy"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: prepare Json body: %w", err)
@@ -10974,23 +10463,11 @@ func (c Client) UpdateIAMOrganizationPolicy(ctx context.Context, req IAMPolicy)
 		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-iam-organization-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-iam-organization-policy", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: http response: %w", err)
 	}
@@ -11000,6 +10477,10 @@ func (c Client) UpdateIAMOrganizationPolicy(ctx context.Context, req IAMPolicy)
 		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateIAMOrganizationPolicy: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -11018,23 +10499,11 @@ func (c Client) ResetIAMOrganizationPolicy(ctx context.Context) (*Operation, err
 		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-iam-organization-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-iam-organization-policy", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: http response: %w", err)
 	}
@@ -11044,6 +10513,10 @@ func (c Client) ResetIAMOrganizationPolicy(ctx context.Context) (*Operation, err
 		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetIAMOrganizationPolicy: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -11085,23 +10558,11 @@ func (c Client) ListIAMRoles(ctx context.Context) (*ListIAMRolesResponse, error)
 		return nil, fmt.Errorf("ListIAMRoles: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListIAMRoles: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-iam-roles")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-iam-roles", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListIAMRoles: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListIAMRoles: http response: %w", err)
 	}
@@ -11111,9 +10572,32 @@ func (c Client) ListIAMRoles(ctx context.Context) (*ListIAMRolesResponse, error)
 		return nil, fmt.Errorf("ListIAMRoles: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListIAMRoles: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllIAMRoles returns an iterator over the IAMRole items returned by ListIAMRoles.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllIAMRoles(ctx context.Context) iter.Seq2[IAMRole, error] {
+	return func(yield func(IAMRole, error) bool) {
+		resp, err := c.ListIAMRoles(ctx)
+		if err != nil {
+			var zero IAMRole
+			yield(zero, fmt.Errorf("AllIAMRoles: %w", err))
+			return
+		}
+
+		for _, item := range resp.IAMRoles {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateIAMRoleRequest struct {
 	// Policy
 	AssumeRolePolicy *IAMPolicy `json:"assume-role-policy,omitempty"`
@@ -11136,6 +10620,10 @@ type CreateIAMRoleRequest struct {
 func (c Client) CreateIAMRole(ctx context.Context, req CreateIAMRoleRequest) (*Operation, error) {
 	path := "/iam-role"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateIAMRole: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateIAMRole: prepare Json body: %w", err)
@@ -11154,23 +10642,11 @@ func (c Client) CreateIAMRole(ctx context.Context, req CreateIAMRoleRequest) (*O
 		return nil, fmt.Errorf("CreateIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-iam-role", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateIAMRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateIAMRole: http response: %w", err)
 	}
@@ -11180,9 +10656,29 @@ func (c Client) CreateIAMRole(ctx context.Context, req CreateIAMRoleRequest) (*O
 		return nil, fmt.Errorf("CreateIAMRole: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateIAMRole: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateIAMRoleAndWait calls CreateIAMRole, waits for the resulting operation to succeed
+// and returns the IAMRole referenced by the operation.
+func (c Client) CreateIAMRoleAndWait(ctx context.Context, req CreateIAMRoleRequest, opts ...WaitOpt) (*IAMRole, error) {
+	op, err := c.CreateIAMRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-iam-role", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateIAMRoleAndWait: %w", err)
+	}
+
+	return c.GetIAMRole(ctx, ref.ID)
+}
+
 // Delete IAM Role
 func (c Client) DeleteIAMRole(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/iam-role/%v", id)
@@ -11198,23 +10694,11 @@ func (c Client) DeleteIAMRole(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-iam-role", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteIAMRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteIAMRole: http response: %w", err)
 	}
@@ -11224,6 +10708,10 @@ func (c Client) DeleteIAMRole(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteIAMRole: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteIAMRole: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -11242,23 +10730,11 @@ func (c Client) GetIAMRole(ctx context.Context, id UUID) (*IAMRole, error) {
 		return nil, fmt.Errorf("GetIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-iam-role", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetIAMRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetIAMRole: http response: %w", err)
 	}
@@ -11268,6 +10744,10 @@ func (c Client) GetIAMRole(ctx context.Context, id UUID) (*IAMRole, error) {
 		return nil, fmt.Errorf("GetIAMRole: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetIAMRole: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -11285,6 +10765,10 @@ type UpdateIAMRoleRequest struct {
 func (c Client) UpdateIAMRole(ctx context.Context, id UUID, req UpdateIAMRoleRequest) (*Operation, error) {
 	path := fmt.Sprintf("/iam-role/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateIAMRole: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMRole: prepare Json body: %w", err)
@@ -11303,23 +10787,11 @@ func (c Client) UpdateIAMRole(ctx context.Context, id UUID, req UpdateIAMRoleReq
 		return nil, fmt.Errorf("UpdateIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-iam-role", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateIAMRole: http response: %w", err)
 	}
@@ -11329,13 +10801,37 @@ func (c Client) UpdateIAMRole(ctx context.Context, id UUID, req UpdateIAMRoleReq
 		return nil, fmt.Errorf("UpdateIAMRole: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateIAMRole: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateIAMRoleAndWait calls UpdateIAMRole, waits for the resulting operation to succeed
+// and returns the IAMRole referenced by the operation.
+func (c Client) UpdateIAMRoleAndWait(ctx context.Context, id UUID, req UpdateIAMRoleRequest, opts ...WaitOpt) (*IAMRole, error) {
+	op, err := c.UpdateIAMRole(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-iam-role", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateIAMRoleAndWait: %w", err)
+	}
+
+	return c.GetIAMRole(ctx, ref.ID)
+}
+
 // Update IAM Assume role Policy
 func (c Client) UpdateIAMRoleAssumePolicy(ctx context.Context, id UUID, req IAMPolicy) (*Operation, error) {
 	path := fmt.Sprintf("/iam-role/%v:assume-role-policy", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateIAMRoleAssumePolicy: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMRoleAssumePolicy: prepare Json body: %w", err)
@@ -11354,23 +10850,11 @@ func (c Client) UpdateIAMRoleAssumePolicy(ctx context.Context, id UUID, req IAMP
 		return nil, fmt.Errorf("UpdateIAMRoleAssumePolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRoleAssumePolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-iam-role-assume-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-iam-role-assume-policy", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMRoleAssumePolicy: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateIAMRoleAssumePolicy: http response: %w", err)
 	}
@@ -11380,13 +10864,37 @@ func (c Client) UpdateIAMRoleAssumePolicy(ctx context.Context, id UUID, req IAMP
 		return nil, fmt.Errorf("UpdateIAMRoleAssumePolicy: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateIAMRoleAssumePolicy: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateIAMRoleAssumePolicyAndWait calls UpdateIAMRoleAssumePolicy, waits for the resulting operation to succeed
+// and returns the IAMRole referenced by the operation.
+func (c Client) UpdateIAMRoleAssumePolicyAndWait(ctx context.Context, id UUID, req IAMPolicy, opts ...WaitOpt) (*IAMRole, error) {
+	op, err := c.UpdateIAMRoleAssumePolicy(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-iam-role", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateIAMRoleAssumePolicyAndWait: %w", err)
+	}
+
+	return c.GetIAMRole(ctx, ref.ID)
+}
+
 // Update IAM Role Policy
 func (c Client) UpdateIAMRolePolicy(ctx context.Context, id UUID, req IAMPolicy) (*Operation, error) {
 	path := fmt.Sprintf("/iam-role/%v:policy", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateIAMRolePolicy: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMRolePolicy: prepare Json body: %w", err)
@@ -11405,23 +10913,11 @@ func (c Client) UpdateIAMRolePolicy(ctx context.Context, id UUID, req IAMPolicy)
 		return nil, fmt.Errorf("UpdateIAMRolePolicy: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateIAMRolePolicy: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-iam-role-policy")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-iam-role-policy", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateIAMRolePolicy: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateIAMRolePolicy: http response: %w", err)
 	}
@@ -11431,9 +10927,29 @@ func (c Client) UpdateIAMRolePolicy(ctx context.Context, id UUID, req IAMPolicy)
 		return nil, fmt.Errorf("UpdateIAMRolePolicy: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateIAMRolePolicy: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateIAMRolePolicyAndWait calls UpdateIAMRolePolicy, waits for the resulting operation to succeed
+// and returns the IAMRole referenced by the operation.
+func (c Client) UpdateIAMRolePolicyAndWait(ctx context.Context, id UUID, req IAMPolicy, opts ...WaitOpt) (*IAMRole, error) {
+	op, err := c.UpdateIAMRolePolicy(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-iam-role", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateIAMRolePolicyAndWait: %w", err)
+	}
+
+	return c.GetIAMRole(ctx, ref.ID)
+}
+
 type AssumeIAMRoleResponse struct {
 	Key    string `json:"key,omitempty"`
 	Name   string `json:"name,omitempty"`
@@ -11451,6 +10967,10 @@ type AssumeIAMRoleRequest struct {
 func (c Client) AssumeIAMRole(ctx context.Context, targetRoleID UUID, req AssumeIAMRoleRequest) (*AssumeIAMRoleResponse, error) {
 	path := fmt.Sprintf("/iam-role/%v/assume", targetRoleID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("AssumeIAMRole: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("AssumeIAMRole: prepare Json body: %w", err)
@@ -11469,23 +10989,11 @@ func (c Client) AssumeIAMRole(ctx context.Context, targetRoleID UUID, req Assume
 		return nil, fmt.Errorf("AssumeIAMRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AssumeIAMRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "assume-iam-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "assume-iam-role", request)
 	if err != nil {
 		return nil, fmt.Errorf("AssumeIAMRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AssumeIAMRole: http response: %w", err)
 	}
@@ -11495,6 +11003,10 @@ func (c Client) AssumeIAMRole(ctx context.Context, targetRoleID UUID, req Assume
 		return nil, fmt.Errorf("AssumeIAMRole: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AssumeIAMRole: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -11617,23 +11129,11 @@ func (c Client) ListInstances(ctx context.Context, opts ...ListInstancesOpt) (*L
 		return nil, fmt.Errorf("ListInstances: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListInstances: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-instances")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-instances", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListInstances: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListInstances: http response: %w", err)
 	}
@@ -11643,7 +11143,30 @@ func (c Client) ListInstances(ctx context.Context, opts ...ListInstancesOpt) (*L
 		return nil, fmt.Errorf("ListInstances: prepare Json response: %w", err)
 	}
 
-	return bodyresp, nil
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListInstances: validate response: %w", err)
+	}
+
+	return bodyresp, nil
+}
+
+// AllInstances returns an iterator over the ListInstancesResponseInstances items returned by ListInstances.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllInstances(ctx context.Context, opts ...ListInstancesOpt) iter.Seq2[ListInstancesResponseInstances, error] {
+	return func(yield func(ListInstancesResponseInstances, error) bool) {
+		resp, err := c.ListInstances(ctx, opts...)
+		if err != nil {
+			var zero ListInstancesResponseInstances
+			yield(zero, fmt.Errorf("AllInstances: %w", err))
+			return
+		}
+
+		for _, item := range resp.Instances {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
 }
 
 type CreateInstanceRequest struct {
@@ -11685,6 +11208,10 @@ type CreateInstanceRequest struct {
 func (c Client) CreateInstance(ctx context.Context, req CreateInstanceRequest) (*Operation, error) {
 	path := "/instance"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateInstance: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateInstance: prepare Json body: %w", err)
@@ -11703,23 +11230,11 @@ func (c Client) CreateInstance(ctx context.Context, req CreateInstanceRequest) (
 		return nil, fmt.Errorf("CreateInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateInstance: http response: %w", err)
 	}
@@ -11729,9 +11244,29 @@ func (c Client) CreateInstance(ctx context.Context, req CreateInstanceRequest) (
 		return nil, fmt.Errorf("CreateInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateInstanceAndWait calls CreateInstance, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) CreateInstanceAndWait(ctx context.Context, req CreateInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.CreateInstance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateInstanceAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 type ListInstancePoolsResponse struct {
 	InstancePools []InstancePool `json:"instance-pools,omitempty"`
 }
@@ -11770,23 +11305,11 @@ func (c Client) ListInstancePools(ctx context.Context) (*ListInstancePoolsRespon
 		return nil, fmt.Errorf("ListInstancePools: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListInstancePools: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-instance-pools")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-instance-pools", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListInstancePools: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListInstancePools: http response: %w", err)
 	}
@@ -11796,9 +11319,32 @@ func (c Client) ListInstancePools(ctx context.Context) (*ListInstancePoolsRespon
 		return nil, fmt.Errorf("ListInstancePools: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListInstancePools: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllInstancePools returns an iterator over the InstancePool items returned by ListInstancePools.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllInstancePools(ctx context.Context) iter.Seq2[InstancePool, error] {
+	return func(yield func(InstancePool, error) bool) {
+		resp, err := c.ListInstancePools(ctx)
+		if err != nil {
+			var zero InstancePool
+			yield(zero, fmt.Errorf("AllInstancePools: %w", err))
+			return
+		}
+
+		for _, item := range resp.InstancePools {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateInstancePoolRequestPublicIPAssignment string
 
 const (
@@ -11853,6 +11399,10 @@ type CreateInstancePoolRequest struct {
 func (c Client) CreateInstancePool(ctx context.Context, req CreateInstancePoolRequest) (*Operation, error) {
 	path := "/instance-pool"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateInstancePool: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateInstancePool: prepare Json body: %w", err)
@@ -11871,23 +11421,11 @@ func (c Client) CreateInstancePool(ctx context.Context, req CreateInstancePoolRe
 		return nil, fmt.Errorf("CreateInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-instance-pool", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateInstancePool: http response: %w", err)
 	}
@@ -11897,9 +11435,29 @@ func (c Client) CreateInstancePool(ctx context.Context, req CreateInstancePoolRe
 		return nil, fmt.Errorf("CreateInstancePool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateInstancePool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateInstancePoolAndWait calls CreateInstancePool, waits for the resulting operation to succeed
+// and returns the InstancePool referenced by the operation.
+func (c Client) CreateInstancePoolAndWait(ctx context.Context, req CreateInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error) {
+	op, err := c.CreateInstancePool(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance-pool", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateInstancePoolAndWait: %w", err)
+	}
+
+	return c.GetInstancePool(ctx, ref.ID)
+}
+
 // Delete an Instance Pool
 func (c Client) DeleteInstancePool(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/instance-pool/%v", id)
@@ -11915,23 +11473,11 @@ func (c Client) DeleteInstancePool(ctx context.Context, id UUID) (*Operation, er
 		return nil, fmt.Errorf("DeleteInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-instance-pool", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteInstancePool: http response: %w", err)
 	}
@@ -11941,6 +11487,10 @@ func (c Client) DeleteInstancePool(ctx context.Context, id UUID) (*Operation, er
 		return nil, fmt.Errorf("DeleteInstancePool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteInstancePool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -11959,23 +11509,11 @@ func (c Client) GetInstancePool(ctx context.Context, id UUID) (*InstancePool, er
 		return nil, fmt.Errorf("GetInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-instance-pool", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetInstancePool: http response: %w", err)
 	}
@@ -11985,6 +11523,10 @@ func (c Client) GetInstancePool(ctx context.Context, id UUID) (*InstancePool, er
 		return nil, fmt.Errorf("GetInstancePool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetInstancePool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -12039,6 +11581,10 @@ type UpdateInstancePoolRequest struct {
 func (c Client) UpdateInstancePool(ctx context.Context, id UUID, req UpdateInstancePoolRequest) (*Operation, error) {
 	path := fmt.Sprintf("/instance-pool/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateInstancePool: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateInstancePool: prepare Json body: %w", err)
@@ -12057,23 +11603,11 @@ func (c Client) UpdateInstancePool(ctx context.Context, id UUID, req UpdateInsta
 		return nil, fmt.Errorf("UpdateInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-instance-pool", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateInstancePool: http response: %w", err)
 	}
@@ -12083,9 +11617,29 @@ func (c Client) UpdateInstancePool(ctx context.Context, id UUID, req UpdateInsta
 		return nil, fmt.Errorf("UpdateInstancePool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateInstancePool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateInstancePoolAndWait calls UpdateInstancePool, waits for the resulting operation to succeed
+// and returns the InstancePool referenced by the operation.
+func (c Client) UpdateInstancePoolAndWait(ctx context.Context, id UUID, req UpdateInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error) {
+	op, err := c.UpdateInstancePool(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance-pool", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateInstancePoolAndWait: %w", err)
+	}
+
+	return c.GetInstancePool(ctx, ref.ID)
+}
+
 type ResetInstancePoolFieldField string
 
 const (
@@ -12116,23 +11670,11 @@ func (c Client) ResetInstancePoolField(ctx context.Context, id UUID, field Reset
 		return nil, fmt.Errorf("ResetInstancePoolField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstancePoolField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance-pool-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-instance-pool-field", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetInstancePoolField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetInstancePoolField: http response: %w", err)
 	}
@@ -12142,6 +11684,10 @@ func (c Client) ResetInstancePoolField(ctx context.Context, id UUID, field Reset
 		return nil, fmt.Errorf("ResetInstancePoolField: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetInstancePoolField: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -12153,6 +11699,10 @@ type EvictInstancePoolMembersRequest struct {
 func (c Client) EvictInstancePoolMembers(ctx context.Context, id UUID, req EvictInstancePoolMembersRequest) (*Operation, error) {
 	path := fmt.Sprintf("/instance-pool/%v:evict", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("EvictInstancePoolMembers: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("EvictInstancePoolMembers: prepare Json body: %w", err)
@@ -12171,23 +11721,11 @@ func (c Client) EvictInstancePoolMembers(ctx context.Context, id UUID, req Evict
 		return nil, fmt.Errorf("EvictInstancePoolMembers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EvictInstancePoolMembers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "evict-instance-pool-members")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "evict-instance-pool-members", request)
 	if err != nil {
 		return nil, fmt.Errorf("EvictInstancePoolMembers: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("EvictInstancePoolMembers: http response: %w", err)
 	}
@@ -12197,9 +11735,29 @@ func (c Client) EvictInstancePoolMembers(ctx context.Context, id UUID, req Evict
 		return nil, fmt.Errorf("EvictInstancePoolMembers: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("EvictInstancePoolMembers: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// EvictInstancePoolMembersAndWait calls EvictInstancePoolMembers, waits for the resulting operation to succeed
+// and returns the InstancePool referenced by the operation.
+func (c Client) EvictInstancePoolMembersAndWait(ctx context.Context, id UUID, req EvictInstancePoolMembersRequest, opts ...WaitOpt) (*InstancePool, error) {
+	op, err := c.EvictInstancePoolMembers(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance-pool", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("EvictInstancePoolMembersAndWait: %w", err)
+	}
+
+	return c.GetInstancePool(ctx, ref.ID)
+}
+
 type ScaleInstancePoolRequest struct {
 	// Number of managed Instances
 	Size int64 `json:"size" validate:"required,gte=0"`
@@ -12209,6 +11767,10 @@ type ScaleInstancePoolRequest struct {
 func (c Client) ScaleInstancePool(ctx context.Context, id UUID, req ScaleInstancePoolRequest) (*Operation, error) {
 	path := fmt.Sprintf("/instance-pool/%v:scale", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ScaleInstancePool: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ScaleInstancePool: prepare Json body: %w", err)
@@ -12227,23 +11789,11 @@ func (c Client) ScaleInstancePool(ctx context.Context, id UUID, req ScaleInstanc
 		return nil, fmt.Errorf("ScaleInstancePool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleInstancePool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-instance-pool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "scale-instance-pool", request)
 	if err != nil {
 		return nil, fmt.Errorf("ScaleInstancePool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ScaleInstancePool: http response: %w", err)
 	}
@@ -12253,9 +11803,29 @@ func (c Client) ScaleInstancePool(ctx context.Context, id UUID, req ScaleInstanc
 		return nil, fmt.Errorf("ScaleInstancePool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ScaleInstancePool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// ScaleInstancePoolAndWait calls ScaleInstancePool, waits for the resulting operation to succeed
+// and returns the InstancePool referenced by the operation.
+func (c Client) ScaleInstancePoolAndWait(ctx context.Context, id UUID, req ScaleInstancePoolRequest, opts ...WaitOpt) (*InstancePool, error) {
+	op, err := c.ScaleInstancePool(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance-pool", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ScaleInstancePoolAndWait: %w", err)
+	}
+
+	return c.GetInstancePool(ctx, ref.ID)
+}
+
 type ListInstanceTypesResponse struct {
 	InstanceTypes []InstanceType `json:"instance-types,omitempty"`
 }
@@ -12294,23 +11864,11 @@ func (c Client) ListInstanceTypes(ctx context.Context) (*ListInstanceTypesRespon
 		return nil, fmt.Errorf("ListInstanceTypes: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListInstanceTypes: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-instance-types")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-instance-types", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListInstanceTypes: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListInstanceTypes: http response: %w", err)
 	}
@@ -12320,9 +11878,32 @@ func (c Client) ListInstanceTypes(ctx context.Context) (*ListInstanceTypesRespon
 		return nil, fmt.Errorf("ListInstanceTypes: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListInstanceTypes: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllInstanceTypes returns an iterator over the InstanceType items returned by ListInstanceTypes.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllInstanceTypes(ctx context.Context) iter.Seq2[InstanceType, error] {
+	return func(yield func(InstanceType, error) bool) {
+		resp, err := c.ListInstanceTypes(ctx)
+		if err != nil {
+			var zero InstanceType
+			yield(zero, fmt.Errorf("AllInstanceTypes: %w", err))
+			return
+		}
+
+		for _, item := range resp.InstanceTypes {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Retrieve Instance Type details
 func (c Client) GetInstanceType(ctx context.Context, id UUID) (*InstanceType, error) {
 	path := fmt.Sprintf("/instance-type/%v", id)
@@ -12338,23 +11919,11 @@ func (c Client) GetInstanceType(ctx context.Context, id UUID) (*InstanceType, er
 		return nil, fmt.Errorf("GetInstanceType: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInstanceType: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-instance-type")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-instance-type", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetInstanceType: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetInstanceType: http response: %w", err)
 	}
@@ -12364,6 +11933,10 @@ func (c Client) GetInstanceType(ctx context.Context, id UUID) (*InstanceType, er
 		return nil, fmt.Errorf("GetInstanceType: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetInstanceType: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -12382,23 +11955,11 @@ func (c Client) DeleteInstance(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteInstance: http response: %w", err)
 	}
@@ -12408,6 +11969,10 @@ func (c Client) DeleteInstance(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -12426,23 +11991,11 @@ func (c Client) GetInstance(ctx context.Context, id UUID) (*Instance, error) {
 		return nil, fmt.Errorf("GetInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetInstance: http response: %w", err)
 	}
@@ -12452,6 +12005,10 @@ func (c Client) GetInstance(ctx context.Context, id UUID) (*Instance, error) {
 		return nil, fmt.Errorf("GetInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -12470,6 +12027,10 @@ type UpdateInstanceRequest struct {
 func (c Client) UpdateInstance(ctx context.Context, id UUID, req UpdateInstanceRequest) (*Operation, error) {
 	path := fmt.Sprintf("/instance/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateInstance: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateInstance: prepare Json body: %w", err)
@@ -12488,23 +12049,11 @@ func (c Client) UpdateInstance(ctx context.Context, id UUID, req UpdateInstanceR
 		return nil, fmt.Errorf("UpdateInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateInstance: http response: %w", err)
 	}
@@ -12514,9 +12063,29 @@ func (c Client) UpdateInstance(ctx context.Context, id UUID, req UpdateInstanceR
 		return nil, fmt.Errorf("UpdateInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateInstanceAndWait calls UpdateInstance, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) UpdateInstanceAndWait(ctx context.Context, id UUID, req UpdateInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.UpdateInstance(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateInstanceAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 type ResetInstanceFieldField string
 
 const (
@@ -12538,23 +12107,11 @@ func (c Client) ResetInstanceField(ctx context.Context, id UUID, field ResetInst
 		return nil, fmt.Errorf("ResetInstanceField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstanceField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-instance-field", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetInstanceField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetInstanceField: http response: %w", err)
 	}
@@ -12564,6 +12121,10 @@ func (c Client) ResetInstanceField(ctx context.Context, id UUID, field ResetInst
 		return nil, fmt.Errorf("ResetInstanceField: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetInstanceField: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -12582,23 +12143,11 @@ func (c Client) AddInstanceProtection(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("AddInstanceProtection: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddInstanceProtection: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-instance-protection")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "add-instance-protection", request)
 	if err != nil {
 		return nil, fmt.Errorf("AddInstanceProtection: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AddInstanceProtection: http response: %w", err)
 	}
@@ -12608,9 +12157,29 @@ func (c Client) AddInstanceProtection(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("AddInstanceProtection: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AddInstanceProtection: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AddInstanceProtectionAndWait calls AddInstanceProtection, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) AddInstanceProtectionAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.AddInstanceProtection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("AddInstanceProtectionAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 // Create a Snapshot of a Compute instance
 func (c Client) CreateSnapshot(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/instance/%v:create-snapshot", id)
@@ -12626,23 +12195,11 @@ func (c Client) CreateSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("CreateSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-snapshot", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateSnapshot: http response: %w", err)
 	}
@@ -12652,6 +12209,10 @@ func (c Client) CreateSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("CreateSnapshot: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateSnapshot: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -12670,23 +12231,11 @@ func (c Client) EnableTpm(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("EnableTpm: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EnableTpm: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "enable-tpm")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "enable-tpm", request)
 	if err != nil {
 		return nil, fmt.Errorf("EnableTpm: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("EnableTpm: http response: %w", err)
 	}
@@ -12696,9 +12245,29 @@ func (c Client) EnableTpm(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("EnableTpm: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("EnableTpm: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// EnableTpmAndWait calls EnableTpm, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) EnableTpmAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.EnableTpm(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("EnableTpmAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 // Reveal the password used during instance creation or the latest password reset.
 // This is only available for VMs created against templates having the `password-enabled`
 // property set to `true`.
@@ -12718,23 +12287,11 @@ func (c Client) RevealInstancePassword(ctx context.Context, id UUID) (*InstanceP
 		return nil, fmt.Errorf("RevealInstancePassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevealInstancePassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reveal-instance-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reveal-instance-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevealInstancePassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevealInstancePassword: http response: %w", err)
 	}
@@ -12744,6 +12301,10 @@ func (c Client) RevealInstancePassword(ctx context.Context, id UUID) (*InstanceP
 		return nil, fmt.Errorf("RevealInstancePassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevealInstancePassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -12762,33 +12323,41 @@ func (c Client) RebootInstance(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("RebootInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RebootInstance: sign request: %w", err)
+	response, err := c.do(ctx, "reboot-instance", request)
+	if err != nil {
+		return nil, fmt.Errorf("RebootInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpRequest(request, "reboot-instance")
+	if err := handleHTTPErrorResp(response); err != nil {
+		return nil, fmt.Errorf("RebootInstance: http response: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
-	if err != nil {
-		return nil, fmt.Errorf("RebootInstance: http client do: %w", err)
+	bodyresp := new(Operation)
+	if err := prepareJSONResponse(response, bodyresp); err != nil {
+		return nil, fmt.Errorf("RebootInstance: prepare Json response: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RebootInstance: validate response: %w", err)
 	}
 
-	if err := handleHTTPErrorResp(response); err != nil {
-		return nil, fmt.Errorf("RebootInstance: http response: %w", err)
+	return bodyresp, nil
+}
+
+// RebootInstanceAndWait calls RebootInstance, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) RebootInstanceAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.RebootInstance(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	bodyresp := new(Operation)
-	if err := prepareJSONResponse(response, bodyresp); err != nil {
-		return nil, fmt.Errorf("RebootInstance: prepare Json response: %w", err)
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("RebootInstanceAndWait: %w", err)
 	}
 
-	return bodyresp, nil
+	return c.GetInstance(ctx, ref.ID)
 }
 
 // Remove instance destruction protection
@@ -12806,23 +12375,11 @@ func (c Client) RemoveInstanceProtection(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("RemoveInstanceProtection: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RemoveInstanceProtection: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "remove-instance-protection")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "remove-instance-protection", request)
 	if err != nil {
 		return nil, fmt.Errorf("RemoveInstanceProtection: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RemoveInstanceProtection: http response: %w", err)
 	}
@@ -12832,9 +12389,29 @@ func (c Client) RemoveInstanceProtection(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("RemoveInstanceProtection: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RemoveInstanceProtection: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// RemoveInstanceProtectionAndWait calls RemoveInstanceProtection, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) RemoveInstanceProtectionAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.RemoveInstanceProtection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("RemoveInstanceProtectionAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 type ResetInstanceRequest struct {
 	// Instance disk size in GiB
 	DiskSize int64 `json:"disk-size,omitempty" validate:"omitempty,gte=10,lte=51200"`
@@ -12846,6 +12423,10 @@ type ResetInstanceRequest struct {
 func (c Client) ResetInstance(ctx context.Context, id UUID, req ResetInstanceRequest) (*Operation, error) {
 	path := fmt.Sprintf("/instance/%v:reset", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ResetInstance: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ResetInstance: prepare Json body: %w", err)
@@ -12864,23 +12445,11 @@ func (c Client) ResetInstance(ctx context.Context, id UUID, req ResetInstanceReq
 		return nil, fmt.Errorf("ResetInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetInstance: http response: %w", err)
 	}
@@ -12890,9 +12459,29 @@ func (c Client) ResetInstance(ctx context.Context, id UUID, req ResetInstanceReq
 		return nil, fmt.Errorf("ResetInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// ResetInstanceAndWait calls ResetInstance, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) ResetInstanceAndWait(ctx context.Context, id UUID, req ResetInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.ResetInstance(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ResetInstanceAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 // Reset a compute instance password
 func (c Client) ResetInstancePassword(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/instance/%v:reset-password", id)
@@ -12908,23 +12497,11 @@ func (c Client) ResetInstancePassword(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("ResetInstancePassword: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetInstancePassword: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-instance-password")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-instance-password", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetInstancePassword: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetInstancePassword: http response: %w", err)
 	}
@@ -12934,9 +12511,29 @@ func (c Client) ResetInstancePassword(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("ResetInstancePassword: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetInstancePassword: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// ResetInstancePasswordAndWait calls ResetInstancePassword, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) ResetInstancePasswordAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.ResetInstancePassword(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ResetInstancePasswordAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 type ResizeInstanceDiskRequest struct {
 	// Instance disk size in GiB
 	DiskSize int64 `json:"disk-size" validate:"required,gte=10,lte=51200"`
@@ -12946,6 +12543,10 @@ type ResizeInstanceDiskRequest struct {
 func (c Client) ResizeInstanceDisk(ctx context.Context, id UUID, req ResizeInstanceDiskRequest) (*Operation, error) {
 	path := fmt.Sprintf("/instance/%v:resize-disk", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ResizeInstanceDisk: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ResizeInstanceDisk: prepare Json body: %w", err)
@@ -12964,23 +12565,11 @@ func (c Client) ResizeInstanceDisk(ctx context.Context, id UUID, req ResizeInsta
 		return nil, fmt.Errorf("ResizeInstanceDisk: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResizeInstanceDisk: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "resize-instance-disk")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "resize-instance-disk", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResizeInstanceDisk: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResizeInstanceDisk: http response: %w", err)
 	}
@@ -12990,9 +12579,29 @@ func (c Client) ResizeInstanceDisk(ctx context.Context, id UUID, req ResizeInsta
 		return nil, fmt.Errorf("ResizeInstanceDisk: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResizeInstanceDisk: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// ResizeInstanceDiskAndWait calls ResizeInstanceDisk, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) ResizeInstanceDiskAndWait(ctx context.Context, id UUID, req ResizeInstanceDiskRequest, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.ResizeInstanceDisk(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ResizeInstanceDiskAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 type ScaleInstanceRequest struct {
 	// Instance type reference
 	InstanceType *InstanceType `json:"instance-type" validate:"required"`
@@ -13002,6 +12611,10 @@ type ScaleInstanceRequest struct {
 func (c Client) ScaleInstance(ctx context.Context, id UUID, req ScaleInstanceRequest) (*Operation, error) {
 	path := fmt.Sprintf("/instance/%v:scale", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ScaleInstance: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ScaleInstance: prepare Json body: %w", err)
@@ -13020,23 +12633,11 @@ func (c Client) ScaleInstance(ctx context.Context, id UUID, req ScaleInstanceReq
 		return nil, fmt.Errorf("ScaleInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "scale-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("ScaleInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ScaleInstance: http response: %w", err)
 	}
@@ -13046,9 +12647,29 @@ func (c Client) ScaleInstance(ctx context.Context, id UUID, req ScaleInstanceReq
 		return nil, fmt.Errorf("ScaleInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ScaleInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// ScaleInstanceAndWait calls ScaleInstance, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) ScaleInstanceAndWait(ctx context.Context, id UUID, req ScaleInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.ScaleInstance(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ScaleInstanceAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 type StartInstanceRequestRescueProfile string
 
 const (
@@ -13065,6 +12686,10 @@ type StartInstanceRequest struct {
 func (c Client) StartInstance(ctx context.Context, id UUID, req StartInstanceRequest) (*Operation, error) {
 	path := fmt.Sprintf("/instance/%v:start", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("StartInstance: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("StartInstance: prepare Json body: %w", err)
@@ -13083,23 +12708,11 @@ func (c Client) StartInstance(ctx context.Context, id UUID, req StartInstanceReq
 		return nil, fmt.Errorf("StartInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StartInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "start-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "start-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("StartInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StartInstance: http response: %w", err)
 	}
@@ -13109,9 +12722,29 @@ func (c Client) StartInstance(ctx context.Context, id UUID, req StartInstanceReq
 		return nil, fmt.Errorf("StartInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StartInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// StartInstanceAndWait calls StartInstance, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) StartInstanceAndWait(ctx context.Context, id UUID, req StartInstanceRequest, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.StartInstance(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("StartInstanceAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 // Stop a Compute instance
 func (c Client) StopInstance(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/instance/%v:stop", id)
@@ -13127,23 +12760,11 @@ func (c Client) StopInstance(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("StopInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("StopInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "stop-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "stop-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("StopInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("StopInstance: http response: %w", err)
 	}
@@ -13153,9 +12774,29 @@ func (c Client) StopInstance(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("StopInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("StopInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// StopInstanceAndWait calls StopInstance, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) StopInstanceAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.StopInstance(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("StopInstanceAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 type RevertInstanceToSnapshotRequest struct {
 	// Snapshot ID
 	ID UUID `json:"id" validate:"required"`
@@ -13166,6 +12807,10 @@ type RevertInstanceToSnapshotRequest struct {
 func (c Client) RevertInstanceToSnapshot(ctx context.Context, instanceID UUID, req RevertInstanceToSnapshotRequest) (*Operation, error) {
 	path := fmt.Sprintf("/instance/%v:revert-snapshot", instanceID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("RevertInstanceToSnapshot: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("RevertInstanceToSnapshot: prepare Json body: %w", err)
@@ -13184,23 +12829,11 @@ func (c Client) RevertInstanceToSnapshot(ctx context.Context, instanceID UUID, r
 		return nil, fmt.Errorf("RevertInstanceToSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RevertInstanceToSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "revert-instance-to-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "revert-instance-to-snapshot", request)
 	if err != nil {
 		return nil, fmt.Errorf("RevertInstanceToSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RevertInstanceToSnapshot: http response: %w", err)
 	}
@@ -13210,9 +12843,29 @@ func (c Client) RevertInstanceToSnapshot(ctx context.Context, instanceID UUID, r
 		return nil, fmt.Errorf("RevertInstanceToSnapshot: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RevertInstanceToSnapshot: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// RevertInstanceToSnapshotAndWait calls RevertInstanceToSnapshot, waits for the resulting operation to succeed
+// and returns the Instance referenced by the operation.
+func (c Client) RevertInstanceToSnapshotAndWait(ctx context.Context, instanceID UUID, req RevertInstanceToSnapshotRequest, opts ...WaitOpt) (*Instance, error) {
+	op, err := c.RevertInstanceToSnapshot(ctx, instanceID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-instance", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("RevertInstanceToSnapshotAndWait: %w", err)
+	}
+
+	return c.GetInstance(ctx, ref.ID)
+}
+
 // FindListKmsKeysResponseEntry attempts to find an ListKmsKeysResponseEntry by nameOrID.
 func (l ListKmsKeysResponse) FindListKmsKeysResponseEntry(nameOrID string) (ListKmsKeysResponseEntry, error) {
 	var result []ListKmsKeysResponseEntry
@@ -13247,23 +12900,11 @@ func (c Client) ListKmsKeys(ctx context.Context) (*ListKmsKeysResponse, error) {
 		return nil, fmt.Errorf("ListKmsKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListKmsKeys: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-kms-keys")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-kms-keys", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListKmsKeys: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListKmsKeys: http response: %w", err)
 	}
@@ -13273,13 +12914,40 @@ func (c Client) ListKmsKeys(ctx context.Context) (*ListKmsKeysResponse, error) {
 		return nil, fmt.Errorf("ListKmsKeys: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListKmsKeys: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllKmsKeys returns an iterator over the ListKmsKeysResponseEntry items returned by ListKmsKeys.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllKmsKeys(ctx context.Context) iter.Seq2[ListKmsKeysResponseEntry, error] {
+	return func(yield func(ListKmsKeysResponseEntry, error) bool) {
+		resp, err := c.ListKmsKeys(ctx)
+		if err != nil {
+			var zero ListKmsKeysResponseEntry
+			yield(zero, fmt.Errorf("AllKmsKeys: %w", err))
+			return
+		}
+
+		for _, item := range resp.KmsKeys {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Create a KMS Key in a given zone with a given name.
 func (c Client) CreateKmsKey(ctx context.Context, req CreateKmsKeyRequest) (*CreateKmsKeyResponse, error) {
 	path := "/kms-key"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateKmsKey: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateKmsKey: prepare Json body: %w", err)
@@ -13298,23 +12966,11 @@ func (c Client) CreateKmsKey(ctx context.Context, req CreateKmsKeyRequest) (*Cre
 		return nil, fmt.Errorf("CreateKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-kms-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateKmsKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateKmsKey: http response: %w", err)
 	}
@@ -13324,6 +12980,10 @@ func (c Client) CreateKmsKey(ctx context.Context, req CreateKmsKeyRequest) (*Cre
 		return nil, fmt.Errorf("CreateKmsKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateKmsKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13342,23 +13002,11 @@ func (c Client) GetKmsKey(ctx context.Context, id UUID) (*GetKmsKeyResponse, err
 		return nil, fmt.Errorf("GetKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-kms-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetKmsKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetKmsKey: http response: %w", err)
 	}
@@ -13368,6 +13016,10 @@ func (c Client) GetKmsKey(ctx context.Context, id UUID) (*GetKmsKeyResponse, err
 		return nil, fmt.Errorf("GetKmsKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetKmsKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13386,23 +13038,11 @@ func (c Client) CancelKmsKeyDeletion(ctx context.Context, id UUID) (*SuccessResp
 		return nil, fmt.Errorf("CancelKmsKeyDeletion: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CancelKmsKeyDeletion: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "cancel-kms-key-deletion")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "cancel-kms-key-deletion", request)
 	if err != nil {
 		return nil, fmt.Errorf("CancelKmsKeyDeletion: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CancelKmsKeyDeletion: http response: %w", err)
 	}
@@ -13412,6 +13052,10 @@ func (c Client) CancelKmsKeyDeletion(ctx context.Context, id UUID) (*SuccessResp
 		return nil, fmt.Errorf("CancelKmsKeyDeletion: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CancelKmsKeyDeletion: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13419,6 +13063,10 @@ func (c Client) CancelKmsKeyDeletion(ctx context.Context, id UUID) (*SuccessResp
 func (c Client) Decrypt(ctx context.Context, id UUID, req DecryptRequest) (*DecryptResponse, error) {
 	path := fmt.Sprintf("/kms-key/%v/decrypt", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("Decrypt: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("Decrypt: prepare Json body: %w", err)
@@ -13437,23 +13085,11 @@ func (c Client) Decrypt(ctx context.Context, id UUID, req DecryptRequest) (*Decr
 		return nil, fmt.Errorf("Decrypt: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("Decrypt: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "decrypt")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "decrypt", request)
 	if err != nil {
 		return nil, fmt.Errorf("Decrypt: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("Decrypt: http response: %w", err)
 	}
@@ -13463,6 +13099,10 @@ func (c Client) Decrypt(ctx context.Context, id UUID, req DecryptRequest) (*Decr
 		return nil, fmt.Errorf("Decrypt: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("Decrypt: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13481,23 +13121,11 @@ func (c Client) DisableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, e
 		return nil, fmt.Errorf("DisableKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DisableKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "disable-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "disable-kms-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("DisableKmsKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DisableKmsKey: http response: %w", err)
 	}
@@ -13507,6 +13135,10 @@ func (c Client) DisableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, e
 		return nil, fmt.Errorf("DisableKmsKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DisableKmsKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13525,23 +13157,11 @@ func (c Client) DisableKmsKeyRotation(ctx context.Context, id UUID) (*DisableKms
 		return nil, fmt.Errorf("DisableKmsKeyRotation: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DisableKmsKeyRotation: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "disable-kms-key-rotation")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "disable-kms-key-rotation", request)
 	if err != nil {
 		return nil, fmt.Errorf("DisableKmsKeyRotation: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DisableKmsKeyRotation: http response: %w", err)
 	}
@@ -13551,6 +13171,10 @@ func (c Client) DisableKmsKeyRotation(ctx context.Context, id UUID) (*DisableKms
 		return nil, fmt.Errorf("DisableKmsKeyRotation: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DisableKmsKeyRotation: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13569,23 +13193,11 @@ func (c Client) EnableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, er
 		return nil, fmt.Errorf("EnableKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EnableKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "enable-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "enable-kms-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("EnableKmsKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("EnableKmsKey: http response: %w", err)
 	}
@@ -13595,6 +13207,10 @@ func (c Client) EnableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, er
 		return nil, fmt.Errorf("EnableKmsKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("EnableKmsKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13602,6 +13218,10 @@ func (c Client) EnableKmsKey(ctx context.Context, id UUID) (*SuccessResponse, er
 func (c Client) EnableKmsKeyRotation(ctx context.Context, id UUID, req EnableKmsKeyRotationRequest) (*EnableKmsKeyRotationResponse, error) {
 	path := fmt.Sprintf("/kms-key/%v/enable-key-rotation", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("EnableKmsKeyRotation: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("EnableKmsKeyRotation: prepare Json body: %w", err)
@@ -13620,23 +13240,11 @@ func (c Client) EnableKmsKeyRotation(ctx context.Context, id UUID, req EnableKms
 		return nil, fmt.Errorf("EnableKmsKeyRotation: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EnableKmsKeyRotation: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "enable-kms-key-rotation")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "enable-kms-key-rotation", request)
 	if err != nil {
 		return nil, fmt.Errorf("EnableKmsKeyRotation: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("EnableKmsKeyRotation: http response: %w", err)
 	}
@@ -13646,6 +13254,10 @@ func (c Client) EnableKmsKeyRotation(ctx context.Context, id UUID, req EnableKms
 		return nil, fmt.Errorf("EnableKmsKeyRotation: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("EnableKmsKeyRotation: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13653,6 +13265,10 @@ func (c Client) EnableKmsKeyRotation(ctx context.Context, id UUID, req EnableKms
 func (c Client) Encrypt(ctx context.Context, id UUID, req EncryptRequest) (*EncryptResponse, error) {
 	path := fmt.Sprintf("/kms-key/%v/encrypt", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("Encrypt: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("Encrypt: prepare Json body: %w", err)
@@ -13671,23 +13287,11 @@ func (c Client) Encrypt(ctx context.Context, id UUID, req EncryptRequest) (*Encr
 		return nil, fmt.Errorf("Encrypt: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("Encrypt: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "encrypt")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "encrypt", request)
 	if err != nil {
 		return nil, fmt.Errorf("Encrypt: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("Encrypt: http response: %w", err)
 	}
@@ -13697,6 +13301,10 @@ func (c Client) Encrypt(ctx context.Context, id UUID, req EncryptRequest) (*Encr
 		return nil, fmt.Errorf("Encrypt: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("Encrypt: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13704,6 +13312,10 @@ func (c Client) Encrypt(ctx context.Context, id UUID, req EncryptRequest) (*Encr
 func (c Client) GenerateDataKey(ctx context.Context, id UUID, req GenerateDataKeyRequest) (*GenerateDataKeyResponse, error) {
 	path := fmt.Sprintf("/kms-key/%v/generate-data-key", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("GenerateDataKey: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("GenerateDataKey: prepare Json body: %w", err)
@@ -13722,23 +13334,11 @@ func (c Client) GenerateDataKey(ctx context.Context, id UUID, req GenerateDataKe
 		return nil, fmt.Errorf("GenerateDataKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GenerateDataKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "generate-data-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "generate-data-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("GenerateDataKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GenerateDataKey: http response: %w", err)
 	}
@@ -13748,6 +13348,10 @@ func (c Client) GenerateDataKey(ctx context.Context, id UUID, req GenerateDataKe
 		return nil, fmt.Errorf("GenerateDataKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GenerateDataKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13762,27 +13366,15 @@ func (c Client) ListKmsKeyRotations(ctx context.Context, id UUID) (*ListKmsKeyRo
 
 	request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("ListKmsKeyRotations: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListKmsKeyRotations: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-kms-key-rotations")
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("ListKmsKeyRotations: execute request editors: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-kms-key-rotations", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListKmsKeyRotations: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListKmsKeyRotations: http response: %w", err)
 	}
@@ -13792,13 +13384,40 @@ func (c Client) ListKmsKeyRotations(ctx context.Context, id UUID) (*ListKmsKeyRo
 		return nil, fmt.Errorf("ListKmsKeyRotations: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListKmsKeyRotations: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllKmsKeyRotations returns an iterator over the ListKmsKeyRotationsResponseEntry items returned by ListKmsKeyRotations.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllKmsKeyRotations(ctx context.Context, id UUID) iter.Seq2[ListKmsKeyRotationsResponseEntry, error] {
+	return func(yield func(ListKmsKeyRotationsResponseEntry, error) bool) {
+		resp, err := c.ListKmsKeyRotations(ctx, id)
+		if err != nil {
+			var zero ListKmsKeyRotationsResponseEntry
+			yield(zero, fmt.Errorf("AllKmsKeyRotations: %w", err))
+			return
+		}
+
+		for _, item := range resp.Rotations {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Decrypts an existing ciphertext using its original key material and re-encrypts the underlying plaintext using a specified KMS key or the latest key material of the same KMS Key.
 func (c Client) ReEncrypt(ctx context.Context, id UUID, req ReEncryptRequest) (*ReEncryptResponse, error) {
 	path := fmt.Sprintf("/kms-key/%v/re-encrypt", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ReEncrypt: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ReEncrypt: prepare Json body: %w", err)
@@ -13817,23 +13436,11 @@ func (c Client) ReEncrypt(ctx context.Context, id UUID, req ReEncryptRequest) (*
 		return nil, fmt.Errorf("ReEncrypt: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ReEncrypt: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "re-encrypt")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "re-encrypt", request)
 	if err != nil {
 		return nil, fmt.Errorf("ReEncrypt: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ReEncrypt: http response: %w", err)
 	}
@@ -13843,6 +13450,10 @@ func (c Client) ReEncrypt(ctx context.Context, id UUID, req ReEncryptRequest) (*
 		return nil, fmt.Errorf("ReEncrypt: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ReEncrypt: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13850,6 +13461,10 @@ func (c Client) ReEncrypt(ctx context.Context, id UUID, req ReEncryptRequest) (*
 func (c Client) ReplicateKmsKey(ctx context.Context, id UUID, req ReplicateKmsKeyRequest) (*SuccessResponse, error) {
 	path := fmt.Sprintf("/kms-key/%v/replicate", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ReplicateKmsKey: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ReplicateKmsKey: prepare Json body: %w", err)
@@ -13868,23 +13483,11 @@ func (c Client) ReplicateKmsKey(ctx context.Context, id UUID, req ReplicateKmsKe
 		return nil, fmt.Errorf("ReplicateKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ReplicateKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "replicate-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "replicate-kms-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("ReplicateKmsKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ReplicateKmsKey: http response: %w", err)
 	}
@@ -13894,6 +13497,10 @@ func (c Client) ReplicateKmsKey(ctx context.Context, id UUID, req ReplicateKmsKe
 		return nil, fmt.Errorf("ReplicateKmsKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ReplicateKmsKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13912,23 +13519,11 @@ func (c Client) RotateKmsKey(ctx context.Context, id UUID) (*RotateKmsKeyRespons
 		return nil, fmt.Errorf("RotateKmsKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateKmsKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-kms-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "rotate-kms-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("RotateKmsKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RotateKmsKey: http response: %w", err)
 	}
@@ -13938,6 +13533,10 @@ func (c Client) RotateKmsKey(ctx context.Context, id UUID) (*RotateKmsKeyRespons
 		return nil, fmt.Errorf("RotateKmsKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateKmsKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -13945,6 +13544,10 @@ func (c Client) RotateKmsKey(ctx context.Context, id UUID) (*RotateKmsKeyRespons
 func (c Client) ScheduleKmsKeyDeletion(ctx context.Context, id UUID, req ScheduleKmsKeyDeletionRequest) (*SuccessResponse, error) {
 	path := fmt.Sprintf("/kms-key/%v/schedule-deletion", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: prepare Json body: %w", err)
@@ -13963,23 +13566,11 @@ func (c Client) ScheduleKmsKeyDeletion(ctx context.Context, id UUID, req Schedul
 		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "schedule-kms-key-deletion")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "schedule-kms-key-deletion", request)
 	if err != nil {
 		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: http response: %w", err)
 	}
@@ -13989,6 +13580,10 @@ func (c Client) ScheduleKmsKeyDeletion(ctx context.Context, id UUID, req Schedul
 		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ScheduleKmsKeyDeletion: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14030,23 +13625,11 @@ func (c Client) ListLoadBalancers(ctx context.Context) (*ListLoadBalancersRespon
 		return nil, fmt.Errorf("ListLoadBalancers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListLoadBalancers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-load-balancers")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-load-balancers", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListLoadBalancers: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListLoadBalancers: http response: %w", err)
 	}
@@ -14056,9 +13639,32 @@ func (c Client) ListLoadBalancers(ctx context.Context) (*ListLoadBalancersRespon
 		return nil, fmt.Errorf("ListLoadBalancers: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListLoadBalancers: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllLoadBalancers returns an iterator over the LoadBalancer items returned by ListLoadBalancers.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllLoadBalancers(ctx context.Context) iter.Seq2[LoadBalancer, error] {
+	return func(yield func(LoadBalancer, error) bool) {
+		resp, err := c.ListLoadBalancers(ctx)
+		if err != nil {
+			var zero LoadBalancer
+			yield(zero, fmt.Errorf("AllLoadBalancers: %w", err))
+			return
+		}
+
+		for _, item := range resp.LoadBalancers {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateLoadBalancerRequest struct {
 	// Load Balancer description
 	Description string `json:"description,omitempty" validate:"omitempty,lte=255"`
@@ -14071,6 +13677,10 @@ type CreateLoadBalancerRequest struct {
 func (c Client) CreateLoadBalancer(ctx context.Context, req CreateLoadBalancerRequest) (*Operation, error) {
 	path := "/load-balancer"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateLoadBalancer: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateLoadBalancer: prepare Json body: %w", err)
@@ -14089,23 +13699,11 @@ func (c Client) CreateLoadBalancer(ctx context.Context, req CreateLoadBalancerRe
 		return nil, fmt.Errorf("CreateLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-load-balancer", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateLoadBalancer: http response: %w", err)
 	}
@@ -14115,9 +13713,29 @@ func (c Client) CreateLoadBalancer(ctx context.Context, req CreateLoadBalancerRe
 		return nil, fmt.Errorf("CreateLoadBalancer: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateLoadBalancer: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateLoadBalancerAndWait calls CreateLoadBalancer, waits for the resulting operation to succeed
+// and returns the LoadBalancer referenced by the operation.
+func (c Client) CreateLoadBalancerAndWait(ctx context.Context, req CreateLoadBalancerRequest, opts ...WaitOpt) (*LoadBalancer, error) {
+	op, err := c.CreateLoadBalancer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-load-balancer", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateLoadBalancerAndWait: %w", err)
+	}
+
+	return c.GetLoadBalancer(ctx, ref.ID)
+}
+
 // Delete a Load Balancer
 func (c Client) DeleteLoadBalancer(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/load-balancer/%v", id)
@@ -14133,23 +13751,11 @@ func (c Client) DeleteLoadBalancer(ctx context.Context, id UUID) (*Operation, er
 		return nil, fmt.Errorf("DeleteLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-load-balancer", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteLoadBalancer: http response: %w", err)
 	}
@@ -14159,6 +13765,10 @@ func (c Client) DeleteLoadBalancer(ctx context.Context, id UUID) (*Operation, er
 		return nil, fmt.Errorf("DeleteLoadBalancer: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteLoadBalancer: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14177,23 +13787,11 @@ func (c Client) GetLoadBalancer(ctx context.Context, id UUID) (*LoadBalancer, er
 		return nil, fmt.Errorf("GetLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-load-balancer", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetLoadBalancer: http response: %w", err)
 	}
@@ -14203,6 +13801,10 @@ func (c Client) GetLoadBalancer(ctx context.Context, id UUID) (*LoadBalancer, er
 		return nil, fmt.Errorf("GetLoadBalancer: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetLoadBalancer: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14218,6 +13820,10 @@ type UpdateLoadBalancerRequest struct {
 func (c Client) UpdateLoadBalancer(ctx context.Context, id UUID, req UpdateLoadBalancerRequest) (*Operation, error) {
 	path := fmt.Sprintf("/load-balancer/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancer: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancer: prepare Json body: %w", err)
@@ -14236,23 +13842,11 @@ func (c Client) UpdateLoadBalancer(ctx context.Context, id UUID, req UpdateLoadB
 		return nil, fmt.Errorf("UpdateLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-load-balancer", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancer: http response: %w", err)
 	}
@@ -14262,9 +13856,29 @@ func (c Client) UpdateLoadBalancer(ctx context.Context, id UUID, req UpdateLoadB
 		return nil, fmt.Errorf("UpdateLoadBalancer: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancer: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateLoadBalancerAndWait calls UpdateLoadBalancer, waits for the resulting operation to succeed
+// and returns the LoadBalancer referenced by the operation.
+func (c Client) UpdateLoadBalancerAndWait(ctx context.Context, id UUID, req UpdateLoadBalancerRequest, opts ...WaitOpt) (*LoadBalancer, error) {
+	op, err := c.UpdateLoadBalancer(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-load-balancer", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancerAndWait: %w", err)
+	}
+
+	return c.GetLoadBalancer(ctx, ref.ID)
+}
+
 type AddServiceToLoadBalancerRequestProtocol string
 
 const (
@@ -14303,6 +13917,10 @@ type AddServiceToLoadBalancerRequest struct {
 func (c Client) AddServiceToLoadBalancer(ctx context.Context, id UUID, req AddServiceToLoadBalancerRequest) (*Operation, error) {
 	path := fmt.Sprintf("/load-balancer/%v/service", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("AddServiceToLoadBalancer: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("AddServiceToLoadBalancer: prepare Json body: %w", err)
@@ -14321,23 +13939,11 @@ func (c Client) AddServiceToLoadBalancer(ctx context.Context, id UUID, req AddSe
 		return nil, fmt.Errorf("AddServiceToLoadBalancer: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddServiceToLoadBalancer: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-service-to-load-balancer")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "add-service-to-load-balancer", request)
 	if err != nil {
 		return nil, fmt.Errorf("AddServiceToLoadBalancer: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AddServiceToLoadBalancer: http response: %w", err)
 	}
@@ -14347,6 +13953,10 @@ func (c Client) AddServiceToLoadBalancer(ctx context.Context, id UUID, req AddSe
 		return nil, fmt.Errorf("AddServiceToLoadBalancer: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AddServiceToLoadBalancer: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14365,23 +13975,11 @@ func (c Client) DeleteLoadBalancerService(ctx context.Context, id UUID, serviceI
 		return nil, fmt.Errorf("DeleteLoadBalancerService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteLoadBalancerService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-load-balancer-service")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-load-balancer-service", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteLoadBalancerService: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteLoadBalancerService: http response: %w", err)
 	}
@@ -14391,6 +13989,10 @@ func (c Client) DeleteLoadBalancerService(ctx context.Context, id UUID, serviceI
 		return nil, fmt.Errorf("DeleteLoadBalancerService: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteLoadBalancerService: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14409,23 +14011,11 @@ func (c Client) GetLoadBalancerService(ctx context.Context, id UUID, serviceID U
 		return nil, fmt.Errorf("GetLoadBalancerService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetLoadBalancerService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-load-balancer-service")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-load-balancer-service", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetLoadBalancerService: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetLoadBalancerService: http response: %w", err)
 	}
@@ -14435,6 +14025,10 @@ func (c Client) GetLoadBalancerService(ctx context.Context, id UUID, serviceID U
 		return nil, fmt.Errorf("GetLoadBalancerService: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetLoadBalancerService: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14474,6 +14068,10 @@ type UpdateLoadBalancerServiceRequest struct {
 func (c Client) UpdateLoadBalancerService(ctx context.Context, id UUID, serviceID UUID, req UpdateLoadBalancerServiceRequest) (*Operation, error) {
 	path := fmt.Sprintf("/load-balancer/%v/service/%v", id, serviceID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancerService: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancerService: prepare Json body: %w", err)
@@ -14492,23 +14090,11 @@ func (c Client) UpdateLoadBalancerService(ctx context.Context, id UUID, serviceI
 		return nil, fmt.Errorf("UpdateLoadBalancerService: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateLoadBalancerService: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-load-balancer-service")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-load-balancer-service", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancerService: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateLoadBalancerService: http response: %w", err)
 	}
@@ -14518,6 +14104,10 @@ func (c Client) UpdateLoadBalancerService(ctx context.Context, id UUID, serviceI
 		return nil, fmt.Errorf("UpdateLoadBalancerService: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateLoadBalancerService: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14542,23 +14132,11 @@ func (c Client) ResetLoadBalancerServiceField(ctx context.Context, id UUID, serv
 		return nil, fmt.Errorf("ResetLoadBalancerServiceField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerServiceField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-load-balancer-service-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-load-balancer-service-field", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetLoadBalancerServiceField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetLoadBalancerServiceField: http response: %w", err)
 	}
@@ -14568,6 +14146,10 @@ func (c Client) ResetLoadBalancerServiceField(ctx context.Context, id UUID, serv
 		return nil, fmt.Errorf("ResetLoadBalancerServiceField: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetLoadBalancerServiceField: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14593,23 +14175,11 @@ func (c Client) ResetLoadBalancerField(ctx context.Context, id UUID, field Reset
 		return nil, fmt.Errorf("ResetLoadBalancerField: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetLoadBalancerField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-load-balancer-field")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-load-balancer-field", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetLoadBalancerField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetLoadBalancerField: http response: %w", err)
 	}
@@ -14619,6 +14189,10 @@ func (c Client) ResetLoadBalancerField(ctx context.Context, id UUID, field Reset
 		return nil, fmt.Errorf("ResetLoadBalancerField: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetLoadBalancerField: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14637,23 +14211,11 @@ func (c Client) GetOperation(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("GetOperation: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetOperation: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-operation")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-operation", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetOperation: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetOperation: http response: %w", err)
 	}
@@ -14663,6 +14225,10 @@ func (c Client) GetOperation(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("GetOperation: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetOperation: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14681,23 +14247,11 @@ func (c Client) GetOrganization(ctx context.Context) (*Organization, error) {
 		return nil, fmt.Errorf("GetOrganization: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetOrganization: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-organization")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-organization", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetOrganization: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetOrganization: http response: %w", err)
 	}
@@ -14707,6 +14261,10 @@ func (c Client) GetOrganization(ctx context.Context) (*Organization, error) {
 		return nil, fmt.Errorf("GetOrganization: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetOrganization: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14748,23 +14306,11 @@ func (c Client) ListPrivateNetworks(ctx context.Context) (*ListPrivateNetworksRe
 		return nil, fmt.Errorf("ListPrivateNetworks: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListPrivateNetworks: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-private-networks")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-private-networks", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListPrivateNetworks: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListPrivateNetworks: http response: %w", err)
 	}
@@ -14774,9 +14320,32 @@ func (c Client) ListPrivateNetworks(ctx context.Context) (*ListPrivateNetworksRe
 		return nil, fmt.Errorf("ListPrivateNetworks: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListPrivateNetworks: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllPrivateNetworks returns an iterator over the PrivateNetwork items returned by ListPrivateNetworks.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllPrivateNetworks(ctx context.Context) iter.Seq2[PrivateNetwork, error] {
+	return func(yield func(PrivateNetwork, error) bool) {
+		resp, err := c.ListPrivateNetworks(ctx)
+		if err != nil {
+			var zero PrivateNetwork
+			yield(zero, fmt.Errorf("AllPrivateNetworks: %w", err))
+			return
+		}
+
+		for _, item := range resp.PrivateNetworks {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreatePrivateNetworkRequest struct {
 	// Private Network description
 	Description string `json:"description,omitempty" validate:"omitempty,lte=255"`
@@ -14797,6 +14366,10 @@ type CreatePrivateNetworkRequest struct {
 func (c Client) CreatePrivateNetwork(ctx context.Context, req CreatePrivateNetworkRequest) (*Operation, error) {
 	path := "/private-network"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreatePrivateNetwork: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreatePrivateNetwork: prepare Json body: %w", err)
@@ -14815,23 +14388,11 @@ func (c Client) CreatePrivateNetwork(ctx context.Context, req CreatePrivateNetwo
 		return nil, fmt.Errorf("CreatePrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreatePrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-private-network", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreatePrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreatePrivateNetwork: http response: %w", err)
 	}
@@ -14841,9 +14402,29 @@ func (c Client) CreatePrivateNetwork(ctx context.Context, req CreatePrivateNetwo
 		return nil, fmt.Errorf("CreatePrivateNetwork: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreatePrivateNetwork: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreatePrivateNetworkAndWait calls CreatePrivateNetwork, waits for the resulting operation to succeed
+// and returns the PrivateNetwork referenced by the operation.
+func (c Client) CreatePrivateNetworkAndWait(ctx context.Context, req CreatePrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	op, err := c.CreatePrivateNetwork(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-private-network", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreatePrivateNetworkAndWait: %w", err)
+	}
+
+	return c.GetPrivateNetwork(ctx, ref.ID)
+}
+
 // Delete a Private Network
 func (c Client) DeletePrivateNetwork(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/private-network/%v", id)
@@ -14859,23 +14440,11 @@ func (c Client) DeletePrivateNetwork(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("DeletePrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeletePrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-private-network", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeletePrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeletePrivateNetwork: http response: %w", err)
 	}
@@ -14885,6 +14454,10 @@ func (c Client) DeletePrivateNetwork(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("DeletePrivateNetwork: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeletePrivateNetwork: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14903,23 +14476,11 @@ func (c Client) GetPrivateNetwork(ctx context.Context, id UUID) (*PrivateNetwork
 		return nil, fmt.Errorf("GetPrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetPrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-private-network", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetPrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetPrivateNetwork: http response: %w", err)
 	}
@@ -14929,6 +14490,10 @@ func (c Client) GetPrivateNetwork(ctx context.Context, id UUID) (*PrivateNetwork
 		return nil, fmt.Errorf("GetPrivateNetwork: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetPrivateNetwork: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -14952,6 +14517,10 @@ type UpdatePrivateNetworkRequest struct {
 func (c Client) UpdatePrivateNetwork(ctx context.Context, id UUID, req UpdatePrivateNetworkRequest) (*Operation, error) {
 	path := fmt.Sprintf("/private-network/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetwork: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetwork: prepare Json body: %w", err)
@@ -14970,23 +14539,11 @@ func (c Client) UpdatePrivateNetwork(ctx context.Context, id UUID, req UpdatePri
 		return nil, fmt.Errorf("UpdatePrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-private-network", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetwork: http response: %w", err)
 	}
@@ -14996,9 +14553,29 @@ func (c Client) UpdatePrivateNetwork(ctx context.Context, id UUID, req UpdatePri
 		return nil, fmt.Errorf("UpdatePrivateNetwork: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetwork: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdatePrivateNetworkAndWait calls UpdatePrivateNetwork, waits for the resulting operation to succeed
+// and returns the PrivateNetwork referenced by the operation.
+func (c Client) UpdatePrivateNetworkAndWait(ctx context.Context, id UUID, req UpdatePrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	op, err := c.UpdatePrivateNetwork(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-private-network", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetworkAndWait: %w", err)
+	}
+
+	return c.GetPrivateNetwork(ctx, ref.ID)
+}
+
 type ResetPrivateNetworkFieldField string
 
 const (
@@ -15016,27 +14593,15 @@ func (c Client) ResetPrivateNetworkField(ctx context.Context, id UUID, field Res
 
 	request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("ResetPrivateNetworkField: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ResetPrivateNetworkField: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "reset-private-network-field")
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("ResetPrivateNetworkField: execute request editors: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "reset-private-network-field", request)
 	if err != nil {
 		return nil, fmt.Errorf("ResetPrivateNetworkField: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ResetPrivateNetworkField: http response: %w", err)
 	}
@@ -15046,6 +14611,10 @@ func (c Client) ResetPrivateNetworkField(ctx context.Context, id UUID, field Res
 		return nil, fmt.Errorf("ResetPrivateNetworkField: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ResetPrivateNetworkField: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15066,6 +14635,10 @@ type AttachInstanceToPrivateNetworkRequest struct {
 func (c Client) AttachInstanceToPrivateNetwork(ctx context.Context, id UUID, req AttachInstanceToPrivateNetworkRequest) (*Operation, error) {
 	path := fmt.Sprintf("/private-network/%v:attach", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: prepare Json body: %w", err)
@@ -15084,23 +14657,11 @@ func (c Client) AttachInstanceToPrivateNetwork(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "attach-instance-to-private-network", request)
 	if err != nil {
 		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: http response: %w", err)
 	}
@@ -15110,9 +14671,29 @@ func (c Client) AttachInstanceToPrivateNetwork(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToPrivateNetwork: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AttachInstanceToPrivateNetworkAndWait calls AttachInstanceToPrivateNetwork, waits for the resulting operation to succeed
+// and returns the PrivateNetwork referenced by the operation.
+func (c Client) AttachInstanceToPrivateNetworkAndWait(ctx context.Context, id UUID, req AttachInstanceToPrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	op, err := c.AttachInstanceToPrivateNetwork(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-private-network", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("AttachInstanceToPrivateNetworkAndWait: %w", err)
+	}
+
+	return c.GetPrivateNetwork(ctx, ref.ID)
+}
+
 type DetachInstanceFromPrivateNetworkRequest struct {
 	// Instance
 	Instance *Instance `json:"instance" validate:"required"`
@@ -15122,6 +14703,10 @@ type DetachInstanceFromPrivateNetworkRequest struct {
 func (c Client) DetachInstanceFromPrivateNetwork(ctx context.Context, id UUID, req DetachInstanceFromPrivateNetworkRequest) (*Operation, error) {
 	path := fmt.Sprintf("/private-network/%v:detach", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: prepare Json body: %w", err)
@@ -15140,23 +14725,11 @@ func (c Client) DetachInstanceFromPrivateNetwork(ctx context.Context, id UUID, r
 		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-private-network")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "detach-instance-from-private-network", request)
 	if err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: http response: %w", err)
 	}
@@ -15166,9 +14739,29 @@ func (c Client) DetachInstanceFromPrivateNetwork(ctx context.Context, id UUID, r
 		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromPrivateNetwork: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// DetachInstanceFromPrivateNetworkAndWait calls DetachInstanceFromPrivateNetwork, waits for the resulting operation to succeed
+// and returns the PrivateNetwork referenced by the operation.
+func (c Client) DetachInstanceFromPrivateNetworkAndWait(ctx context.Context, id UUID, req DetachInstanceFromPrivateNetworkRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	op, err := c.DetachInstanceFromPrivateNetwork(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-private-network", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromPrivateNetworkAndWait: %w", err)
+	}
+
+	return c.GetPrivateNetwork(ctx, ref.ID)
+}
+
 type UpdatePrivateNetworkInstanceIPRequestInstance struct {
 	// Instance ID
 	ID UUID `json:"id" validate:"required"`
@@ -15184,6 +14777,10 @@ type UpdatePrivateNetworkInstanceIPRequest struct {
 func (c Client) UpdatePrivateNetworkInstanceIP(ctx context.Context, id UUID, req UpdatePrivateNetworkInstanceIPRequest) (*Operation, error) {
 	path := fmt.Sprintf("/private-network/%v:update-ip", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: prepare Json body: %w", err)
@@ -15202,23 +14799,11 @@ func (c Client) UpdatePrivateNetworkInstanceIP(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-private-network-instance-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-private-network-instance-ip", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: http response: %w", err)
 	}
@@ -15228,9 +14813,29 @@ func (c Client) UpdatePrivateNetworkInstanceIP(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIP: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdatePrivateNetworkInstanceIPAndWait calls UpdatePrivateNetworkInstanceIP, waits for the resulting operation to succeed
+// and returns the PrivateNetwork referenced by the operation.
+func (c Client) UpdatePrivateNetworkInstanceIPAndWait(ctx context.Context, id UUID, req UpdatePrivateNetworkInstanceIPRequest, opts ...WaitOpt) (*PrivateNetwork, error) {
+	op, err := c.UpdatePrivateNetworkInstanceIP(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-private-network", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdatePrivateNetworkInstanceIPAndWait: %w", err)
+	}
+
+	return c.GetPrivateNetwork(ctx, ref.ID)
+}
+
 type ListQuotasResponse struct {
 	Quotas []Quota `json:"quotas,omitempty"`
 }
@@ -15250,23 +14855,11 @@ func (c Client) ListQuotas(ctx context.Context) (*ListQuotasResponse, error) {
 		return nil, fmt.Errorf("ListQuotas: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListQuotas: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-quotas")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-quotas", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListQuotas: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListQuotas: http response: %w", err)
 	}
@@ -15276,9 +14869,32 @@ func (c Client) ListQuotas(ctx context.Context) (*ListQuotasResponse, error) {
 		return nil, fmt.Errorf("ListQuotas: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListQuotas: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllQuotas returns an iterator over the Quota items returned by ListQuotas.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllQuotas(ctx context.Context) iter.Seq2[Quota, error] {
+	return func(yield func(Quota, error) bool) {
+		resp, err := c.ListQuotas(ctx)
+		if err != nil {
+			var zero Quota
+			yield(zero, fmt.Errorf("AllQuotas: %w", err))
+			return
+		}
+
+		for _, item := range resp.Quotas {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Retrieve Resource Quota
 func (c Client) GetQuota(ctx context.Context, entity string) (*Quota, error) {
 	path := fmt.Sprintf("/quota/%v", entity)
@@ -15294,23 +14910,11 @@ func (c Client) GetQuota(ctx context.Context, entity string) (*Quota, error) {
 		return nil, fmt.Errorf("GetQuota: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetQuota: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-quota")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-quota", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetQuota: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetQuota: http response: %w", err)
 	}
@@ -15320,6 +14924,10 @@ func (c Client) GetQuota(ctx context.Context, entity string) (*Quota, error) {
 		return nil, fmt.Errorf("GetQuota: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetQuota: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15338,23 +14946,11 @@ func (c Client) DeleteReverseDNSElasticIP(ctx context.Context, id UUID) (*Operat
 		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-reverse-dns-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-reverse-dns-elastic-ip", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: http response: %w", err)
 	}
@@ -15364,6 +14960,10 @@ func (c Client) DeleteReverseDNSElasticIP(ctx context.Context, id UUID) (*Operat
 		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteReverseDNSElasticIP: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15382,23 +14982,11 @@ func (c Client) GetReverseDNSElasticIP(ctx context.Context, id UUID) (*ReverseDN
 		return nil, fmt.Errorf("GetReverseDNSElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-reverse-dns-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-reverse-dns-elastic-ip", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetReverseDNSElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetReverseDNSElasticIP: http response: %w", err)
 	}
@@ -15408,6 +14996,10 @@ func (c Client) GetReverseDNSElasticIP(ctx context.Context, id UUID) (*ReverseDN
 		return nil, fmt.Errorf("GetReverseDNSElasticIP: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetReverseDNSElasticIP: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15419,6 +15011,10 @@ type UpdateReverseDNSElasticIPRequest struct {
 func (c Client) UpdateReverseDNSElasticIP(ctx context.Context, id UUID, req UpdateReverseDNSElasticIPRequest) (*Operation, error) {
 	path := fmt.Sprintf("/reverse-dns/elastic-ip/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: prepare Json body: %w", err)
@@ -15437,23 +15033,11 @@ func (c Client) UpdateReverseDNSElasticIP(ctx context.Context, id UUID, req Upda
 		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-reverse-dns-elastic-ip")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-reverse-dns-elastic-ip", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: http response: %w", err)
 	}
@@ -15463,6 +15047,10 @@ func (c Client) UpdateReverseDNSElasticIP(ctx context.Context, id UUID, req Upda
 		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateReverseDNSElasticIP: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15481,23 +15069,11 @@ func (c Client) DeleteReverseDNSInstance(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DeleteReverseDNSInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteReverseDNSInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-reverse-dns-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-reverse-dns-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteReverseDNSInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteReverseDNSInstance: http response: %w", err)
 	}
@@ -15507,6 +15083,10 @@ func (c Client) DeleteReverseDNSInstance(ctx context.Context, id UUID) (*Operati
 		return nil, fmt.Errorf("DeleteReverseDNSInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteReverseDNSInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15525,23 +15105,11 @@ func (c Client) GetReverseDNSInstance(ctx context.Context, id UUID) (*ReverseDNS
 		return nil, fmt.Errorf("GetReverseDNSInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetReverseDNSInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-reverse-dns-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-reverse-dns-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetReverseDNSInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetReverseDNSInstance: http response: %w", err)
 	}
@@ -15551,6 +15119,10 @@ func (c Client) GetReverseDNSInstance(ctx context.Context, id UUID) (*ReverseDNS
 		return nil, fmt.Errorf("GetReverseDNSInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetReverseDNSInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15562,6 +15134,10 @@ type UpdateReverseDNSInstanceRequest struct {
 func (c Client) UpdateReverseDNSInstance(ctx context.Context, id UUID, req UpdateReverseDNSInstanceRequest) (*Operation, error) {
 	path := fmt.Sprintf("/reverse-dns/instance/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateReverseDNSInstance: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSInstance: prepare Json body: %w", err)
@@ -15580,23 +15156,11 @@ func (c Client) UpdateReverseDNSInstance(ctx context.Context, id UUID, req Updat
 		return nil, fmt.Errorf("UpdateReverseDNSInstance: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateReverseDNSInstance: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-reverse-dns-instance")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-reverse-dns-instance", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSInstance: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateReverseDNSInstance: http response: %w", err)
 	}
@@ -15606,6 +15170,10 @@ func (c Client) UpdateReverseDNSInstance(ctx context.Context, id UUID, req Updat
 		return nil, fmt.Errorf("UpdateReverseDNSInstance: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateReverseDNSInstance: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15673,23 +15241,11 @@ func (c Client) ListSecurityGroups(ctx context.Context, opts ...ListSecurityGrou
 		return nil, fmt.Errorf("ListSecurityGroups: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSecurityGroups: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-security-groups")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-security-groups", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListSecurityGroups: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListSecurityGroups: http response: %w", err)
 	}
@@ -15699,9 +15255,32 @@ func (c Client) ListSecurityGroups(ctx context.Context, opts ...ListSecurityGrou
 		return nil, fmt.Errorf("ListSecurityGroups: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSecurityGroups: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllSecurityGroups returns an iterator over the SecurityGroup items returned by ListSecurityGroups.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllSecurityGroups(ctx context.Context, opts ...ListSecurityGroupsOpt) iter.Seq2[SecurityGroup, error] {
+	return func(yield func(SecurityGroup, error) bool) {
+		resp, err := c.ListSecurityGroups(ctx, opts...)
+		if err != nil {
+			var zero SecurityGroup
+			yield(zero, fmt.Errorf("AllSecurityGroups: %w", err))
+			return
+		}
+
+		for _, item := range resp.SecurityGroups {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateSecurityGroupRequest struct {
 	// Security Group description
 	Description string `json:"description,omitempty" validate:"omitempty,lte=255"`
@@ -15713,6 +15292,10 @@ type CreateSecurityGroupRequest struct {
 func (c Client) CreateSecurityGroup(ctx context.Context, req CreateSecurityGroupRequest) (*Operation, error) {
 	path := "/security-group"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateSecurityGroup: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateSecurityGroup: prepare Json body: %w", err)
@@ -15731,23 +15314,11 @@ func (c Client) CreateSecurityGroup(ctx context.Context, req CreateSecurityGroup
 		return nil, fmt.Errorf("CreateSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-security-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateSecurityGroup: http response: %w", err)
 	}
@@ -15757,9 +15328,29 @@ func (c Client) CreateSecurityGroup(ctx context.Context, req CreateSecurityGroup
 		return nil, fmt.Errorf("CreateSecurityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateSecurityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateSecurityGroupAndWait calls CreateSecurityGroup, waits for the resulting operation to succeed
+// and returns the SecurityGroup referenced by the operation.
+func (c Client) CreateSecurityGroupAndWait(ctx context.Context, req CreateSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	op, err := c.CreateSecurityGroup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-security-group", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSecurityGroupAndWait: %w", err)
+	}
+
+	return c.GetSecurityGroup(ctx, ref.ID)
+}
+
 // Delete a Security Group
 func (c Client) DeleteSecurityGroup(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/security-group/%v", id)
@@ -15775,23 +15366,11 @@ func (c Client) DeleteSecurityGroup(ctx context.Context, id UUID) (*Operation, e
 		return nil, fmt.Errorf("DeleteSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-security-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteSecurityGroup: http response: %w", err)
 	}
@@ -15801,6 +15380,10 @@ func (c Client) DeleteSecurityGroup(ctx context.Context, id UUID) (*Operation, e
 		return nil, fmt.Errorf("DeleteSecurityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSecurityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15819,23 +15402,11 @@ func (c Client) GetSecurityGroup(ctx context.Context, id UUID) (*SecurityGroup,
 		return nil, fmt.Errorf("GetSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-security-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetSecurityGroup: http response: %w", err)
 	}
@@ -15845,6 +15416,10 @@ func (c Client) GetSecurityGroup(ctx context.Context, id UUID) (*SecurityGroup,
 		return nil, fmt.Errorf("GetSecurityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSecurityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15897,6 +15472,10 @@ type AddRuleToSecurityGroupRequest struct {
 func (c Client) AddRuleToSecurityGroup(ctx context.Context, id UUID, req AddRuleToSecurityGroupRequest) (*Operation, error) {
 	path := fmt.Sprintf("/security-group/%v/rules", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("AddRuleToSecurityGroup: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("AddRuleToSecurityGroup: prepare Json body: %w", err)
@@ -15915,23 +15494,11 @@ func (c Client) AddRuleToSecurityGroup(ctx context.Context, id UUID, req AddRule
 		return nil, fmt.Errorf("AddRuleToSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddRuleToSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-rule-to-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "add-rule-to-security-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("AddRuleToSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AddRuleToSecurityGroup: http response: %w", err)
 	}
@@ -15941,6 +15508,10 @@ func (c Client) AddRuleToSecurityGroup(ctx context.Context, id UUID, req AddRule
 		return nil, fmt.Errorf("AddRuleToSecurityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AddRuleToSecurityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15959,23 +15530,11 @@ func (c Client) DeleteRuleFromSecurityGroup(ctx context.Context, id UUID, ruleID
 		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-rule-from-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-rule-from-security-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: http response: %w", err)
 	}
@@ -15985,6 +15544,10 @@ func (c Client) DeleteRuleFromSecurityGroup(ctx context.Context, id UUID, ruleID
 		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteRuleFromSecurityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -15997,6 +15560,10 @@ type AddExternalSourceToSecurityGroupRequest struct {
 func (c Client) AddExternalSourceToSecurityGroup(ctx context.Context, id UUID, req AddExternalSourceToSecurityGroupRequest) (*Operation, error) {
 	path := fmt.Sprintf("/security-group/%v:add-source", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: prepare Json body: %w", err)
@@ -16015,23 +15582,11 @@ func (c Client) AddExternalSourceToSecurityGroup(ctx context.Context, id UUID, r
 		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "add-external-source-to-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "add-external-source-to-security-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: http response: %w", err)
 	}
@@ -16041,9 +15596,29 @@ func (c Client) AddExternalSourceToSecurityGroup(ctx context.Context, id UUID, r
 		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AddExternalSourceToSecurityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AddExternalSourceToSecurityGroupAndWait calls AddExternalSourceToSecurityGroup, waits for the resulting operation to succeed
+// and returns the SecurityGroup referenced by the operation.
+func (c Client) AddExternalSourceToSecurityGroupAndWait(ctx context.Context, id UUID, req AddExternalSourceToSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	op, err := c.AddExternalSourceToSecurityGroup(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-security-group", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("AddExternalSourceToSecurityGroupAndWait: %w", err)
+	}
+
+	return c.GetSecurityGroup(ctx, ref.ID)
+}
+
 type AttachInstanceToSecurityGroupRequest struct {
 	// Instance
 	Instance *Instance `json:"instance" validate:"required"`
@@ -16053,6 +15628,10 @@ type AttachInstanceToSecurityGroupRequest struct {
 func (c Client) AttachInstanceToSecurityGroup(ctx context.Context, id UUID, req AttachInstanceToSecurityGroupRequest) (*Operation, error) {
 	path := fmt.Sprintf("/security-group/%v:attach", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: prepare Json body: %w", err)
@@ -16071,23 +15650,11 @@ func (c Client) AttachInstanceToSecurityGroup(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "attach-instance-to-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "attach-instance-to-security-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: http response: %w", err)
 	}
@@ -16097,9 +15664,29 @@ func (c Client) AttachInstanceToSecurityGroup(ctx context.Context, id UUID, req
 		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("AttachInstanceToSecurityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AttachInstanceToSecurityGroupAndWait calls AttachInstanceToSecurityGroup, waits for the resulting operation to succeed
+// and returns the SecurityGroup referenced by the operation.
+func (c Client) AttachInstanceToSecurityGroupAndWait(ctx context.Context, id UUID, req AttachInstanceToSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	op, err := c.AttachInstanceToSecurityGroup(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-security-group", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("AttachInstanceToSecurityGroupAndWait: %w", err)
+	}
+
+	return c.GetSecurityGroup(ctx, ref.ID)
+}
+
 type DetachInstanceFromSecurityGroupRequest struct {
 	// Instance
 	Instance *Instance `json:"instance" validate:"required"`
@@ -16109,6 +15696,10 @@ type DetachInstanceFromSecurityGroupRequest struct {
 func (c Client) DetachInstanceFromSecurityGroup(ctx context.Context, id UUID, req DetachInstanceFromSecurityGroupRequest) (*Operation, error) {
 	path := fmt.Sprintf("/security-group/%v:detach", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: prepare Json body: %w", err)
@@ -16127,23 +15718,11 @@ func (c Client) DetachInstanceFromSecurityGroup(ctx context.Context, id UUID, re
 		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "detach-instance-from-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "detach-instance-from-security-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: http response: %w", err)
 	}
@@ -16153,9 +15732,29 @@ func (c Client) DetachInstanceFromSecurityGroup(ctx context.Context, id UUID, re
 		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromSecurityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// DetachInstanceFromSecurityGroupAndWait calls DetachInstanceFromSecurityGroup, waits for the resulting operation to succeed
+// and returns the SecurityGroup referenced by the operation.
+func (c Client) DetachInstanceFromSecurityGroupAndWait(ctx context.Context, id UUID, req DetachInstanceFromSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	op, err := c.DetachInstanceFromSecurityGroup(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-security-group", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("DetachInstanceFromSecurityGroupAndWait: %w", err)
+	}
+
+	return c.GetSecurityGroup(ctx, ref.ID)
+}
+
 type RemoveExternalSourceFromSecurityGroupRequest struct {
 	// CIDR-formatted network to remove
 	Cidr string `json:"cidr" validate:"required"`
@@ -16165,6 +15764,10 @@ type RemoveExternalSourceFromSecurityGroupRequest struct {
 func (c Client) RemoveExternalSourceFromSecurityGroup(ctx context.Context, id UUID, req RemoveExternalSourceFromSecurityGroupRequest) (*Operation, error) {
 	path := fmt.Sprintf("/security-group/%v:remove-source", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: prepare Json body: %w", err)
@@ -16183,23 +15786,11 @@ func (c Client) RemoveExternalSourceFromSecurityGroup(ctx context.Context, id UU
 		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "remove-external-source-from-security-group")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "remove-external-source-from-security-group", request)
 	if err != nil {
 		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: http response: %w", err)
 	}
@@ -16209,9 +15800,29 @@ func (c Client) RemoveExternalSourceFromSecurityGroup(ctx context.Context, id UU
 		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroup: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// RemoveExternalSourceFromSecurityGroupAndWait calls RemoveExternalSourceFromSecurityGroup, waits for the resulting operation to succeed
+// and returns the SecurityGroup referenced by the operation.
+func (c Client) RemoveExternalSourceFromSecurityGroupAndWait(ctx context.Context, id UUID, req RemoveExternalSourceFromSecurityGroupRequest, opts ...WaitOpt) (*SecurityGroup, error) {
+	op, err := c.RemoveExternalSourceFromSecurityGroup(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-security-group", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("RemoveExternalSourceFromSecurityGroupAndWait: %w", err)
+	}
+
+	return c.GetSecurityGroup(ctx, ref.ID)
+}
+
 type ListSKSClustersResponse struct {
 	SKSClusters []SKSCluster `json:"sks-clusters,omitempty"`
 }
@@ -16250,23 +15861,11 @@ func (c Client) ListSKSClusters(ctx context.Context) (*ListSKSClustersResponse,
 		return nil, fmt.Errorf("ListSKSClusters: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSKSClusters: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-sks-clusters")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-sks-clusters", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListSKSClusters: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListSKSClusters: http response: %w", err)
 	}
@@ -16276,9 +15875,32 @@ func (c Client) ListSKSClusters(ctx context.Context) (*ListSKSClustersResponse,
 		return nil, fmt.Errorf("ListSKSClusters: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSKSClusters: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllSKSClusters returns an iterator over the SKSCluster items returned by ListSKSClusters.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllSKSClusters(ctx context.Context) iter.Seq2[SKSCluster, error] {
+	return func(yield func(SKSCluster, error) bool) {
+		resp, err := c.ListSKSClusters(ctx)
+		if err != nil {
+			var zero SKSCluster
+			yield(zero, fmt.Errorf("AllSKSClusters: %w", err))
+			return
+		}
+
+		for _, item := range resp.SKSClusters {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateSKSClusterRequestCni string
 
 const (
@@ -16327,6 +15949,10 @@ type CreateSKSClusterRequest struct {
 func (c Client) CreateSKSCluster(ctx context.Context, req CreateSKSClusterRequest) (*Operation, error) {
 	path := "/sks-cluster"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateSKSCluster: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateSKSCluster: prepare Json body: %w", err)
@@ -16345,23 +15971,11 @@ func (c Client) CreateSKSCluster(ctx context.Context, req CreateSKSClusterReques
 		return nil, fmt.Errorf("CreateSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-sks-cluster", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateSKSCluster: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateSKSCluster: http response: %w", err)
 	}
@@ -16371,9 +15985,29 @@ func (c Client) CreateSKSCluster(ctx context.Context, req CreateSKSClusterReques
 		return nil, fmt.Errorf("CreateSKSCluster: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateSKSCluster: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// CreateSKSClusterAndWait calls CreateSKSCluster, waits for the resulting operation to succeed
+// and returns the SKSCluster referenced by the operation.
+func (c Client) CreateSKSClusterAndWait(ctx context.Context, req CreateSKSClusterRequest, opts ...WaitOpt) (*SKSCluster, error) {
+	op, err := c.CreateSKSCluster(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-sks-cluster", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("CreateSKSClusterAndWait: %w", err)
+	}
+
+	return c.GetSKSCluster(ctx, ref.ID)
+}
+
 // This operation returns the deprecated resources for a given cluster
 func (c Client) ListSKSClusterDeprecatedResources(ctx context.Context, id UUID) ([]SKSClusterDeprecatedResource, error) {
 	path := fmt.Sprintf("/sks-cluster-deprecated-resources/%v", id)
@@ -16389,23 +16023,11 @@ func (c Client) ListSKSClusterDeprecatedResources(ctx context.Context, id UUID)
 		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-sks-cluster-deprecated-resources")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-sks-cluster-deprecated-resources", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: http response: %w", err)
 	}
@@ -16415,9 +16037,32 @@ func (c Client) ListSKSClusterDeprecatedResources(ctx context.Context, id UUID)
 		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSKSClusterDeprecatedResources: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllSKSClusterDeprecatedResources returns an iterator over the SKSClusterDeprecatedResource items returned by ListSKSClusterDeprecatedResources.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllSKSClusterDeprecatedResources(ctx context.Context, id UUID) iter.Seq2[SKSClusterDeprecatedResource, error] {
+	return func(yield func(SKSClusterDeprecatedResource, error) bool) {
+		resp, err := c.ListSKSClusterDeprecatedResources(ctx, id)
+		if err != nil {
+			var zero SKSClusterDeprecatedResource
+			yield(zero, fmt.Errorf("AllSKSClusterDeprecatedResources: %w", err))
+			return
+		}
+
+		for _, item := range resp {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type GenerateSKSClusterKubeconfigResponse struct {
 	Kubeconfig string `json:"kubeconfig,omitempty"`
 }
@@ -16426,6 +16071,10 @@ type GenerateSKSClusterKubeconfigResponse struct {
 func (c Client) GenerateSKSClusterKubeconfig(ctx context.Context, id UUID, req SKSKubeconfigRequest) (*GenerateSKSClusterKubeconfigResponse, error) {
 	path := fmt.Sprintf("/sks-cluster-kubeconfig/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: prepare Json body: %w", err)
@@ -16444,23 +16093,11 @@ func (c Client) GenerateSKSClusterKubeconfig(ctx context.Context, id UUID, req S
 		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "generate-sks-cluster-kubeconfig")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "generate-sks-cluster-kubeconfig", request)
 	if err != nil {
 		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: http response: %w", err)
 	}
@@ -16470,6 +16107,10 @@ func (c Client) GenerateSKSClusterKubeconfig(ctx context.Context, id UUID, req S
 		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GenerateSKSClusterKubeconfig: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -16508,23 +16149,11 @@ func (c Client) ListSKSClusterVersions(ctx context.Context, opts ...ListSKSClust
 		return nil, fmt.Errorf("ListSKSClusterVersions: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSKSClusterVersions: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-sks-cluster-versions")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-sks-cluster-versions", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListSKSClusterVersions: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListSKSClusterVersions: http response: %w", err)
 	}
@@ -16534,9 +16163,32 @@ func (c Client) ListSKSClusterVersions(ctx context.Context, opts ...ListSKSClust
 		return nil, fmt.Errorf("ListSKSClusterVersions: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSKSClusterVersions: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllSKSClusterVersions returns an iterator over the string items returned by ListSKSClusterVersions.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllSKSClusterVersions(ctx context.Context, opts ...ListSKSClusterVersionsOpt) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		resp, err := c.ListSKSClusterVersions(ctx, opts...)
+		if err != nil {
+			var zero string
+			yield(zero, fmt.Errorf("AllSKSClusterVersions: %w", err))
+			return
+		}
+
+		for _, item := range resp.SKSClusterVersions {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Delete an SKS cluster
 func (c Client) DeleteSKSCluster(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/sks-cluster/%v", id)
@@ -16552,23 +16204,11 @@ func (c Client) DeleteSKSCluster(ctx context.Context, id UUID) (*Operation, erro
 		return nil, fmt.Errorf("DeleteSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-sks-cluster", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSKSCluster: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteSKSCluster: http response: %w", err)
 	}
@@ -16578,6 +16218,10 @@ func (c Client) DeleteSKSCluster(ctx context.Context, id UUID) (*Operation, erro
 		return nil, fmt.Errorf("DeleteSKSCluster: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSKSCluster: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -16596,23 +16240,11 @@ func (c Client) GetSKSCluster(ctx context.Context, id UUID) (*SKSCluster, error)
 		return nil, fmt.Errorf("GetSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-sks-cluster", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetSKSCluster: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetSKSCluster: http response: %w", err)
 	}
@@ -16622,6 +16254,10 @@ func (c Client) GetSKSCluster(ctx context.Context, id UUID) (*SKSCluster, error)
 		return nil, fmt.Errorf("GetSKSCluster: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSKSCluster: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -16649,6 +16285,10 @@ type UpdateSKSClusterRequest struct {
 func (c Client) UpdateSKSCluster(ctx context.Context, id UUID, req UpdateSKSClusterRequest) (*Operation, error) {
 	path := fmt.Sprintf("/sks-cluster/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateSKSCluster: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateSKSCluster: prepare Json body: %w", err)
@@ -16667,23 +16307,11 @@ func (c Client) UpdateSKSCluster(ctx context.Context, id UUID, req UpdateSKSClus
 		return nil, fmt.Errorf("UpdateSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-sks-cluster", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateSKSCluster: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateSKSCluster: http response: %w", err)
 	}
@@ -16693,9 +16321,29 @@ func (c Client) UpdateSKSCluster(ctx context.Context, id UUID, req UpdateSKSClus
 		return nil, fmt.Errorf("UpdateSKSCluster: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateSKSCluster: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateSKSClusterAndWait calls UpdateSKSCluster, waits for the resulting operation to succeed
+// and returns the SKSCluster referenced by the operation.
+func (c Client) UpdateSKSClusterAndWait(ctx context.Context, id UUID, req UpdateSKSClusterRequest, opts ...WaitOpt) (*SKSCluster, error) {
+	op, err := c.UpdateSKSCluster(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-sks-cluster", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateSKSClusterAndWait: %w", err)
+	}
+
+	return c.GetSKSCluster(ctx, ref.ID)
+}
+
 type GetSKSClusterAuthorityCertResponse struct {
 	Cacert string `json:"cacert,omitempty"`
 }
@@ -16723,23 +16371,11 @@ func (c Client) GetSKSClusterAuthorityCert(ctx context.Context, id UUID, authori
 		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-cluster-authority-cert")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-sks-cluster-authority-cert", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: http response: %w", err)
 	}
@@ -16749,6 +16385,10 @@ func (c Client) GetSKSClusterAuthorityCert(ctx context.Context, id UUID, authori
 		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSKSClusterAuthorityCert: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -16769,23 +16409,11 @@ func (c Client) GetSKSClusterInspection(ctx context.Context, id UUID) (*GetSKSCl
 		return nil, fmt.Errorf("GetSKSClusterInspection: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSClusterInspection: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-cluster-inspection")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-sks-cluster-inspection", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetSKSClusterInspection: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetSKSClusterInspection: http response: %w", err)
 	}
@@ -16795,6 +16423,10 @@ func (c Client) GetSKSClusterInspection(ctx context.Context, id UUID) (*GetSKSCl
 		return nil, fmt.Errorf("GetSKSClusterInspection: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSKSClusterInspection: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -16842,6 +16474,10 @@ type CreateSKSNodepoolRequest struct {
 func (c Client) CreateSKSNodepool(ctx context.Context, id UUID, req CreateSKSNodepoolRequest) (*Operation, error) {
 	path := fmt.Sprintf("/sks-cluster/%v/nodepool", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateSKSNodepool: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateSKSNodepool: prepare Json body: %w", err)
@@ -16860,23 +16496,11 @@ func (c Client) CreateSKSNodepool(ctx context.Context, id UUID, req CreateSKSNod
 		return nil, fmt.Errorf("CreateSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-sks-nodepool", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateSKSNodepool: http response: %w", err)
 	}
@@ -16886,6 +16510,10 @@ func (c Client) CreateSKSNodepool(ctx context.Context, id UUID, req CreateSKSNod
 		return nil, fmt.Errorf("CreateSKSNodepool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateSKSNodepool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -16904,23 +16532,11 @@ func (c Client) DeleteSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UU
 		return nil, fmt.Errorf("DeleteSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-sks-nodepool", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteSKSNodepool: http response: %w", err)
 	}
@@ -16930,6 +16546,10 @@ func (c Client) DeleteSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UU
 		return nil, fmt.Errorf("DeleteSKSNodepool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSKSNodepool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -16948,23 +16568,11 @@ func (c Client) GetSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID)
 		return nil, fmt.Errorf("GetSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-sks-nodepool", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetSKSNodepool: http response: %w", err)
 	}
@@ -16974,6 +16582,10 @@ func (c Client) GetSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID)
 		return nil, fmt.Errorf("GetSKSNodepool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSKSNodepool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17017,6 +16629,10 @@ type UpdateSKSNodepoolRequest struct {
 func (c Client) UpdateSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID, req UpdateSKSNodepoolRequest) (*Operation, error) {
 	path := fmt.Sprintf("/sks-cluster/%v/nodepool/%v", id, sksNodepoolID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateSKSNodepool: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateSKSNodepool: prepare Json body: %w", err)
@@ -17035,23 +16651,11 @@ func (c Client) UpdateSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UU
 		return nil, fmt.Errorf("UpdateSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-sks-nodepool", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateSKSNodepool: http response: %w", err)
 	}
@@ -17061,6 +16665,10 @@ func (c Client) UpdateSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UU
 		return nil, fmt.Errorf("UpdateSKSNodepool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateSKSNodepool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17072,6 +16680,10 @@ type EvictSKSNodepoolMembersRequest struct {
 func (c Client) EvictSKSNodepoolMembers(ctx context.Context, id UUID, sksNodepoolID UUID, req EvictSKSNodepoolMembersRequest) (*Operation, error) {
 	path := fmt.Sprintf("/sks-cluster/%v/nodepool/%v:evict", id, sksNodepoolID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("EvictSKSNodepoolMembers: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("EvictSKSNodepoolMembers: prepare Json body: %w", err)
@@ -17090,23 +16702,11 @@ func (c Client) EvictSKSNodepoolMembers(ctx context.Context, id UUID, sksNodepoo
 		return nil, fmt.Errorf("EvictSKSNodepoolMembers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("EvictSKSNodepoolMembers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "evict-sks-nodepool-members")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "evict-sks-nodepool-members", request)
 	if err != nil {
 		return nil, fmt.Errorf("EvictSKSNodepoolMembers: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("EvictSKSNodepoolMembers: http response: %w", err)
 	}
@@ -17116,6 +16716,10 @@ func (c Client) EvictSKSNodepoolMembers(ctx context.Context, id UUID, sksNodepoo
 		return nil, fmt.Errorf("EvictSKSNodepoolMembers: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("EvictSKSNodepoolMembers: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17128,6 +16732,10 @@ type ScaleSKSNodepoolRequest struct {
 func (c Client) ScaleSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUID, req ScaleSKSNodepoolRequest) (*Operation, error) {
 	path := fmt.Sprintf("/sks-cluster/%v/nodepool/%v:scale", id, sksNodepoolID)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("ScaleSKSNodepool: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("ScaleSKSNodepool: prepare Json body: %w", err)
@@ -17146,23 +16754,11 @@ func (c Client) ScaleSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUI
 		return nil, fmt.Errorf("ScaleSKSNodepool: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ScaleSKSNodepool: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "scale-sks-nodepool")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "scale-sks-nodepool", request)
 	if err != nil {
 		return nil, fmt.Errorf("ScaleSKSNodepool: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ScaleSKSNodepool: http response: %w", err)
 	}
@@ -17172,6 +16768,10 @@ func (c Client) ScaleSKSNodepool(ctx context.Context, id UUID, sksNodepoolID UUI
 		return nil, fmt.Errorf("ScaleSKSNodepool: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ScaleSKSNodepool: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17190,23 +16790,11 @@ func (c Client) RotateSKSCcmCredentials(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("RotateSKSCcmCredentials: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSCcmCredentials: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-ccm-credentials")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "rotate-sks-ccm-credentials", request)
 	if err != nil {
 		return nil, fmt.Errorf("RotateSKSCcmCredentials: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RotateSKSCcmCredentials: http response: %w", err)
 	}
@@ -17216,6 +16804,10 @@ func (c Client) RotateSKSCcmCredentials(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("RotateSKSCcmCredentials: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateSKSCcmCredentials: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17234,23 +16826,11 @@ func (c Client) RotateSKSCsiCredentials(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("RotateSKSCsiCredentials: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSCsiCredentials: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-csi-credentials")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "rotate-sks-csi-credentials", request)
 	if err != nil {
 		return nil, fmt.Errorf("RotateSKSCsiCredentials: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RotateSKSCsiCredentials: http response: %w", err)
 	}
@@ -17260,6 +16840,10 @@ func (c Client) RotateSKSCsiCredentials(ctx context.Context, id UUID) (*Operatio
 		return nil, fmt.Errorf("RotateSKSCsiCredentials: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateSKSCsiCredentials: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17278,23 +16862,11 @@ func (c Client) RotateSKSKarpenterCredentials(ctx context.Context, id UUID) (*Op
 		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-karpenter-credentials")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "rotate-sks-karpenter-credentials", request)
 	if err != nil {
 		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: http response: %w", err)
 	}
@@ -17304,6 +16876,10 @@ func (c Client) RotateSKSKarpenterCredentials(ctx context.Context, id UUID) (*Op
 		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateSKSKarpenterCredentials: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17322,23 +16898,11 @@ func (c Client) RotateSKSOperatorsCA(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("RotateSKSOperatorsCA: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RotateSKSOperatorsCA: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "rotate-sks-operators-ca")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "rotate-sks-operators-ca", request)
 	if err != nil {
 		return nil, fmt.Errorf("RotateSKSOperatorsCA: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RotateSKSOperatorsCA: http response: %w", err)
 	}
@@ -17348,6 +16912,10 @@ func (c Client) RotateSKSOperatorsCA(ctx context.Context, id UUID) (*Operation,
 		return nil, fmt.Errorf("RotateSKSOperatorsCA: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RotateSKSOperatorsCA: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17360,6 +16928,10 @@ type UpgradeSKSClusterRequest struct {
 func (c Client) UpgradeSKSCluster(ctx context.Context, id UUID, req UpgradeSKSClusterRequest) (*Operation, error) {
 	path := fmt.Sprintf("/sks-cluster/%v/upgrade", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpgradeSKSCluster: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpgradeSKSCluster: prepare Json body: %w", err)
@@ -17378,23 +16950,11 @@ func (c Client) UpgradeSKSCluster(ctx context.Context, id UUID, req UpgradeSKSCl
 		return nil, fmt.Errorf("UpgradeSKSCluster: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSCluster: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "upgrade-sks-cluster")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "upgrade-sks-cluster", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpgradeSKSCluster: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpgradeSKSCluster: http response: %w", err)
 	}
@@ -17404,6 +16964,10 @@ func (c Client) UpgradeSKSCluster(ctx context.Context, id UUID, req UpgradeSKSCl
 		return nil, fmt.Errorf("UpgradeSKSCluster: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpgradeSKSCluster: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17422,23 +16986,11 @@ func (c Client) UpgradeSKSClusterServiceLevel(ctx context.Context, id UUID) (*Op
 		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "upgrade-sks-cluster-service-level")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "upgrade-sks-cluster-service-level", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: http response: %w", err)
 	}
@@ -17448,6 +17000,10 @@ func (c Client) UpgradeSKSClusterServiceLevel(ctx context.Context, id UUID) (*Op
 		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpgradeSKSClusterServiceLevel: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17477,23 +17033,11 @@ func (c Client) GetActiveNodepoolTemplate(ctx context.Context, kubeVersion strin
 		return nil, fmt.Errorf("GetActiveNodepoolTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetActiveNodepoolTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-active-nodepool-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-active-nodepool-template", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetActiveNodepoolTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetActiveNodepoolTemplate: http response: %w", err)
 	}
@@ -17503,6 +17047,10 @@ func (c Client) GetActiveNodepoolTemplate(ctx context.Context, kubeVersion strin
 		return nil, fmt.Errorf("GetActiveNodepoolTemplate: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetActiveNodepoolTemplate: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17540,27 +17088,15 @@ func (c Client) ListSnapshots(ctx context.Context) (*ListSnapshotsResponse, erro
 
 	request.Header.Add("User-Agent", c.getUserAgent())
 
-	if err := c.executeRequestInterceptors(ctx, request); err != nil {
-		return nil, fmt.Errorf("ListSnapshots: execute request editors: %w", err)
-	}
-
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSnapshots: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-snapshots")
+	if err := c.executeRequestInterceptors(ctx, request); err != nil {
+		return nil, fmt.Errorf("ListSnapshots: execute request editors: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-snapshots", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListSnapshots: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListSnapshots: http response: %w", err)
 	}
@@ -17570,9 +17106,32 @@ func (c Client) ListSnapshots(ctx context.Context) (*ListSnapshotsResponse, erro
 		return nil, fmt.Errorf("ListSnapshots: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSnapshots: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllSnapshots returns an iterator over the Snapshot items returned by ListSnapshots.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllSnapshots(ctx context.Context) iter.Seq2[Snapshot, error] {
+	return func(yield func(Snapshot, error) bool) {
+		resp, err := c.ListSnapshots(ctx)
+		if err != nil {
+			var zero Snapshot
+			yield(zero, fmt.Errorf("AllSnapshots: %w", err))
+			return
+		}
+
+		for _, item := range resp.Snapshots {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Delete a Snapshot
 func (c Client) DeleteSnapshot(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/snapshot/%v", id)
@@ -17588,23 +17147,11 @@ func (c Client) DeleteSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-snapshot", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteSnapshot: http response: %w", err)
 	}
@@ -17614,6 +17161,10 @@ func (c Client) DeleteSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteSnapshot: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSnapshot: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17632,23 +17183,11 @@ func (c Client) GetSnapshot(ctx context.Context, id UUID) (*Snapshot, error) {
 		return nil, fmt.Errorf("GetSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-snapshot", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetSnapshot: http response: %w", err)
 	}
@@ -17658,6 +17197,10 @@ func (c Client) GetSnapshot(ctx context.Context, id UUID) (*Snapshot, error) {
 		return nil, fmt.Errorf("GetSnapshot: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSnapshot: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17676,23 +17219,11 @@ func (c Client) ExportSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("ExportSnapshot: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ExportSnapshot: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "export-snapshot")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "export-snapshot", request)
 	if err != nil {
 		return nil, fmt.Errorf("ExportSnapshot: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ExportSnapshot: http response: %w", err)
 	}
@@ -17702,9 +17233,29 @@ func (c Client) ExportSnapshot(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("ExportSnapshot: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ExportSnapshot: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// ExportSnapshotAndWait calls ExportSnapshot, waits for the resulting operation to succeed
+// and returns the Snapshot referenced by the operation.
+func (c Client) ExportSnapshotAndWait(ctx context.Context, id UUID, opts ...WaitOpt) (*Snapshot, error) {
+	op, err := c.ExportSnapshot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-snapshot", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ExportSnapshotAndWait: %w", err)
+	}
+
+	return c.GetSnapshot(ctx, ref.ID)
+}
+
 type PromoteSnapshotToTemplateRequest struct {
 	// Template default user
 	DefaultUser string `json:"default-user,omitempty" validate:"omitempty,gte=1,lte=255"`
@@ -17722,6 +17273,10 @@ type PromoteSnapshotToTemplateRequest struct {
 func (c Client) PromoteSnapshotToTemplate(ctx context.Context, id UUID, req PromoteSnapshotToTemplateRequest) (*Operation, error) {
 	path := fmt.Sprintf("/snapshot/%v:promote", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("PromoteSnapshotToTemplate: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("PromoteSnapshotToTemplate: prepare Json body: %w", err)
@@ -17740,23 +17295,11 @@ func (c Client) PromoteSnapshotToTemplate(ctx context.Context, id UUID, req Prom
 		return nil, fmt.Errorf("PromoteSnapshotToTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("PromoteSnapshotToTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "promote-snapshot-to-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "promote-snapshot-to-template", request)
 	if err != nil {
 		return nil, fmt.Errorf("PromoteSnapshotToTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("PromoteSnapshotToTemplate: http response: %w", err)
 	}
@@ -17766,6 +17309,10 @@ func (c Client) PromoteSnapshotToTemplate(ctx context.Context, id UUID, req Prom
 		return nil, fmt.Errorf("PromoteSnapshotToTemplate: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("PromoteSnapshotToTemplate: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17807,23 +17354,11 @@ func (c Client) ListSOSBucketsUsage(ctx context.Context) (*ListSOSBucketsUsageRe
 		return nil, fmt.Errorf("ListSOSBucketsUsage: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSOSBucketsUsage: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-sos-buckets-usage")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-sos-buckets-usage", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListSOSBucketsUsage: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListSOSBucketsUsage: http response: %w", err)
 	}
@@ -17833,9 +17368,32 @@ func (c Client) ListSOSBucketsUsage(ctx context.Context) (*ListSOSBucketsUsageRe
 		return nil, fmt.Errorf("ListSOSBucketsUsage: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSOSBucketsUsage: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllSOSBucketsUsage returns an iterator over the SOSBucketUsage items returned by ListSOSBucketsUsage.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllSOSBucketsUsage(ctx context.Context) iter.Seq2[SOSBucketUsage, error] {
+	return func(yield func(SOSBucketUsage, error) bool) {
+		resp, err := c.ListSOSBucketsUsage(ctx)
+		if err != nil {
+			var zero SOSBucketUsage
+			yield(zero, fmt.Errorf("AllSOSBucketsUsage: %w", err))
+			return
+		}
+
+		for _, item := range resp.SOSBucketsUsage {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type GetSOSPresignedURLResponse struct {
 	URL string `json:"url,omitempty"`
 }
@@ -17871,23 +17429,11 @@ func (c Client) GetSOSPresignedURL(ctx context.Context, bucket string, opts ...G
 		return nil, fmt.Errorf("GetSOSPresignedURL: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSOSPresignedURL: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-sos-presigned-url")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-sos-presigned-url", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetSOSPresignedURL: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetSOSPresignedURL: http response: %w", err)
 	}
@@ -17897,6 +17443,10 @@ func (c Client) GetSOSPresignedURL(ctx context.Context, bucket string, opts ...G
 		return nil, fmt.Errorf("GetSOSPresignedURL: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSOSPresignedURL: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -17938,23 +17488,11 @@ func (c Client) ListSSHKeys(ctx context.Context) (*ListSSHKeysResponse, error) {
 		return nil, fmt.Errorf("ListSSHKeys: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListSSHKeys: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-ssh-keys")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-ssh-keys", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListSSHKeys: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListSSHKeys: http response: %w", err)
 	}
@@ -17964,9 +17502,32 @@ func (c Client) ListSSHKeys(ctx context.Context) (*ListSSHKeysResponse, error) {
 		return nil, fmt.Errorf("ListSSHKeys: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListSSHKeys: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllSSHKeys returns an iterator over the SSHKey items returned by ListSSHKeys.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllSSHKeys(ctx context.Context) iter.Seq2[SSHKey, error] {
+	return func(yield func(SSHKey, error) bool) {
+		resp, err := c.ListSSHKeys(ctx)
+		if err != nil {
+			var zero SSHKey
+			yield(zero, fmt.Errorf("AllSSHKeys: %w", err))
+			return
+		}
+
+		for _, item := range resp.SSHKeys {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type RegisterSSHKeyRequest struct {
 	// SSH key name
 	Name string `json:"name" validate:"required"`
@@ -17978,6 +17539,10 @@ type RegisterSSHKeyRequest struct {
 func (c Client) RegisterSSHKey(ctx context.Context, req RegisterSSHKeyRequest) (*Operation, error) {
 	path := "/ssh-key"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("RegisterSSHKey: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("RegisterSSHKey: prepare Json body: %w", err)
@@ -17996,23 +17561,11 @@ func (c Client) RegisterSSHKey(ctx context.Context, req RegisterSSHKeyRequest) (
 		return nil, fmt.Errorf("RegisterSSHKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RegisterSSHKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "register-ssh-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "register-ssh-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("RegisterSSHKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RegisterSSHKey: http response: %w", err)
 	}
@@ -18022,6 +17575,10 @@ func (c Client) RegisterSSHKey(ctx context.Context, req RegisterSSHKeyRequest) (
 		return nil, fmt.Errorf("RegisterSSHKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RegisterSSHKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18040,23 +17597,11 @@ func (c Client) DeleteSSHKey(ctx context.Context, name string) (*Operation, erro
 		return nil, fmt.Errorf("DeleteSSHKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteSSHKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-ssh-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-ssh-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteSSHKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteSSHKey: http response: %w", err)
 	}
@@ -18066,6 +17611,10 @@ func (c Client) DeleteSSHKey(ctx context.Context, name string) (*Operation, erro
 		return nil, fmt.Errorf("DeleteSSHKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteSSHKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18084,23 +17633,11 @@ func (c Client) GetSSHKey(ctx context.Context, name string) (*SSHKey, error) {
 		return nil, fmt.Errorf("GetSSHKey: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetSSHKey: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-ssh-key")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-ssh-key", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetSSHKey: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetSSHKey: http response: %w", err)
 	}
@@ -18110,6 +17647,10 @@ func (c Client) GetSSHKey(ctx context.Context, name string) (*SSHKey, error) {
 		return nil, fmt.Errorf("GetSSHKey: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetSSHKey: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18180,23 +17721,11 @@ func (c Client) ListTemplates(ctx context.Context, opts ...ListTemplatesOpt) (*L
 		return nil, fmt.Errorf("ListTemplates: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListTemplates: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-templates")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-templates", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListTemplates: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListTemplates: http response: %w", err)
 	}
@@ -18206,9 +17735,32 @@ func (c Client) ListTemplates(ctx context.Context, opts ...ListTemplatesOpt) (*L
 		return nil, fmt.Errorf("ListTemplates: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListTemplates: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllTemplates returns an iterator over the Template items returned by ListTemplates.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllTemplates(ctx context.Context, opts ...ListTemplatesOpt) iter.Seq2[Template, error] {
+	return func(yield func(Template, error) bool) {
+		resp, err := c.ListTemplates(ctx, opts...)
+		if err != nil {
+			var zero Template
+			yield(zero, fmt.Errorf("AllTemplates: %w", err))
+			return
+		}
+
+		for _, item := range resp.Templates {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type RegisterTemplateRequestBootMode string
 
 const (
@@ -18249,6 +17801,10 @@ type RegisterTemplateRequest struct {
 func (c Client) RegisterTemplate(ctx context.Context, req RegisterTemplateRequest) (*Operation, error) {
 	path := "/template"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("RegisterTemplate: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("RegisterTemplate: prepare Json body: %w", err)
@@ -18267,23 +17823,11 @@ func (c Client) RegisterTemplate(ctx context.Context, req RegisterTemplateReques
 		return nil, fmt.Errorf("RegisterTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("RegisterTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "register-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "register-template", request)
 	if err != nil {
 		return nil, fmt.Errorf("RegisterTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("RegisterTemplate: http response: %w", err)
 	}
@@ -18293,9 +17837,29 @@ func (c Client) RegisterTemplate(ctx context.Context, req RegisterTemplateReques
 		return nil, fmt.Errorf("RegisterTemplate: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("RegisterTemplate: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// RegisterTemplateAndWait calls RegisterTemplate, waits for the resulting operation to succeed
+// and returns the Template referenced by the operation.
+func (c Client) RegisterTemplateAndWait(ctx context.Context, req RegisterTemplateRequest, opts ...WaitOpt) (*Template, error) {
+	op, err := c.RegisterTemplate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-template", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("RegisterTemplateAndWait: %w", err)
+	}
+
+	return c.GetTemplate(ctx, ref.ID)
+}
+
 // Delete a Template
 func (c Client) DeleteTemplate(ctx context.Context, id UUID) (*Operation, error) {
 	path := fmt.Sprintf("/template/%v", id)
@@ -18311,23 +17875,11 @@ func (c Client) DeleteTemplate(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-template", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteTemplate: http response: %w", err)
 	}
@@ -18337,6 +17889,10 @@ func (c Client) DeleteTemplate(ctx context.Context, id UUID) (*Operation, error)
 		return nil, fmt.Errorf("DeleteTemplate: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteTemplate: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18355,23 +17911,11 @@ func (c Client) GetTemplate(ctx context.Context, id UUID) (*Template, error) {
 		return nil, fmt.Errorf("GetTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-template", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetTemplate: http response: %w", err)
 	}
@@ -18381,6 +17925,10 @@ func (c Client) GetTemplate(ctx context.Context, id UUID) (*Template, error) {
 		return nil, fmt.Errorf("GetTemplate: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetTemplate: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18393,6 +17941,10 @@ type CopyTemplateRequest struct {
 func (c Client) CopyTemplate(ctx context.Context, id UUID, req CopyTemplateRequest) (*Operation, error) {
 	path := fmt.Sprintf("/template/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CopyTemplate: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CopyTemplate: prepare Json body: %w", err)
@@ -18411,23 +17963,11 @@ func (c Client) CopyTemplate(ctx context.Context, id UUID, req CopyTemplateReque
 		return nil, fmt.Errorf("CopyTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CopyTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "copy-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "copy-template", request)
 	if err != nil {
 		return nil, fmt.Errorf("CopyTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CopyTemplate: http response: %w", err)
 	}
@@ -18437,6 +17977,10 @@ func (c Client) CopyTemplate(ctx context.Context, id UUID, req CopyTemplateReque
 		return nil, fmt.Errorf("CopyTemplate: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CopyTemplate: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18451,6 +17995,10 @@ type UpdateTemplateRequest struct {
 func (c Client) UpdateTemplate(ctx context.Context, id UUID, req UpdateTemplateRequest) (*Operation, error) {
 	path := fmt.Sprintf("/template/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateTemplate: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateTemplate: prepare Json body: %w", err)
@@ -18469,23 +18017,11 @@ func (c Client) UpdateTemplate(ctx context.Context, id UUID, req UpdateTemplateR
 		return nil, fmt.Errorf("UpdateTemplate: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateTemplate: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-template")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-template", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateTemplate: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateTemplate: http response: %w", err)
 	}
@@ -18495,9 +18031,29 @@ func (c Client) UpdateTemplate(ctx context.Context, id UUID, req UpdateTemplateR
 		return nil, fmt.Errorf("UpdateTemplate: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateTemplate: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// UpdateTemplateAndWait calls UpdateTemplate, waits for the resulting operation to succeed
+// and returns the Template referenced by the operation.
+func (c Client) UpdateTemplateAndWait(ctx context.Context, id UUID, req UpdateTemplateRequest, opts ...WaitOpt) (*Template, error) {
+	op, err := c.UpdateTemplate(ctx, id, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := c.waitForReference(ctx, op, "get-template", opts...)
+	if err != nil {
+		return nil, fmt.Errorf("UpdateTemplateAndWait: %w", err)
+	}
+
+	return c.GetTemplate(ctx, ref.ID)
+}
+
 // Usage
 type GetUsageReportResponseUsage struct {
 	// Description
@@ -18551,23 +18107,11 @@ func (c Client) GetUsageReport(ctx context.Context, opts ...GetUsageReportOpt) (
 		return nil, fmt.Errorf("GetUsageReport: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("GetUsageReport: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "get-usage-report")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "get-usage-report", request)
 	if err != nil {
 		return nil, fmt.Errorf("GetUsageReport: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("GetUsageReport: http response: %w", err)
 	}
@@ -18577,6 +18121,10 @@ func (c Client) GetUsageReport(ctx context.Context, opts ...GetUsageReportOpt) (
 		return nil, fmt.Errorf("GetUsageReport: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("GetUsageReport: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18617,23 +18165,11 @@ func (c Client) ListUsers(ctx context.Context) (*ListUsersResponse, error) {
 		return nil, fmt.Errorf("ListUsers: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListUsers: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-users")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-users", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListUsers: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListUsers: http response: %w", err)
 	}
@@ -18643,9 +18179,32 @@ func (c Client) ListUsers(ctx context.Context) (*ListUsersResponse, error) {
 		return nil, fmt.Errorf("ListUsers: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListUsers: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
+// AllUsers returns an iterator over the User items returned by ListUsers.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllUsers(ctx context.Context) iter.Seq2[User, error] {
+	return func(yield func(User, error) bool) {
+		resp, err := c.ListUsers(ctx)
+		if err != nil {
+			var zero User
+			yield(zero, fmt.Errorf("AllUsers: %w", err))
+			return
+		}
+
+		for _, item := range resp.Users {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 type CreateUserRequest struct {
 	// User Email
 	Email string `json:"email" validate:"required"`
@@ -18656,6 +18215,10 @@ type CreateUserRequest struct {
 func (c Client) CreateUser(ctx context.Context, req CreateUserRequest) (*Operation, error) {
 	path := "/user"
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("CreateUser: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("CreateUser: prepare Json body: %w", err)
@@ -18674,23 +18237,11 @@ func (c Client) CreateUser(ctx context.Context, req CreateUserRequest) (*Operati
 		return nil, fmt.Errorf("CreateUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("CreateUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "create-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "create-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("CreateUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("CreateUser: http response: %w", err)
 	}
@@ -18700,6 +18251,10 @@ func (c Client) CreateUser(ctx context.Context, req CreateUserRequest) (*Operati
 		return nil, fmt.Errorf("CreateUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("CreateUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18717,23 +18272,11 @@ func (c Client) DeleteUser(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("DeleteUser: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("DeleteUser: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "delete-user")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "delete-user", request)
 	if err != nil {
 		return nil, fmt.Errorf("DeleteUser: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("DeleteUser: http response: %w", err)
 	}
@@ -18743,6 +18286,10 @@ func (c Client) DeleteUser(ctx context.Context, id UUID) (*Operation, error) {
 		return nil, fmt.Errorf("DeleteUser: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("DeleteUser: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18754,6 +18301,10 @@ type UpdateUserRoleRequest struct {
 func (c Client) UpdateUserRole(ctx context.Context, id UUID, req UpdateUserRoleRequest) (*Operation, error) {
 	path := fmt.Sprintf("/user/%v", id)
 
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("UpdateUserRole: validate request: %w", err)
+	}
+
 	body, err := prepareJSONBody(req)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateUserRole: prepare Json body: %w", err)
@@ -18772,23 +18323,11 @@ func (c Client) UpdateUserRole(ctx context.Context, id UUID, req UpdateUserRoleR
 		return nil, fmt.Errorf("UpdateUserRole: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("UpdateUserRole: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "update-user-role")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "update-user-role", request)
 	if err != nil {
 		return nil, fmt.Errorf("UpdateUserRole: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("UpdateUserRole: http response: %w", err)
 	}
@@ -18798,6 +18337,10 @@ func (c Client) UpdateUserRole(ctx context.Context, id UUID, req UpdateUserRoleR
 		return nil, fmt.Errorf("UpdateUserRole: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("UpdateUserRole: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
 
@@ -18839,23 +18382,11 @@ func (c Client) ListZones(ctx context.Context) (*ListZonesResponse, error) {
 		return nil, fmt.Errorf("ListZones: execute request editors: %w", err)
 	}
 
-	if err := c.signRequest(request); err != nil {
-		return nil, fmt.Errorf("ListZones: sign request: %w", err)
-	}
-
-	if c.trace {
-		dumpRequest(request, "list-zones")
-	}
-
-	response, err := c.httpClient.Do(request)
+	response, err := c.do(ctx, "list-zones", request)
 	if err != nil {
 		return nil, fmt.Errorf("ListZones: http client do: %w", err)
 	}
 
-	if c.trace {
-		dumpResponse(response)
-	}
-
 	if err := handleHTTPErrorResp(response); err != nil {
 		return nil, fmt.Errorf("ListZones: http response: %w", err)
 	}
@@ -18865,5 +18396,28 @@ func (c Client) ListZones(ctx context.Context) (*ListZonesResponse, error) {
 		return nil, fmt.Errorf("ListZones: prepare Json response: %w", err)
 	}
 
+	if err := c.validateResponse(bodyresp); err != nil {
+		return nil, fmt.Errorf("ListZones: validate response: %w", err)
+	}
+
 	return bodyresp, nil
 }
+
+// AllZones returns an iterator over the Zone items returned by ListZones.
+// The list request is issued on the first iteration; iteration stops on the first error.
+func (c Client) AllZones(ctx context.Context) iter.Seq2[Zone, error] {
+	return func(yield func(Zone, error) bool) {
+		resp, err := c.ListZones(ctx)
+		if err != nil {
+			var zero Zone
+			yield(zero, fmt.Errorf("AllZones: %w", err))
+			return
+		}
+
+		for _, item := range resp.Zones {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
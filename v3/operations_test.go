@@ -0,0 +1,122 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...ClientOpt) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	opts = append([]ClientOpt{
+		ClientOptWithEndpoint(Endpoint(srv.URL)),
+		ClientOptWithHTTPClient(srv.Client()),
+	}, opts...)
+
+	client, err := NewClient(credentials.NewStaticCredentials("EXOtest", "secret"), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestAllInstances(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/instance" {
+			t.Errorf("unexpected path %q", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("manager-type"); got != "instance-pool" {
+			t.Errorf("manager-type = %q, want %q", got, "instance-pool")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"instances":[{"name":"a"},{"name":"b"},{"name":"c"}]}`))
+	})
+
+	var names []string
+	for instance, err := range client.AllInstances(context.Background(), ListInstancesWithManagerType("instance-pool")) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, instance.Name)
+		if len(names) == 2 {
+			break
+		}
+	}
+
+	if len(names) != 2 || names[0] != "a" || names[1] != "b" {
+		t.Errorf("got %v, want [a b]", names)
+	}
+}
+
+func TestAllEventsError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message":"denied"}`))
+	})
+
+	var calls int
+	for _, err := range client.AllEvents(context.Background()) {
+		calls++
+		if !errors.Is(err, ErrForbidden) {
+			t.Errorf("expected ErrForbidden, got %v", err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("expected a single iteration, got %d", calls)
+	}
+}
+
+func TestCreateInstanceAndWait(t *testing.T) {
+	const instanceID = "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b"
+
+	state := OperationStateSuccess
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/instance":
+			_, _ = w.Write([]byte(`{"id":"9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e","state":"` + state + `",` +
+				`"reference":{"id":"` + instanceID + `","command":"get-instance"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/operation/9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e":
+			_, _ = w.Write([]byte(`{"id":"9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e","state":"pending"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/instance/"+instanceID:
+			_, _ = w.Write([]byte(`{"id":"` + instanceID + `","name":"test"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	instance, err := client.CreateInstanceAndWait(context.Background(), CreateInstanceRequest{Name: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if instance.ID != instanceID || instance.Name != "test" {
+		t.Errorf("unexpected instance %+v", instance)
+	}
+
+	state = OperationStateFailure
+	_, err = client.CreateInstanceAndWait(context.Background(), CreateInstanceRequest{Name: "test"})
+	var opErr *OperationError
+	if !errors.As(err, &opErr) || opErr.Operation.State != OperationStateFailure {
+		t.Errorf("expected an OperationError, got %v", err)
+	}
+
+	state = OperationStatePending
+	_, err = client.CreateInstanceAndWait(context.Background(), CreateInstanceRequest{Name: "test"},
+		WaitOptWithBackoff(func(time.Duration) time.Duration { return time.Millisecond }),
+		WaitOptWithTimeout(20*time.Millisecond),
+	)
+	if !errors.Is(err, ErrWaitTimeout) {
+		t.Errorf("expected the wait options to be applied, got %v", err)
+	}
+}
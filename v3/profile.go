@@ -0,0 +1,105 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+// NewClientFromProfile returns a new Exoscale API client configured from an account of the exo CLI configuration file:
+//   - the endpoint of the account default zone, resolved from the default ZoneRegistry (refreshed with ListZones
+//     if the zone is unknown), and environment,
+//   - the account SOS endpoint, see Client.SOSEndpoint,
+//   - the account client timeout (in minutes), applied to HTTP requests and to Wait,
+//   - the account custom headers, added to every request.
+//
+// The given opts are applied after the account configuration and can override it.
+func NewClientFromProfile(provider *credentials.FileProvider, opts ...ClientOpt) (*Client, error) {
+	account, err := provider.Account()
+	if err != nil {
+		return nil, fmt.Errorf("new client from profile: %w", err)
+	}
+
+	return NewClient(credentials.NewCredentials(provider), append(profileClientOpts(account), opts...)...)
+}
+
+// profileClientOpts returns the ClientOpts applying the configuration of an account.
+func profileClientOpts(account credentials.Account) []ClientOpt {
+	var opts []ClientOpt
+
+	if account.DefaultZone != "" || account.Environment != "" {
+		zone := ZoneName(account.DefaultZone)
+		if zone == "" {
+			zone = ZoneNameCHGva2
+		}
+		opts = append(opts, clientOptWithProfileZone(zone))
+
+		if account.Environment != "" && account.Environment != "api" {
+			opts = append(opts, clientOptWithEnvironment(account.Environment))
+		}
+	}
+
+	if account.SosEndpoint != "" {
+		opts = append(opts, ClientOptWithSOSEndpoint(account.SosEndpoint))
+	}
+
+	if account.ClientTimeout > 0 {
+		timeout := time.Duration(account.ClientTimeout) * time.Minute
+		opts = append(opts, ClientOptWithTimeout(timeout), ClientOptWithWaitTimeout(timeout))
+	}
+
+	if len(account.CustomHeaders) > 0 {
+		headers := account.CustomHeaders
+		opts = append(opts, ClientOptWithRequestInterceptors(func(ctx context.Context, req *http.Request) error {
+			for name, value := range headers {
+				req.Header.Set(name, value)
+			}
+			return nil
+		}))
+	}
+
+	return opts
+}
+
+// clientOptWithProfileZone returns a ClientOpt setting the Endpoint of the given zone.
+// Unlike ClientOptWithZone, zones unknown to the Client ZoneRegistry are resolved by refreshing it with ListZones,
+// so accounts can default to zones opened after this library release.
+func clientOptWithProfileZone(zone ZoneName) ClientOpt {
+	return func(c *Client) error {
+		endpoint, ok := c.zones.lookup(zone)
+		if !ok {
+			var err error
+			if endpoint, err = c.zones.Endpoint(context.Background(), c, zone); err != nil {
+				return fmt.Errorf("zone %q: %w", zone, err)
+			}
+		}
+		c.serverEndpoint = string(endpoint)
+
+		return nil
+	}
+}
+
+// clientOptWithEnvironment returns a ClientOpt replacing the "api" environment of the Client zone endpoint
+// (e.g. https://api-ch-gva-2.exoscale.com/v2) with the given one, following the exo CLI conventions.
+func clientOptWithEnvironment(environment string) ClientOpt {
+	return func(c *Client) error {
+		u, err := url.Parse(c.serverEndpoint)
+		if err != nil {
+			return fmt.Errorf("environment %q: %w", environment, err)
+		}
+
+		host, ok := strings.CutPrefix(u.Host, "api-")
+		if !ok {
+			return fmt.Errorf("environment %q: unexpected zone endpoint %q", environment, c.serverEndpoint)
+		}
+		u.Host = environment + "-" + host
+		c.serverEndpoint = u.String()
+
+		return nil
+	}
+}
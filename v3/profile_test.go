@@ -0,0 +1,128 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func TestNewClientFromProfile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "exoscale.toml")
+	err := os.WriteFile(filename, []byte(`
+defaultAccount = "prod"
+
+[[accounts]]
+name = "prod"
+key = "EXOtest"
+secret = "secret"
+defaultZone = "de-fra-1"
+environment = "ppapi"
+sosEndpoint = "https://sos-de-fra-1.exo.io"
+clientTimeout = 5
+customHeaders = { X-Team = "infra" }
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewClientFromProfile(credentials.NewFileProvider(credentials.FileOptWithFilename(filename)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if client.serverEndpoint != "https://ppapi-de-fra-1.exoscale.com/v2" {
+		t.Errorf("unexpected endpoint %q", client.serverEndpoint)
+	}
+	if client.SOSEndpoint() != "https://sos-de-fra-1.exo.io" {
+		t.Errorf("unexpected SOS endpoint %q", client.SOSEndpoint())
+	}
+	if client.waitTimeout != 5*time.Minute || client.requestHTTPClient().Timeout != 5*time.Minute {
+		t.Errorf("unexpected timeouts %s, %s", client.waitTimeout, client.requestHTTPClient().Timeout)
+	}
+	if client.httpClient != defaultHTTPClient || defaultHTTPClient.Timeout != 0 {
+		t.Error("the default HTTP client must not be modified")
+	}
+	if hc := client.WithRetryPolicy(testRetryPolicy()).requestHTTPClient(); hc.Transport != nil || hc.Timeout != 5*time.Minute {
+		t.Error("a client with a RetryPolicy must send requests without the retrying transport, with the profile timeout")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Team"); got != "infra" {
+			t.Errorf("X-Team header = %q, want %q", got, "infra")
+		}
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	if _, err := client.WithEndpoint(Endpoint(srv.URL)).WithHTTPClient(srv.Client()).ListZones(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProfileClientOpts(t *testing.T) {
+	tests := []struct {
+		name     string
+		account  credentials.Account
+		endpoint string
+		wantErr  bool
+	}{
+		{name: "default", endpoint: string(CHGva2)},
+		{name: "zone", account: credentials.Account{DefaultZone: "at-vie-1"}, endpoint: string(ATVie1)},
+		{
+			name:     "environment",
+			account:  credentials.Account{Environment: "ppapi"},
+			endpoint: "https://ppapi-ch-gva-2.exoscale.com/v2",
+		},
+		{name: "api environment", account: credentials.Account{DefaultZone: "ch-dk-2", Environment: "api"}, endpoint: string(CHDk2)},
+		{name: "new zone", account: credentials.Account{DefaultZone: "xx-new-1"}, endpoint: "https://api-xx-new-1.exoscale.com/v2"},
+		{
+			name:     "new zone environment",
+			account:  credentials.Account{DefaultZone: "xx-new-1", Environment: "ppapi"},
+			endpoint: "https://ppapi-xx-new-1.exoscale.com/v2",
+		},
+		{name: "unknown zone", account: credentials.Account{DefaultZone: "xx-nowhere-1"}, wantErr: true},
+	}
+
+	var listZones int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		listZones++
+		_, _ = w.Write([]byte(`{"zones":[{"name":"xx-new-1","api-endpoint":"https://api-xx-new-1.exoscale.com/v2"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listZones = 0
+			opts := profileClientOpts(tt.account)
+			if tt.account.DefaultZone != "" {
+				// Zones unknown to the registry are resolved with ListZones, called on the test server.
+				opts = append([]ClientOpt{ClientOptWithEndpoint(Endpoint(srv.URL))}, opts...)
+			}
+			client, err := NewClient(credentials.NewStaticCredentials("EXOtest", "secret"), opts...)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := defaultZoneEndpoints[ZoneName(tt.account.DefaultZone)]; ok && listZones != 0 {
+				t.Errorf("built-in zones must be resolved without network requests, got %d requests", listZones)
+			}
+			if client.serverEndpoint != tt.endpoint {
+				t.Errorf("got endpoint %q, want %q", client.serverEndpoint, tt.endpoint)
+			}
+			if client.timeout != 0 || client.requestHTTPClient() != defaultHTTPClient {
+				t.Error("accounts without client timeout must use the default HTTP client")
+			}
+		})
+	}
+}
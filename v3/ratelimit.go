@@ -0,0 +1,179 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// OperationClass represents the class of an API operation for rate limiting purposes.
+type OperationClass string
+
+const (
+	// OperationClassRead represents read-only operations (GET, HEAD, OPTIONS).
+	OperationClassRead OperationClass = "read"
+	// OperationClassMutate represents operations modifying resources.
+	OperationClassMutate OperationClass = "mutate"
+)
+
+// operationClass returns the OperationClass of an HTTP method.
+func operationClass(method string) OperationClass {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return OperationClassRead
+	}
+
+	return OperationClassMutate
+}
+
+// RateLimit represents a token bucket configuration.
+// A zero Rate disables rate limiting.
+type RateLimit struct {
+	// Rate is the number of requests allowed per second.
+	Rate float64
+	// Burst is the maximum number of requests allowed at once, 1 if not set.
+	Burst int
+}
+
+type rateLimits struct {
+	read   RateLimit
+	mutate RateLimit
+}
+
+func (r rateLimits) get(class OperationClass) RateLimit {
+	if class == OperationClassRead {
+		return r.read
+	}
+
+	return r.mutate
+}
+
+type bucketKey struct {
+	endpoint Endpoint
+	class    OperationClass
+}
+
+// RateLimiter is a client-side token bucket rate limiter,
+// with a bucket per zone endpoint and operation class.
+// A RateLimiter is safe for concurrent use and is shared by all
+// the clients derived from the client it is set on.
+type RateLimiter struct {
+	defaults  rateLimits
+	endpoints map[Endpoint]rateLimits
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*tokenBucket
+	now     func() time.Time
+}
+
+// RateLimiterOpt represents a function setting a RateLimiter option.
+type RateLimiterOpt func(*RateLimiter)
+
+// RateLimiterOptWithEndpoint returns a RateLimiterOpt overriding the rate limits of a given zone Endpoint.
+func RateLimiterOptWithEndpoint(endpoint Endpoint, read, mutate RateLimit) RateLimiterOpt {
+	return func(l *RateLimiter) {
+		l.endpoints[endpoint] = rateLimits{read: read, mutate: mutate}
+	}
+}
+
+// NewRateLimiter returns a RateLimiter applying the given read and mutate
+// operations rate limits to every zone endpoint.
+func NewRateLimiter(read, mutate RateLimit, opts ...RateLimiterOpt) *RateLimiter {
+	l := &RateLimiter{
+		defaults:  rateLimits{read: read, mutate: mutate},
+		endpoints: make(map[Endpoint]rateLimits),
+		buckets:   make(map[bucketKey]*tokenBucket),
+		now:       time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// Wait blocks until a request of the given HTTP method is allowed on the zone endpoint,
+// or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context, endpoint Endpoint, method string) error {
+	class := operationClass(method)
+
+	l.mu.Lock()
+	key := bucketKey{endpoint: endpoint, class: class}
+	b, ok := l.buckets[key]
+	if !ok {
+		limits, ok := l.endpoints[endpoint]
+		if !ok {
+			limits = l.defaults
+		}
+		b = newTokenBucket(limits.get(class), l.now())
+		l.buckets[key] = b
+	}
+	wait := b.reserve(l.now())
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		b.cancel()
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// tokenBucket is a token bucket, it must be guarded by the RateLimiter mutex.
+type tokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit, now time.Time) *tokenBucket {
+	if limit.Burst < 1 {
+		limit.Burst = 1
+	}
+
+	return &tokenBucket{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   now,
+	}
+}
+
+// reserve takes a token from the bucket and returns the delay
+// to wait before the token is actually available.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if b.limit.Rate <= 0 {
+		return 0
+	}
+
+	elapsed := now.Sub(b.last).Seconds()
+	b.last = now
+	b.tokens = min(b.tokens+elapsed*b.limit.Rate, float64(b.limit.Burst))
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.limit.Rate * float64(time.Second))
+}
+
+// cancel gives back a token taken by an abandoned reservation.
+func (b *tokenBucket) cancel() {
+	if b.limit.Rate <= 0 {
+		return
+	}
+
+	b.tokens = min(b.tokens+1, float64(b.limit.Burst))
+}
@@ -0,0 +1,74 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(RateLimit{Rate: 2, Burst: 2}, now)
+
+	for i := 0; i < 2; i++ {
+		if wait := b.reserve(now); wait != 0 {
+			t.Fatalf("reservation %d: expected no wait, got %v", i, wait)
+		}
+	}
+
+	if wait := b.reserve(now); wait != 500*time.Millisecond {
+		t.Errorf("expected 500ms wait, got %v", wait)
+	}
+
+	b.cancel()
+	if wait := b.reserve(now.Add(500 * time.Millisecond)); wait != 0 {
+		t.Errorf("expected no wait after refill, got %v", wait)
+	}
+}
+
+func TestRateLimiterClasses(t *testing.T) {
+	l := NewRateLimiter(
+		RateLimit{Rate: 1},
+		RateLimit{},
+		RateLimiterOptWithEndpoint(CHDk2, RateLimit{}, RateLimit{Rate: 1}),
+	)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(ctx, CHGva2, http.MethodPost); err != nil {
+			t.Fatal(err)
+		}
+		if err := l.Wait(ctx, CHDk2, http.MethodGet); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := l.Wait(ctx, CHGva2, http.MethodGet); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, CHGva2, http.MethodGet); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected read operations to be limited in %s, got %v", CHGva2, err)
+	}
+}
+
+func TestRateLimiterSharedByClones(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"zones":[]}`))
+	}, ClientOptWithRateLimiter(NewRateLimiter(RateLimit{Rate: 0.1}, RateLimit{})))
+
+	if _, err := client.ListZones(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.WithUserAgent("test").ListZones(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the cloned client to be rate limited, got %v", err)
+	}
+}
@@ -0,0 +1,370 @@
+package v3
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+)
+
+// RecorderMode represents the mode of a Recorder.
+type RecorderMode int
+
+const (
+	// RecorderModeReplayOrRecord replays the cassette if it exists, records it otherwise.
+	RecorderModeReplayOrRecord RecorderMode = iota
+	// RecorderModeRecord sends requests to the API and records the interactions, replacing the cassette.
+	RecorderModeRecord
+	// RecorderModeReplay replays the interactions of the cassette, without network requests.
+	RecorderModeReplay
+)
+
+// apiKeyPattern matches API keys, scrubbed wherever they appear in cassettes.
+var apiKeyPattern = regexp.MustCompile(`EXO[0-9a-f]{24}`)
+
+// RecorderOpt represents a function setting a Recorder option.
+type RecorderOpt func(*Recorder)
+
+// RecorderOptWithMode returns a RecorderOpt overriding the Recorder mode (RecorderModeReplayOrRecord by default).
+func RecorderOptWithMode(mode RecorderMode) RecorderOpt {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// RecorderOptWithTransport returns a RecorderOpt overriding the transport of recorded requests
+// (http.DefaultTransport by default).
+func RecorderOptWithTransport(t http.RoundTripper) RecorderOpt {
+	return func(r *Recorder) {
+		r.transport = t
+	}
+}
+
+// RecorderOptWithScrubbedFields returns a RecorderOpt scrubbing additional JSON fields from the recorded bodies.
+func RecorderOptWithScrubbedFields(fields ...string) RecorderOpt {
+	return func(r *Recorder) {
+		for _, f := range fields {
+			r.scrubbedFields[f] = struct{}{}
+		}
+	}
+}
+
+// A Recorder is an http.RoundTripper recording API interactions to a cassette file, and replaying them later.
+//
+// The signature headers, API keys and secret fields (passwords, secrets, tokens...) are scrubbed from the cassettes.
+// Requests are matched with the recorded interactions by operation ID, URL path and query, and body,
+// each interaction being replayed once in the recorded order.
+type Recorder struct {
+	filename       string
+	mode           RecorderMode
+	transport      http.RoundTripper
+	scrubbedFields map[string]struct{}
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	replayed     []bool
+}
+
+// A Cassette holds the interactions recorded by a Recorder.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// An Interaction is a recorded API request and its response.
+type Interaction struct {
+	OperationID string           `json:"operation-id,omitempty"`
+	Request     RecordedRequest  `json:"request"`
+	Response    RecordedResponse `json:"response"`
+}
+
+// A RecordedRequest is a scrubbed API request.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	RecordedBody
+}
+
+// A RecordedResponse is a scrubbed API response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status-code"`
+	Header     http.Header `json:"header,omitempty"`
+	RecordedBody
+}
+
+// A RecordedBody is a scrubbed request or response body, recorded as is if it is JSON, as text if it is UTF-8,
+// and base64 encoded otherwise.
+type RecordedBody struct {
+	Body   json.RawMessage `json:"body,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Binary []byte          `json:"binary,omitempty"`
+}
+
+func (b RecordedBody) equal(o RecordedBody) bool {
+	return bytes.Equal(b.Body, o.Body) && b.Text == o.Text && bytes.Equal(b.Binary, o.Binary)
+}
+
+func (b RecordedBody) bytes() []byte {
+	switch {
+	case len(b.Body) > 0:
+		return b.Body
+	case len(b.Binary) > 0:
+		return b.Binary
+	}
+
+	return []byte(b.Text)
+}
+
+// NewRecorder returns a Recorder of the cassette file.
+// The cassette is loaded unless recording, the Recorder must be saved once done recording.
+//
+//	rec, err := v3.NewRecorder("testdata/create-cluster.json")
+//	client, err := v3.NewClient(creds, v3.ClientOptWithHTTPClient(&http.Client{Transport: rec}))
+//	defer rec.Save()
+func NewRecorder(filename string, opts ...RecorderOpt) (*Recorder, error) {
+	r := &Recorder{
+		filename:       filename,
+		transport:      http.DefaultTransport,
+		scrubbedFields: make(map[string]struct{}, len(secretProperties)),
+	}
+	for f := range secretProperties {
+		r.scrubbedFields[f] = struct{}{}
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.mode == RecorderModeReplayOrRecord {
+		r.mode = RecorderModeReplay
+		if _, err := os.Stat(filename); errors.Is(err, os.ErrNotExist) {
+			r.mode = RecorderModeRecord
+		}
+	}
+
+	if r.mode == RecorderModeRecord {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("recorder: read cassette: %w", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("recorder: decode cassette %q: %w", filename, err)
+	}
+	// Bodies are compared as compact JSON.
+	for _, interaction := range cassette.Interactions {
+		for _, b := range []*RecordedBody{&interaction.Request.RecordedBody, &interaction.Response.RecordedBody} {
+			if len(b.Body) > 0 {
+				var buf bytes.Buffer
+				if err := json.Compact(&buf, b.Body); err != nil {
+					return nil, fmt.Errorf("recorder: decode cassette %q: %w", filename, err)
+				}
+				b.Body = buf.Bytes()
+			}
+		}
+	}
+	r.interactions = cassette.Interactions
+	r.replayed = make([]bool, len(r.interactions))
+
+	return r, nil
+}
+
+// Mode returns the mode of the Recorder, RecorderModeRecord or RecorderModeReplay.
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// RoundTrip records or replays an API interaction.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	operationID, _ := OperationIDFromContext(req.Context())
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("recorder: read request body: %w", err)
+		}
+		req.Body.Close()
+
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := RecordedRequest{
+		Method:       req.Method,
+		URL:          r.scrubString(req.URL.RequestURI()),
+		Header:       r.scrubHeader(req.Header),
+		RecordedBody: r.scrubBody(body),
+	}
+
+	if r.mode == RecorderModeReplay {
+		return r.replay(req, operationID, recorded)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("recorder: read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, &Interaction{
+		OperationID: operationID,
+		Request:     recorded,
+		Response: RecordedResponse{
+			StatusCode:   resp.StatusCode,
+			Header:       r.scrubHeader(resp.Header),
+			RecordedBody: r.scrubBody(respBody),
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay returns the response of the first interaction matching the request not replayed yet.
+func (r *Recorder) replay(req *http.Request, operationID string, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.replayed[i] ||
+			interaction.OperationID != operationID ||
+			interaction.Request.Method != recorded.Method ||
+			interaction.Request.URL != recorded.URL ||
+			!interaction.Request.equal(recorded.RecordedBody) {
+			continue
+		}
+		r.replayed[i] = true
+		body := interaction.Response.bytes()
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("recorder: %s %s (operation %q): %w", recorded.Method, recorded.URL, operationID, ErrInteractionNotFound)
+}
+
+// Save writes the recorded interactions to the cassette file, it does nothing when replaying.
+func (r *Recorder) Save() error {
+	if r.mode != RecorderModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(Cassette{Interactions: r.interactions}, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("recorder: encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.filename), 0o755); err != nil {
+		return fmt.Errorf("recorder: %w", err)
+	}
+	if err := os.WriteFile(r.filename, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("recorder: write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// Unused returns the recorded interactions not replayed, e.g. to check a flow replayed completely.
+func (r *Recorder) Unused() []*Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []*Interaction
+	for i, interaction := range r.interactions {
+		if !r.replayed[i] {
+			unused = append(unused, interaction)
+		}
+	}
+
+	return unused
+}
+
+func (r *Recorder) scrubString(s string) string {
+	return apiKeyPattern.ReplaceAllString(s, redacted)
+}
+
+func (r *Recorder) scrubHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, name := range redactedHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, redacted)
+		}
+	}
+	// Dates and signatures vary between runs.
+	h.Del("Date")
+	h.Del("User-Agent")
+
+	return h
+}
+
+// scrubBody returns the body with its secret fields and API keys scrubbed.
+func (r *Recorder) scrubBody(body []byte) RecordedBody {
+	if len(body) == 0 {
+		return RecordedBody{}
+	}
+	if !utf8.Valid(body) {
+		return RecordedBody{Binary: body}
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return RecordedBody{Text: r.scrubString(string(body))}
+	}
+
+	data, err := json.Marshal(r.scrub(v))
+	if err != nil {
+		return RecordedBody{Text: r.scrubString(string(body))}
+	}
+
+	return RecordedBody{Body: data}
+}
+
+// scrub replaces recursively the secret fields and API keys of a decoded JSON value.
+func (r *Recorder) scrub(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if _, ok := r.scrubbedFields[k]; ok {
+				v[k] = redacted
+				continue
+			}
+			v[k] = r.scrub(val)
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = r.scrub(val)
+		}
+	case string:
+		return r.scrubString(v)
+	}
+
+	return v
+}
@@ -0,0 +1,182 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func TestRecorder(t *testing.T) {
+	const apiKey = "EXO0123456789abcdef01234567"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/dbaas-postgres/db/user/admin/password/reveal":
+			_, _ = w.Write([]byte(`{"username":"admin","password":"s3cr3t"}`))
+		case "/iam-role/0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b/assume":
+			_, _ = w.Write([]byte(`{"key":"` + apiKey + `","secret":"s3cr3t"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	filename := filepath.Join(t.TempDir(), "cassette.json")
+	flow := func(rec *Recorder) (*DBAASUserPostgresSecrets, *AssumeIAMRoleResponse) {
+		t.Helper()
+
+		client, err := NewClient(credentials.NewStaticCredentials(apiKey, "secret"),
+			ClientOptWithEndpoint(Endpoint(srv.URL)),
+			ClientOptWithHTTPClient(&http.Client{Transport: rec}),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := context.Background()
+		secrets, err := client.RevealDBAASPostgresUserPassword(ctx, "db", "admin")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assumed, err := client.AssumeIAMRole(ctx, "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b", AssumeIAMRoleRequest{Ttl: 60})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return secrets, assumed
+	}
+
+	rec, err := NewRecorder(filename)
+	if err != nil || rec.Mode() != RecorderModeRecord {
+		t.Fatalf("expected a recording Recorder without cassette, got %v", err)
+	}
+	if secrets, assumed := flow(rec); secrets.Password != "s3cr3t" || assumed.Key != apiKey {
+		t.Errorf("recorded responses must be returned as is, got %+v, %+v", secrets, assumed)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "s3cr3t") || strings.Contains(string(data), apiKey) {
+		t.Errorf("secrets must be scrubbed from cassettes, got %s", data)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil || len(cassette.Interactions) != 2 ||
+		cassette.Interactions[0].OperationID != "reveal-dbaas-postgres-user-password" {
+		t.Fatalf("unexpected cassette %s: %v", data, err)
+	}
+
+	srv.Close()
+
+	rec, err = NewRecorder(filename)
+	if err != nil || rec.Mode() != RecorderModeReplay {
+		t.Fatalf("expected a replaying Recorder with a cassette, got %v", err)
+	}
+	if secrets, assumed := flow(rec); secrets.Password != redacted || assumed.Secret != redacted {
+		t.Errorf("unexpected replayed responses %+v, %+v", secrets, assumed)
+	}
+	if unused := rec.Unused(); len(unused) != 0 {
+		t.Errorf("unexpected unused interactions %+v", unused)
+	}
+
+	client, err := NewClient(credentials.NewStaticCredentials(apiKey, "secret"),
+		ClientOptWithEndpoint(Endpoint(srv.URL)),
+		ClientOptWithHTTPClient(&http.Client{Transport: rec}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.RevealDBAASPostgresUserPassword(context.Background(), "db", "admin"); !errors.Is(err, ErrInteractionNotFound) {
+		t.Errorf("interactions must be replayed once, got %v", err)
+	}
+}
+
+func TestRecorderBinaryBodies(t *testing.T) {
+	rec := &Recorder{}
+
+	binary := []byte{0x1f, 0x8b, 0x08, 0x00, 0xff}
+	data, err := json.Marshal(rec.scrubBody(binary))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body RecordedBody
+	if err := json.Unmarshal(data, &body); err != nil || !bytes.Equal(body.bytes(), binary) {
+		t.Errorf("binary bodies must be recorded as is, got %s: %v", data, err)
+	}
+
+	if body := rec.scrubBody([]byte("zone file")); body.Text != "zone file" {
+		t.Errorf("text bodies must be recorded as text, got %+v", body)
+	}
+}
+
+func TestRecorderRetries(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"zones":[{"name":"ch-gva-2"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	filename := filepath.Join(t.TempDir(), "cassette.json")
+	flow := func(rec *Recorder) {
+		t.Helper()
+
+		client, err := NewClient(credentials.NewStaticCredentials("EXOtest", "secret"),
+			ClientOptWithEndpoint(Endpoint(srv.URL)),
+			ClientOptWithHTTPClient(&http.Client{Transport: rec}),
+			ClientOptWithRetryPolicy(testRetryPolicy()),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		zones, err := client.ListZones(context.Background())
+		if err != nil || len(zones.Zones) != 1 {
+			t.Fatalf("unexpected zones %+v: %v", zones, err)
+		}
+	}
+
+	rec, err := NewRecorder(filename, RecorderOptWithMode(RecorderModeRecord))
+	if err != nil {
+		t.Fatal(err)
+	}
+	flow(rec)
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	rec, err = NewRecorder(filename, RecorderOptWithMode(RecorderModeReplay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, interaction := range rec.interactions {
+		if interaction.OperationID != "list-zones" {
+			t.Errorf("retried attempts must be recorded with their operation ID, got %q", interaction.OperationID)
+		}
+	}
+	if len(rec.interactions) != 2 {
+		t.Fatalf("expected the failed attempt and its retry to be recorded, got %d interactions", len(rec.interactions))
+	}
+
+	flow(rec)
+	if unused := rec.Unused(); len(unused) != 0 || calls != 2 {
+		t.Errorf("the retried flow must be replayed without requests, got %d unused interactions and %d calls", len(unused), calls)
+	}
+}
@@ -0,0 +1,151 @@
+package v3
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// plainHTTPClient is the HTTP client used in place of defaultHTTPClient
+// when a RetryPolicy is set, to avoid retrying requests twice.
+var plainHTTPClient = &http.Client{}
+
+// noRetryHTTPClient returns the HTTP client to use along with a RetryPolicy.
+func noRetryHTTPClient(hc *http.Client) *http.Client {
+	if hc == defaultHTTPClient {
+		return plainHTTPClient
+	}
+
+	return hc
+}
+
+// retryHTTPClient returns the HTTP client to use without RetryPolicy, reverting noRetryHTTPClient.
+func retryHTTPClient(hc *http.Client) *http.Client {
+	if hc == plainHTTPClient {
+		return defaultHTTPClient
+	}
+
+	return hc
+}
+
+// RetryAttempt describes a failed attempt of an API request.
+type RetryAttempt struct {
+	// OperationID is the OpenAPI operation ID of the request (e.g. "create-instance").
+	OperationID string
+	// Method is the HTTP method of the request.
+	Method string
+	// Attempt is the number of attempts made so far, starting at 1.
+	// The attempt retried once after its credentials were rejected (HTTP 401) is not counted.
+	Attempt int
+	// Response is the HTTP response of the attempt, nil on transport error.
+	Response *http.Response
+	// Err is the transport error of the attempt, nil if a response was received.
+	Err error
+}
+
+// RetryPolicy decides whether a failed API request must be attempted again.
+type RetryPolicy interface {
+	// Retry returns the delay to wait before the next attempt,
+	// and false if the request must not be retried.
+	Retry(ctx context.Context, attempt RetryAttempt) (time.Duration, bool)
+}
+
+// DefaultRetryPolicy is a RetryPolicy retrying safe (GET, HEAD, OPTIONS) requests
+// and explicitly idempotent operations on transport errors and transient HTTP errors,
+// with an exponential backoff.
+// The Retry-After header is honored on HTTP 429 and 503 responses, within MaxWait.
+type DefaultRetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// MinWait is the delay before the first retry, doubled on every subsequent one.
+	MinWait time.Duration
+	// MaxWait caps the delay between two attempts, including the one requested by Retry-After.
+	MaxWait time.Duration
+	// IdempotentOperations lists the operation IDs safe to retry regardless of their HTTP method.
+	IdempotentOperations []string
+	// OnRetry, if set, is called before waiting for every retried attempt.
+	OnRetry func(attempt RetryAttempt, wait time.Duration)
+}
+
+// NewRetryPolicy returns a DefaultRetryPolicy with 5 attempts and a backoff from 1 to 30 seconds.
+func NewRetryPolicy(idempotentOperations ...string) *DefaultRetryPolicy {
+	return &DefaultRetryPolicy{
+		MaxAttempts:          5,
+		MinWait:              time.Second,
+		MaxWait:              30 * time.Second,
+		IdempotentOperations: idempotentOperations,
+	}
+}
+
+// Retry implements RetryPolicy.
+func (p *DefaultRetryPolicy) Retry(ctx context.Context, attempt RetryAttempt) (time.Duration, bool) {
+	if ctx.Err() != nil || attempt.Attempt >= p.MaxAttempts {
+		return 0, false
+	}
+
+	if !p.isIdempotent(attempt) {
+		return 0, false
+	}
+
+	wait := p.backoff(attempt.Attempt)
+	if attempt.Err == nil {
+		switch attempt.Response.StatusCode {
+		case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+			if d, ok := retryAfter(attempt.Response); ok {
+				wait = d
+				if p.MaxWait > 0 {
+					wait = min(d, p.MaxWait)
+				}
+			}
+		case http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout:
+		default:
+			return 0, false
+		}
+	}
+
+	if p.OnRetry != nil {
+		p.OnRetry(attempt, wait)
+	}
+
+	return wait, true
+}
+
+func (p *DefaultRetryPolicy) isIdempotent(attempt RetryAttempt) bool {
+	switch attempt.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return slices.Contains(p.IdempotentOperations, attempt.OperationID)
+}
+
+func (p *DefaultRetryPolicy) backoff(attempt int) time.Duration {
+	wait := float64(p.MinWait) * math.Pow(2, float64(attempt-1))
+	if p.MaxWait > 0 && wait > float64(p.MaxWait) {
+		return p.MaxWait
+	}
+
+	return time.Duration(wait)
+}
+
+// retryAfter returns the delay requested by the Retry-After header of an HTTP response,
+// expressed either in seconds or as an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,219 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func testRetryPolicy(idempotentOperations ...string) *DefaultRetryPolicy {
+	p := NewRetryPolicy(idempotentOperations...)
+	p.MinWait = time.Millisecond
+	p.MaxWait = 10 * time.Millisecond
+
+	return p
+}
+
+func TestRetryPolicySafeMethod(t *testing.T) {
+	var calls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"zones":[{"name":"ch-gva-2"}]}`))
+	})
+
+	policy := testRetryPolicy()
+	var attempts []int
+	policy.OnRetry = func(attempt RetryAttempt, _ time.Duration) {
+		if attempt.OperationID != "list-zones" {
+			t.Errorf("unexpected operation ID %q", attempt.OperationID)
+		}
+		attempts = append(attempts, attempt.Attempt)
+	}
+
+	resp, err := client.WithRetryPolicy(policy).ListZones(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Zones) != 1 {
+		t.Errorf("expected 1 zone, got %d", len(resp.Zones))
+	}
+	if calls != 3 || len(attempts) != 2 || attempts[1] != 2 {
+		t.Errorf("expected 3 calls and 2 retries, got %d calls and retries %v", calls, attempts)
+	}
+}
+
+func TestRetryPolicyNonIdempotent(t *testing.T) {
+	var calls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, ClientOptWithRetryPolicy(testRetryPolicy()))
+
+	_, err := client.CreateInstance(context.Background(), CreateInstanceRequest{Name: "test"})
+	if !errors.Is(err, ErrServiceUnavailable) {
+		t.Errorf("expected ErrServiceUnavailable, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single call, got %d", calls)
+	}
+}
+
+func TestRetryPolicyIdempotentOperation(t *testing.T) {
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"2bdb9a6b-5a5d-4de4-9a86-d6e3a0cc1b9c","state":"pending"}`))
+	}, ClientOptWithRetryPolicy(testRetryPolicy("update-instance")))
+
+	op, err := client.UpdateInstance(context.Background(), "2bdb9a6b-5a5d-4de4-9a86-d6e3a0cc1b9c", UpdateInstanceRequest{Name: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.State != OperationStatePending {
+		t.Errorf("unexpected operation state %q", op.State)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[0] == "" {
+		t.Errorf("expected the request body to be sent twice, got %q", bodies)
+	}
+}
+
+func TestRetryPolicyStreamedBody(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("TMPDIR", tmp)
+
+	payload := strings.Repeat("x", 2*maxBodySpoolMemory)
+	var bodies []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}, ClientOptWithRetryPolicy(testRetryPolicy("upload-blob")))
+
+	req, err := http.NewRequest(http.MethodPut, client.serverEndpoint+"/blob", io.NopCloser(strings.NewReader(payload)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.do(context.Background(), "upload-blob", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if len(bodies) != 2 || bodies[0] != payload || bodies[1] != payload {
+		t.Errorf("expected the streamed request body to be sent twice, got %d requests", len(bodies))
+	}
+	if files, _ := filepath.Glob(filepath.Join(tmp, "egoscale-body-*")); len(files) > 0 {
+		t.Errorf("the temporary files must be removed, got %v", files)
+	}
+}
+
+func TestRetryPolicyReauthentication(t *testing.T) {
+	var keys []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(strings.Split(r.Header.Get("Authorization"), ",")[0], "EXO2-HMAC-SHA256 credential=")
+		keys = append(keys, key)
+		if key != "EXOrotated" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	provider := &rotatingProvider{keys: []string{"EXOrevoked", "EXOrotated"}}
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 2
+	var attempts []int
+	policy.OnRetry = func(attempt RetryAttempt, _ time.Duration) {
+		attempts = append(attempts, attempt.Attempt)
+	}
+	client = client.WithCredentials(credentials.NewCredentials(provider)).WithRetryPolicy(policy)
+
+	if _, err := client.ListZones(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+	// The attempt rejected with the revoked key pair does not count toward MaxAttempts.
+	if len(keys) != 3 || len(attempts) != 1 || attempts[0] != 1 {
+		t.Errorf("expected 3 requests and 1 retry, got keys %v and retries %v", keys, attempts)
+	}
+}
+
+func TestRetryPolicyMaxWait(t *testing.T) {
+	policy := testRetryPolicy()
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"3600"}}}
+
+	wait, retry := policy.Retry(context.Background(), RetryAttempt{Method: http.MethodGet, Attempt: 1, Response: resp})
+	if !retry || wait != policy.MaxWait {
+		t.Errorf("expected a retry after MaxWait, got %v, %v", wait, retry)
+	}
+
+	policy.MaxWait = 0
+	if wait, _ := policy.Retry(context.Background(), RetryAttempt{Method: http.MethodGet, Attempt: 1, Response: resp}); wait != time.Hour {
+		t.Errorf("expected Retry-After to be honored without MaxWait, got %v", wait)
+	}
+}
+
+func TestRetryPolicyNil(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {})
+	client.httpClient = defaultHTTPClient
+
+	if hc := client.WithRetryPolicy(nil).httpClient; hc != defaultHTTPClient {
+		t.Error("a nil RetryPolicy must keep the retrying default HTTP client")
+	}
+	if hc := client.WithRetryPolicy(testRetryPolicy()).WithRetryPolicy(nil).httpClient; hc != defaultHTTPClient {
+		t.Error("a nil RetryPolicy must restore the retrying default HTTP client")
+	}
+
+	c, err := NewClient(credentials.NewStaticCredentials("EXOtest", "secret"), ClientOptWithRetryPolicy(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.httpClient != defaultHTTPClient {
+		t.Error("ClientOptWithRetryPolicy(nil) must keep the retrying default HTTP client")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{value: "", wantOK: false},
+		{value: "3", want: 3 * time.Second, wantOK: true},
+		{value: "invalid", wantOK: false},
+		{value: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), want: 0, wantOK: true},
+	}
+
+	for _, test := range tests {
+		resp := &http.Response{Header: http.Header{}}
+		if test.value != "" {
+			resp.Header.Set("Retry-After", test.value)
+		}
+
+		got, ok := retryAfter(resp)
+		if got != test.want || ok != test.wantOK {
+			t.Errorf("retryAfter(%q) = %v, %v, want %v, %v", test.value, got, ok, test.want, test.wantOK)
+		}
+	}
+}
@@ -5069,3 +5069,16 @@ const (
 type InstanceTarget = InstanceRef
 type BlockStorageSnapshotTarget = BlockStorageSnapshotRef
 type BlockStorageVolumeTarget = BlockStorageVolumeRef
+
+// secretProperties lists the schemas properties holding secret values.
+var secretProperties = map[string]struct{}{
+	"admin-password":        {},
+	"basic-auth-password":   {},
+	"bearer-token":          {},
+	"client_secret":         {},
+	"email_sender_password": {},
+	"huggingface-token":     {},
+	"kubeconfig":            {},
+	"password":              {},
+	"secret":                {},
+}
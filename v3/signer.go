@@ -0,0 +1,314 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+// signatureScheme is the authorization scheme of the Exoscale API.
+const signatureScheme = "EXO2-HMAC-SHA256"
+
+// maxSignatureClockSkew is the clock skew tolerated by Verify between the signing and verifying clocks.
+const maxSignatureClockSkew = time.Minute
+
+// Signer signs requests with the EXO2-HMAC-SHA256 scheme of the Exoscale API,
+// and verifies the signature of signed requests.
+type Signer struct {
+	expiry time.Duration
+	now    func() time.Time
+}
+
+// SignerOpt represents a function setting a Signer option.
+type SignerOpt func(*Signer)
+
+// SignerOptWithExpiry returns a SignerOpt overriding the validity duration of signatures (10 minutes by default).
+func SignerOptWithExpiry(expiry time.Duration) SignerOpt {
+	return func(s *Signer) {
+		s.expiry = expiry
+	}
+}
+
+// SignerOptWithClock returns a SignerOpt overriding the clock used to compute and verify signature expirations.
+func SignerOptWithClock(now func() time.Time) SignerOpt {
+	return func(s *Signer) {
+		s.now = now
+	}
+}
+
+// NewSigner returns a new Signer.
+func NewSigner(opts ...SignerOpt) *Signer {
+	s := &Signer{
+		expiry: 10 * time.Minute,
+		now:    time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Sign sets the Authorization header of req with a signature computed from creds.
+// The request body, if any, is hashed while streamed from req.GetBody, and read again when sent:
+// GetBody should return in-memory or otherwise cheaply re-readable copies.
+// Without GetBody, the body is read and replaced to be sent afterwards,
+// large bodies are buffered in a temporary file rather than in memory, removed once the body is closed.
+func (s *Signer) Sign(req *http.Request, creds credentials.Value) error {
+	// Important: this is order-sensitive, we have to have to sort parameters alphabetically to ensure signed
+	// values match the names listed in the "signed-query-args=" signature pragma.
+	signedParams, paramsValues := extractRequestParameters(req)
+	expiration := s.now().UTC().Add(s.expiry).Unix()
+
+	headerParts := []string{signatureScheme + " credential=" + creds.APIKey}
+	if len(signedParams) > 0 {
+		headerParts = append(headerParts, "signed-query-args="+strings.Join(signedParams, ";"))
+	}
+
+	h := hmac.New(sha256.New, []byte(creds.APISecret))
+	if err := hashRequest(h, req, paramsValues, expiration); err != nil {
+		return err
+	}
+
+	headerParts = append(headerParts,
+		"expires="+fmt.Sprint(expiration),
+		"signature="+base64.StdEncoding.EncodeToString(h.Sum(nil)),
+	)
+
+	req.Header.Set("Authorization", strings.Join(headerParts, ","))
+
+	return nil
+}
+
+// Verify verifies the signature of req, looking up the secret of the signing API key with secret.
+// It returns the signing API key, or an error wrapping ErrInvalidSignature or ErrSignatureExpired.
+// Signatures expiring later than the Signer expiry (plus a minute of clock skew) are invalid,
+// as are requests with single-valued query parameters missing from the signed ones.
+// The request body, if any, is hashed like in Sign and can be read afterwards: bodies larger than 1 MiB
+// are buffered in a temporary file, removed once the body is closed or once the request context is done,
+// i.e. when the handler serving req returns.
+func (s *Signer) Verify(req *http.Request, secret func(apiKey string) (string, error)) (string, error) {
+	scheme, params, _ := strings.Cut(req.Header.Get("Authorization"), " ")
+	if scheme != signatureScheme {
+		return "", fmt.Errorf("%w: unsupported authorization scheme %q", ErrInvalidSignature, scheme)
+	}
+
+	fields := make(map[string]string)
+	for _, part := range strings.Split(params, ",") {
+		k, v, _ := strings.Cut(part, "=")
+		fields[k] = v
+	}
+
+	apiKey := fields["credential"]
+	expiration, err := strconv.ParseInt(fields["expires"], 10, 64)
+	if err != nil || apiKey == "" {
+		return "", fmt.Errorf("%w: malformed authorization header", ErrInvalidSignature)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(fields["signature"])
+	if err != nil {
+		return "", fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+
+	now := s.now()
+	if !now.Before(time.Unix(expiration, 0)) {
+		return "", fmt.Errorf("API key %q: %w", apiKey, ErrSignatureExpired)
+	}
+	if time.Unix(expiration, 0).After(now.Add(s.expiry + maxSignatureClockSkew)) {
+		return "", fmt.Errorf("API key %q: %w: expiration exceeds the signature validity", apiKey, ErrInvalidSignature)
+	}
+
+	// Every single-valued query parameter is signed, see extractRequestParameters.
+	signedParams, paramsValues := extractRequestParameters(req)
+	if strings.Join(signedParams, ";") != fields["signed-query-args"] {
+		return "", fmt.Errorf("API key %q: %w: signed query parameters mismatch", apiKey, ErrInvalidSignature)
+	}
+
+	apiSecret, err := secret(apiKey)
+	if err != nil {
+		return "", fmt.Errorf("API key %q: %w", apiKey, err)
+	}
+
+	h := hmac.New(sha256.New, []byte(apiSecret))
+	if err := hashRequest(h, req, paramsValues, expiration); err != nil {
+		return "", err
+	}
+
+	if !hmac.Equal(sig, h.Sum(nil)) {
+		return "", fmt.Errorf("API key %q: %w", apiKey, ErrInvalidSignature)
+	}
+
+	return apiKey, nil
+}
+
+// hashRequest writes the signed parts of req, separated by line returns, into h:
+// the method and URL path, the body, the signed query parameters values, the signed headers (none at the moment)
+// and the expiration date (UNIX timestamp, no line return).
+func hashRequest(h io.Writer, req *http.Request, paramsValues string, expiration int64) error {
+	if _, err := io.WriteString(h, req.Method+" "+req.URL.EscapedPath()+"\n"); err != nil {
+		return err
+	}
+
+	if err := hashRequestBody(h, req); err != nil {
+		return fmt.Errorf("hash request body: %w", err)
+	}
+
+	_, err := io.WriteString(h, "\n"+paramsValues+"\n\n"+fmt.Sprint(expiration))
+
+	return err
+}
+
+// hashRequestBody writes the body of req into h.
+// Bodies which can be rewound with GetBody are hashed from a copy, and read again when sent.
+// Other bodies are read and replaced with a rewindable copy, see spoolRequestBody:
+// a temporary file holding the body is removed once the body is closed (e.g. by the http.Client sending req),
+// or once the request context is done (e.g. when the handler serving req returns).
+func hashRequestBody(h io.Writer, req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+
+		_, err = io.Copy(h, body)
+		return err
+	}
+
+	spool, err := spoolRequestBody(req, h)
+	if err != nil {
+		return err
+	}
+
+	if spool.file != nil {
+		req.Body = &spooledBody{Reader: spool.reader(), spool: spool}
+		context.AfterFunc(req.Context(), spool.discard)
+	}
+
+	return nil
+}
+
+// spoolRequestBody reads the body of req through w into a bodySpool, and replaces it
+// with the spooled one, rewindable with GetBody until the bodySpool is discarded.
+func spoolRequestBody(req *http.Request, w io.Writer) (*bodySpool, error) {
+	spool := &bodySpool{}
+	_, err := io.Copy(io.MultiWriter(w, spool), req.Body)
+	req.Body.Close()
+	if err != nil {
+		spool.discard()
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(spool.reader())
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(spool.reader()), nil
+	}
+
+	return spool, nil
+}
+
+// maxBodySpoolMemory is the size above which bodySpool buffers request bodies in a temporary file.
+const maxBodySpoolMemory = 1 << 20
+
+// bodySpool buffers a request body in memory, or in a temporary file once larger than maxBodySpoolMemory.
+type bodySpool struct {
+	buf  bytes.Buffer
+	file *os.File
+	size int64
+	once sync.Once
+}
+
+func (s *bodySpool) Write(p []byte) (int, error) {
+	if s.file == nil && s.buf.Len()+len(p) <= maxBodySpoolMemory {
+		return s.buf.Write(p)
+	}
+
+	if s.file == nil {
+		f, err := os.CreateTemp("", "egoscale-body-*")
+		if err != nil {
+			return 0, err
+		}
+		s.file = f
+
+		if _, err := s.file.Write(s.buf.Bytes()); err != nil {
+			return 0, err
+		}
+		s.size = int64(s.buf.Len())
+		s.buf = bytes.Buffer{}
+	}
+
+	n, err := s.file.Write(p)
+	s.size += int64(n)
+
+	return n, err
+}
+
+// reader returns a reader of the buffered body from its start.
+func (s *bodySpool) reader() io.Reader {
+	if s.file == nil {
+		return bytes.NewReader(s.buf.Bytes())
+	}
+
+	return io.NewSectionReader(s.file, 0, s.size)
+}
+
+// discard removes the temporary file, if any. It can be called several times.
+func (s *bodySpool) discard() {
+	s.once.Do(func() {
+		if s.file != nil {
+			_ = s.file.Close()
+			_ = os.Remove(s.file.Name())
+		}
+	})
+}
+
+// spooledBody is a request body read from a bodySpool, discarded once closed.
+type spooledBody struct {
+	io.Reader
+	spool *bodySpool
+}
+
+func (b *spooledBody) Close() error {
+	b.spool.discard()
+	return nil
+}
+
+// extractRequestParameters returns the list of request URL parameters names
+// and a strings concatenating the values of the parameters.
+func extractRequestParameters(req *http.Request) ([]string, string) {
+	var (
+		names  []string
+		values string
+	)
+
+	for param, values := range req.URL.Query() {
+		// Keep only parameters that hold exactly 1 value (i.e. no empty or multi-valued parameters)
+		if len(values) == 1 {
+			names = append(names, param)
+		}
+	}
+	sort.Strings(names)
+
+	for _, param := range names {
+		values += req.URL.Query().Get(param)
+	}
+
+	return names, values
+}
@@ -0,0 +1,238 @@
+package v3
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/exoscale/egoscale/v3/credentials"
+)
+
+func TestSignerSign(t *testing.T) {
+	// Expected signatures are computed with an external (verified) implementation,
+	// e.g. https://github.com/exoscale/requests-exoscale-auth
+	var (
+		creds      = credentials.Value{APIKey: "EXOxxxxxxxxxxxxxxxxxxxxxxxx", APISecret: "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"}
+		expiration = time.Date(2077, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	signer := NewSigner(
+		SignerOptWithExpiry(time.Minute),
+		SignerOptWithClock(func() time.Time { return expiration.Add(-time.Minute) }),
+	)
+
+	tests := map[string]string{
+		"https://api.exoscale.com/v2/zone": "EXO2-HMAC-SHA256 credential=" + creds.APIKey +
+			",expires=" + fmt.Sprint(expiration.Unix()) +
+			",signature=Ntbq/p0HVmA3Zg1HHY+Lq1vjFGi7HeMrrgXDS5jRNlY=",
+		"https://api.exoscale.com/v2/zone?k1=v1&k2=v2": "EXO2-HMAC-SHA256 credential=" + creds.APIKey +
+			",signed-query-args=k1;k2" +
+			",expires=" + fmt.Sprint(expiration.Unix()) +
+			",signature=iqOBz13+44L5j0uJclE8hmUhQQcvtCSoPEOXYK6liqY=",
+	}
+
+	for url, want := range tests {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := signer.Sign(req, creds); err != nil {
+			t.Fatal(err)
+		}
+		if got := req.Header.Get("Authorization"); got != want {
+			t.Errorf("%s: got %q, want %q", url, got, want)
+		}
+	}
+}
+
+func TestSignerVerify(t *testing.T) {
+	now := time.Now()
+	signer := NewSigner(SignerOptWithClock(func() time.Time { return now }))
+	secret := func(apiKey string) (string, error) {
+		if apiKey != "EXOtest" {
+			return "", ErrNotFound
+		}
+		return "secret", nil
+	}
+
+	newRequest := func(key, secret string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "https://api.exoscale.com/v2/instance?a=1&b=2", strings.NewReader(`{"name":"test"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := signer.Sign(req, credentials.Value{APIKey: key, APISecret: secret}); err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+
+	req := newRequest("EXOtest", "secret")
+	if key, err := signer.Verify(req, secret); err != nil || key != "EXOtest" {
+		t.Errorf("expected a valid signature, got %q, %v", key, err)
+	}
+	if body, _ := io.ReadAll(req.Body); string(body) != `{"name":"test"}` {
+		t.Errorf("the request body must be restored, got %q", body)
+	}
+
+	req = newRequest("EXOtest", "secret")
+	req.URL.RawQuery = "a=1&b=3"
+	if _, err := signer.Verify(req, secret); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected ErrInvalidSignature for a tampered request, got %v", err)
+	}
+
+	req = newRequest("EXOtest", "secret")
+	req.URL.RawQuery = "a=1&b=2&c=3"
+	if _, err := signer.Verify(req, secret); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected ErrInvalidSignature for an unsigned query parameter, got %v", err)
+	}
+
+	req = newRequest("EXOtest", "secret")
+	req.URL.RawQuery = "a=1&b=2&c=3&c=4"
+	if _, err := signer.Verify(req, secret); err != nil {
+		t.Errorf("multi-valued query parameters are not signed, got %v", err)
+	}
+
+	farSigner := NewSigner(SignerOptWithClock(func() time.Time { return now }), SignerOptWithExpiry(365*24*time.Hour))
+	req = newRequest("EXOtest", "secret")
+	if err := farSigner.Sign(req, credentials.Value{APIKey: "EXOtest", APISecret: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.Verify(req, secret); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected ErrInvalidSignature for an expiration beyond the signer expiry, got %v", err)
+	}
+
+	skewedSigner := NewSigner(SignerOptWithClock(func() time.Time { return now.Add(30 * time.Second) }))
+	req = newRequest("EXOtest", "secret")
+	if err := skewedSigner.Sign(req, credentials.Value{APIKey: "EXOtest", APISecret: "secret"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.Verify(req, secret); err != nil {
+		t.Errorf("expected a valid signature with a clock skew, got %v", err)
+	}
+
+	if _, err := signer.Verify(newRequest("EXOtest", "wrong"), secret); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("expected ErrInvalidSignature for a wrong secret, got %v", err)
+	}
+
+	if _, err := signer.Verify(newRequest("EXOunknown", "secret"), secret); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the secret lookup error, got %v", err)
+	}
+
+	req = newRequest("EXOtest", "secret")
+	now = now.Add(time.Hour)
+	if _, err := signer.Verify(req, secret); !errors.Is(err, ErrSignatureExpired) {
+		t.Errorf("expected ErrSignatureExpired, got %v", err)
+	}
+}
+
+func TestSignerStreamedBody(t *testing.T) {
+	signer := NewSigner(SignerOptWithClock(func() time.Time { return time.Unix(0, 0) }))
+	creds := credentials.Value{APIKey: "EXOtest", APISecret: "secret"}
+
+	for _, size := range []int{1 << 10, 2 * maxBodySpoolMemory} {
+		payload := strings.Repeat("x", size)
+
+		rewindable, err := http.NewRequest(http.MethodPost, "https://api.exoscale.com/v2/template", strings.NewReader(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := signer.Sign(rewindable, creds); err != nil {
+			t.Fatal(err)
+		}
+
+		// Bodies without GetBody are buffered while hashed.
+		streamed, err := http.NewRequest(http.MethodPost, "https://api.exoscale.com/v2/template", io.NopCloser(strings.NewReader(payload)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := signer.Sign(streamed, creds); err != nil {
+			t.Fatal(err)
+		}
+
+		if rewindable.Header.Get("Authorization") != streamed.Header.Get("Authorization") {
+			t.Errorf("%d bytes: signatures differ", size)
+		}
+
+		body, err := io.ReadAll(streamed.Body)
+		if err != nil || string(body) != payload {
+			t.Errorf("%d bytes: the request body must be restored, got %d bytes: %v", size, len(body), err)
+		}
+		if err := streamed.Body.Close(); err != nil {
+			t.Error(err)
+		}
+
+		if b, ok := streamed.Body.(*spooledBody); ok {
+			if size <= maxBodySpoolMemory {
+				t.Errorf("%d bytes: unexpected temporary file", size)
+			}
+			if _, err := os.Stat(b.spool.file.Name()); !os.IsNotExist(err) {
+				t.Errorf("%d bytes: the temporary file must be removed, got %v", size, err)
+			}
+		} else if size > maxBodySpoolMemory {
+			t.Errorf("%d bytes: expected a temporary file", size)
+		}
+	}
+}
+
+func TestSignerVerifyTemporaryFileCleanup(t *testing.T) {
+	signer := NewSigner()
+	creds := credentials.Value{APIKey: "EXOtest", APISecret: "secret"}
+	payload := strings.Repeat("x", 2*maxBodySpoolMemory)
+
+	var filename string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := signer.Verify(r, func(string) (string, error) { return creds.APISecret, nil }); err != nil {
+			t.Error(err)
+			return
+		}
+
+		b, ok := r.Body.(*spooledBody)
+		if !ok {
+			t.Errorf("expected a temporary file, got %T", r.Body)
+			return
+		}
+		filename = b.spool.file.Name()
+
+		// The handler reads the body without closing it.
+		if body, err := io.ReadAll(r.Body); err != nil || string(body) != payload {
+			t.Errorf("the request body must be restored, got %d bytes: %v", len(body), err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/v2/template", strings.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := signer.Sign(req, creds); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if filename == "" {
+		t.FailNow()
+	}
+
+	// The request context is canceled once the handler returns, asynchronously to the response.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := os.Stat(filename)
+		if os.IsNotExist(err) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the temporary file must be removed once the handler returns, got %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,192 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the OpenTelemetry instrumentation scope name of the client.
+const instrumentationName = "github.com/exoscale/egoscale/v3"
+
+// OpenTelemetry attribute keys set on API operations spans and metrics.
+const (
+	AttributeOperation      = attribute.Key("exoscale.operation")
+	AttributeZone           = attribute.Key("exoscale.zone")
+	AttributeRetryCount     = attribute.Key("exoscale.retry_count")
+	AttributeAsyncOperation = attribute.Key("exoscale.async_operation.id")
+	attributeHTTPMethod     = attribute.Key("http.request.method")
+	attributeHTTPStatusCode = attribute.Key("http.response.status_code")
+)
+
+// clientMetrics holds the OpenTelemetry instruments of a Client.
+type clientMetrics struct {
+	duration metric.Float64Histogram
+	requests metric.Int64Counter
+	errors   metric.Int64Counter
+}
+
+func newClientMetrics(mp metric.MeterProvider) (*clientMetrics, error) {
+	meter := mp.Meter(instrumentationName, metric.WithInstrumentationVersion(Version))
+
+	duration, err := meter.Float64Histogram(
+		"exoscale.client.operation.duration",
+		metric.WithDescription("Duration of Exoscale API operations, retries included."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("duration histogram: %w", err)
+	}
+
+	requests, err := meter.Int64Counter(
+		"exoscale.client.operations",
+		metric.WithDescription("Number of Exoscale API operations."),
+		metric.WithUnit("{operation}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("operations counter: %w", err)
+	}
+
+	errors, err := meter.Int64Counter(
+		"exoscale.client.operation.errors",
+		metric.WithDescription("Number of failed Exoscale API operations."),
+		metric.WithUnit("{operation}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("errors counter: %w", err)
+	}
+
+	return &clientMetrics{
+		duration: duration,
+		requests: requests,
+		errors:   errors,
+	}, nil
+}
+
+// startSpan starts the span of an API operation, it returns a no-op span if tracing is disabled.
+func (c Client) startSpan(ctx context.Context, operationID string, req *http.Request) (context.Context, trace.Span) {
+	if c.tracer == nil {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	return c.tracer.Start(ctx, operationID,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			AttributeOperation.String(operationID),
+			AttributeZone.String(zoneFromEndpoint(c.serverEndpoint)),
+			attributeHTTPMethod.String(req.Method),
+		),
+	)
+}
+
+// endSpan records the outcome of an API operation on its span and metrics, then ends the span.
+func (c Client) endSpan(
+	ctx context.Context,
+	span trace.Span,
+	operationID string,
+	req *http.Request,
+	start time.Time,
+	resp *http.Response,
+	attempts int,
+	err error,
+) {
+	failed := err != nil
+
+	attrs := []attribute.KeyValue{
+		AttributeOperation.String(operationID),
+		AttributeZone.String(zoneFromEndpoint(c.serverEndpoint)),
+		attributeHTTPMethod.String(req.Method),
+	}
+	if resp != nil {
+		attrs = append(attrs, attributeHTTPStatusCode.Int(resp.StatusCode))
+		failed = failed || resp.StatusCode >= 400
+	}
+
+	if c.tracer != nil {
+		span.SetAttributes(attrs...)
+		span.SetAttributes(AttributeRetryCount.Int(max(attempts-1, 0)))
+
+		switch {
+		case err != nil:
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		case failed:
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		case req.Method != http.MethodGet || operationID == "get-operation":
+			// Only these operations may return an Operation.
+			if id := asyncOperationID(resp); id != "" {
+				span.SetAttributes(AttributeAsyncOperation.String(id))
+			}
+		}
+		span.End()
+	}
+
+	if c.metrics != nil {
+		opt := metric.WithAttributes(attrs...)
+		c.metrics.duration.Record(ctx, time.Since(start).Seconds(), opt)
+		c.metrics.requests.Add(ctx, 1, opt)
+		if failed {
+			c.metrics.errors.Add(ctx, 1, opt)
+		}
+	}
+}
+
+// maxOperationSize bounds the read of response bodies looking for an async Operation.
+const maxOperationSize = 4 << 10
+
+// asyncOperationID returns the ID of the async Operation returned in a response body, if any.
+// At most maxOperationSize bytes are read, the response body is restored to be read again by the caller.
+func asyncOperationID(resp *http.Response) string {
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return ""
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxOperationSize+1))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+	if err != nil || len(data) > maxOperationSize {
+		return ""
+	}
+
+	var op struct {
+		ID        UUID                `json:"id"`
+		State     OperationState      `json:"state"`
+		Reference *OperationReference `json:"reference"`
+	}
+	if err := json.Unmarshal(data, &op); err != nil || op.Reference == nil {
+		return ""
+	}
+
+	switch op.State {
+	case OperationStatePending, OperationStateSuccess, OperationStateFailure, OperationStateTimeout:
+		return op.ID.String()
+	}
+
+	return ""
+}
+
+// zoneFromEndpoint returns the zone name of a zone API endpoint,
+// e.g. "ch-gva-2" for "https://api-ch-gva-2.exoscale.com/v2".
+func zoneFromEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+
+	label, _, _ := strings.Cut(u.Hostname(), ".")
+
+	return strings.TrimPrefix(label, "api-")
+}
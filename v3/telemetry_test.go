@@ -0,0 +1,152 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+type recordedSpan struct {
+	noop.Span
+	name   string
+	attrs  map[attribute.Key]attribute.Value
+	status codes.Code
+	ended  bool
+}
+
+func (s *recordedSpan) SetAttributes(kv ...attribute.KeyValue) {
+	for _, a := range kv {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordedSpan) SetStatus(code codes.Code, _ string) { s.status = code }
+func (s *recordedSpan) End(...trace.SpanEndOption)          { s.ended = true }
+
+type recordingTracer struct {
+	noop.Tracer
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	span := &recordedSpan{name: name, attrs: map[attribute.Key]attribute.Value{}}
+	cfg := trace.NewSpanStartConfig(opts...)
+	span.SetAttributes(cfg.Attributes()...)
+	t.spans = append(t.spans, span)
+
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type recordingTracerProvider struct {
+	noop.TracerProvider
+	tracer *recordingTracer
+}
+
+func (p recordingTracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer { return p.tracer }
+
+func TestTelemetrySpans(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e","state":"pending","reference":{"id":"0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b"}}`))
+	})
+
+	tracer := &recordingTracer{}
+	if err := ClientOptWithTracerProvider(recordingTracerProvider{tracer: tracer})(client); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	op, err := client.StartInstance(ctx, "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b", StartInstanceRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.ID != "9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e" {
+		t.Errorf("the response body must be restored, got operation %+v", op)
+	}
+	_, _ = client.DeleteInstance(ctx, "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b")
+
+	if len(tracer.spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(tracer.spans))
+	}
+
+	start := tracer.spans[0]
+	if start.name != "start-instance" || !start.ended || start.status == codes.Error {
+		t.Errorf("unexpected span %+v", start)
+	}
+	for key, want := range map[attribute.Key]string{
+		AttributeOperation:      "start-instance",
+		AttributeAsyncOperation: "9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e",
+		attributeHTTPMethod:     http.MethodPut,
+	} {
+		if got := start.attrs[key].AsString(); got != want {
+			t.Errorf("attribute %s = %q, want %q", key, got, want)
+		}
+	}
+	if got := start.attrs[attributeHTTPStatusCode].AsInt64(); got != http.StatusOK {
+		t.Errorf("unexpected status code attribute %d", got)
+	}
+
+	if del := tracer.spans[1]; del.status != codes.Error || del.attrs[attributeHTTPStatusCode].AsInt64() != http.StatusNotFound {
+		t.Errorf("unexpected span %+v", del)
+	}
+}
+
+func TestZoneFromEndpoint(t *testing.T) {
+	tests := map[string]string{
+		string(CHGva2):                           "ch-gva-2",
+		string(HrZag1):                           "hr-zag-1",
+		"https://ppapi-de-fra-1.exoscale.com/v2": "ppapi-de-fra-1",
+		"://invalid":                             "",
+	}
+
+	for endpoint, want := range tests {
+		if got := zoneFromEndpoint(endpoint); got != want {
+			t.Errorf("zoneFromEndpoint(%q) = %q, want %q", endpoint, got, want)
+		}
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestTelemetrySpanContext(t *testing.T) {
+	large := `{"id":"9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e","state":"pending","message":"` + strings.Repeat("x", maxOperationSize) + `"}`
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(large))
+	})
+
+	tracer := &recordingTracer{}
+	if err := ClientOptWithTracerProvider(recordingTracerProvider{tracer: tracer})(client); err != nil {
+		t.Fatal(err)
+	}
+
+	var spans []trace.Span
+	transport := client.httpClient.Transport
+	client = client.WithHTTPClient(&http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		spans = append(spans, trace.SpanFromContext(req.Context()))
+		return transport.RoundTrip(req)
+	})})
+
+	op, err := client.StartInstance(context.Background(), "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b", StartInstanceRequest{})
+	if err != nil || len(op.Message) != maxOperationSize {
+		t.Fatalf("large response bodies must be restored, got %v", err)
+	}
+	if len(spans) != 1 || len(tracer.spans) != 1 || spans[0] != tracer.spans[0] {
+		t.Errorf("the transport must receive the operation span in the request context")
+	}
+	if _, ok := tracer.spans[0].attrs[AttributeAsyncOperation]; ok {
+		t.Errorf("operations larger than maxOperationSize must not be decoded")
+	}
+}
@@ -0,0 +1,189 @@
+package v3
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidationOpt represents a function setting a client-side validation option.
+type ValidationOpt func(*validation)
+
+// ValidationOptWithResponses returns a ValidationOpt validating the API responses as well as the requests.
+func ValidationOptWithResponses() ValidationOpt {
+	return func(v *validation) {
+		v.responses = true
+	}
+}
+
+// ValidationError is returned by operations of a Client validating requests (see ClientOptWithRequestValidation)
+// when a request or response body is invalid.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+// FieldError is an invalid field of a ValidationError.
+type FieldError struct {
+	// Path is the JSON path of the field in the body (e.g. "disk-size" or "instance-type.cpus").
+	Path string
+	// Rule is the validation rule the field does not satisfy (e.g. "gte=10").
+	Rule string
+	// Value is the value of the field.
+	Value any
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, f := range e.Errors {
+		messages = append(messages, fmt.Sprintf("'%s' = '%v' does not validate '%s'", f.Path, f.Value, f.Rule))
+	}
+
+	return "validation error: " + strings.Join(messages, ", ")
+}
+
+// NewValidationError returns the ValidationError of the struct v from the errors of its validation,
+// reporting the invalid fields by JSON path rather than by Go field name.
+func NewValidationError(v any, validationErrs validator.ValidationErrors) *ValidationError {
+	errs := make([]FieldError, 0, len(validationErrs))
+	for _, e := range validationErrs {
+		rule := e.ActualTag()
+		if e.Param() != "" {
+			rule += "=" + e.Param()
+		}
+
+		errs = append(errs, FieldError{Path: jsonPath(reflect.TypeOf(v), e.StructNamespace()), Rule: rule, Value: e.Value()})
+	}
+
+	return &ValidationError{Errors: errs}
+}
+
+// indexSuffix matches the slice or map index suffix of a namespace segment, e.g. "[0]".
+var indexSuffix = regexp.MustCompile(`(\[[^]]*\])+$`)
+
+// jsonPath converts the Go struct namespace of a field of t (e.g. "CreateInstanceRequest.InstanceType.Cpus")
+// to its JSON path (e.g. "instance-type.cpus").
+func jsonPath(t reflect.Type, namespace string) string {
+	segments := strings.Split(namespace, ".")[1:]
+	path := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		index := indexSuffix.FindString(segment)
+		name := strings.TrimSuffix(segment, index)
+
+		for t != nil && t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t == nil || t.Kind() != reflect.Struct {
+			path = append(path, segment)
+			t = nil
+			continue
+		}
+
+		field, ok := t.FieldByName(name)
+		if !ok {
+			path = append(path, segment)
+			t = nil
+			continue
+		}
+
+		if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" && tag != "-" {
+			name = tag
+		}
+		path = append(path, name+index)
+
+		t = field.Type
+		for range strings.Count(index, "[") {
+			for t.Kind() == reflect.Pointer {
+				t = t.Elem()
+			}
+			switch t.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				t = t.Elem()
+			}
+		}
+	}
+
+	return strings.Join(path, ".")
+}
+
+type validation struct {
+	responses bool
+}
+
+func newValidation(opts ...ValidationOpt) *validation {
+	v := &validation{}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// validateRequest validates the request body when request validation is enabled.
+func (c Client) validateRequest(body any) error {
+	if c.validation == nil {
+		return nil
+	}
+
+	return c.validateBody(body, "")
+}
+
+// validateResponse validates the response body when response validation is enabled.
+func (c Client) validateResponse(body any) error {
+	if c.validation == nil || !c.validation.responses {
+		return nil
+	}
+
+	return c.validateBody(body, "")
+}
+
+// validateBody validates a struct, or the structs of a slice, with the Client validator,
+// reporting the invalid fields under prefix.
+func (c Client) validateBody(body any, prefix string) error {
+	value := reflect.ValueOf(body)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+	case reflect.Slice, reflect.Array:
+		var errs []FieldError
+		for i := range value.Len() {
+			err := c.validateBody(value.Index(i).Interface(), fmt.Sprintf("%s[%d]", prefix, i))
+			var validationErr *ValidationError
+			if errors.As(err, &validationErr) {
+				errs = append(errs, validationErr.Errors...)
+			} else if err != nil {
+				return err
+			}
+		}
+		if len(errs) > 0 {
+			return &ValidationError{Errors: errs}
+		}
+		return nil
+	default:
+		return nil
+	}
+
+	err := c.validate.Struct(value.Interface())
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return err
+	}
+
+	validationErr := NewValidationError(value.Interface(), validationErrs)
+	if prefix != "" {
+		for i := range validationErr.Errors {
+			validationErr.Errors[i].Path = prefix + "." + validationErr.Errors[i].Path
+		}
+	}
+
+	return validationErr
+}
@@ -0,0 +1,104 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestRequestValidation(t *testing.T) {
+	var calls int
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/instance":
+			_, _ = w.Write([]byte(`{"id":"0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b","state":"pending"}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":"0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b","ttl":-1}`))
+		}
+	}
+	client := newTestClient(t, handler, ClientOptWithRequestValidation())
+	ctx := context.Background()
+
+	_, err := client.CreateInstance(ctx, CreateInstanceRequest{
+		DiskSize:     5,
+		InstanceType: &InstanceType{Cpus: -1},
+		Template:     &Template{ID: "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b"},
+	})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected a ValidationError, got %v", err)
+	}
+	if len(validationErr.Errors) != 2 ||
+		validationErr.Errors[0] != (FieldError{Path: "disk-size", Rule: "gte=10", Value: int64(5)}) ||
+		validationErr.Errors[1].Path != "instance-type.cpus" {
+		t.Errorf("unexpected errors %+v", validationErr.Errors)
+	}
+	if calls != 0 {
+		t.Errorf("invalid requests must not be sent, got %d calls", calls)
+	}
+
+	if _, err := client.CreateInstance(ctx, CreateInstanceRequest{
+		DiskSize:     10,
+		InstanceType: &InstanceType{ID: "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b"},
+		Template:     &Template{ID: "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// Responses are validated only with ValidationOptWithResponses.
+	if _, err := client.GetDNSDomainRecord(ctx, "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b", "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b"); err != nil {
+		t.Fatal(err)
+	}
+
+	client = newTestClient(t, handler, ClientOptWithRequestValidation(ValidationOptWithResponses()))
+	_, err = client.GetDNSDomainRecord(ctx, "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b", "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b")
+	if !errors.As(err, &validationErr) || len(validationErr.Errors) != 1 || validationErr.Errors[0].Path != "ttl" {
+		t.Errorf("expected an invalid ttl, got %v", err)
+	}
+}
+
+func TestRequestValidationCustomValidator(t *testing.T) {
+	validate := validator.New()
+	validate.RegisterStructValidation(func(sl validator.StructLevel) {
+		if sl.Current().Interface().(RegisterSSHKeyRequest).Name == "reserved" {
+			sl.ReportError("reserved", "Name", "Name", "notreserved", "")
+		}
+	}, RegisterSSHKeyRequest{})
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("invalid requests must not be sent, got %s %s", r.Method, r.URL.Path)
+	}, ClientOptWithRequestValidation(), ClientOptWithValidator(validate))
+
+	_, err := client.RegisterSSHKey(context.Background(), RegisterSSHKeyRequest{Name: "reserved", PublicKey: "ssh-ed25519 AAAA"})
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Errors) != 1 ||
+		validationErr.Errors[0] != (FieldError{Path: "name", Rule: "notreserved", Value: "reserved"}) {
+		t.Errorf("expected the custom validator to be used, got %v", err)
+	}
+}
+
+func TestJSONPath(t *testing.T) {
+	type item struct {
+		ID string `json:"id"`
+	}
+	type request struct {
+		Items []*item          `json:"items"`
+		Tags  map[string]*item `json:"tags"`
+		Plain string
+	}
+
+	for namespace, want := range map[string]string{
+		"request.Items[1].ID":  "items[1].id",
+		"request.Tags[web].ID": "tags[web].id",
+		"request.Plain":        "Plain",
+	} {
+		if got := jsonPath(reflect.TypeOf(request{}), namespace); got != want {
+			t.Errorf("jsonPath(%q) = %q, want %q", namespace, got, want)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// waitConcurrency is the maximum number of operations polled concurrently by WaitAll and WaitAny.
+const waitConcurrency = 8
+
+// WaitResult is the outcome of an async operation waited by WaitAll.
+type WaitResult struct {
+	// Operation is the operation in its final state, nil on error.
+	Operation *Operation
+	// Err is the polling error, or an OperationError if the operation final state is not expected.
+	Err error
+}
+
+// WaitAll is a helper that waits for all the given async operations to reach a success state.
+// It returns the results in the order of the given operations,
+// along with an error joining the errors of the operations which did not succeed.
+func (c Client) WaitAll(ctx context.Context, ops ...*Operation) ([]WaitResult, error) {
+	return c.WaitAllWithOpts(ctx, ops)
+}
+
+// WaitAllWithOpts is a helper that waits for all the given async operations to reach a final state,
+// like WaitAll with additional options.
+// Operations are polled by a single scheduler going through the Client RateLimiter, the wait timeout
+// applies to the whole batch. Expected final states default to success, see WaitOptWithStates.
+func (c Client) WaitAllWithOpts(ctx context.Context, ops []*Operation, opts ...WaitOpt) ([]WaitResult, error) {
+	results, _ := c.waitMany(ctx, ops, false, opts...)
+
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+		}
+	}
+
+	return results, errors.Join(errs...)
+}
+
+// WaitAny is a helper that waits for the first of the given async operations to reach a final state.
+// It returns the index of this operation in ops and the operation,
+// or an OperationError if it did not succeed.
+// If none of the operations could be polled, it returns -1 along with an error joining the polling errors.
+func (c Client) WaitAny(ctx context.Context, ops ...*Operation) (int, *Operation, error) {
+	return c.WaitAnyWithOpts(ctx, ops)
+}
+
+// WaitAnyWithOpts is a helper that waits for the first of the given async operations to reach a final state,
+// like WaitAny with additional options.
+func (c Client) WaitAnyWithOpts(ctx context.Context, ops []*Operation, opts ...WaitOpt) (int, *Operation, error) {
+	results, first := c.waitMany(ctx, ops, true, opts...)
+	if first >= 0 {
+		return first, results[first].Operation, results[first].Err
+	}
+
+	var errs []error
+	for _, r := range results {
+		errs = append(errs, r.Err)
+	}
+
+	return -1, nil, errors.Join(errs...)
+}
+
+// waitingOperation is an operation scheduled for polling.
+type waitingOperation struct {
+	index            int
+	next             time.Time
+	subsequentErrors int
+}
+
+// waitMany polls the given operations until they all reach a final state,
+// or until the first one does if untilAny is true.
+// It returns the results along with the index of the first operation reaching a final state, -1 if none.
+func (c Client) waitMany(ctx context.Context, ops []*Operation, untilAny bool, opts ...WaitOpt) ([]WaitResult, int) {
+	cfg := c.newWaitConfig(append([]WaitOpt{WaitOptWithStates(OperationStateSuccess)}, opts...)...)
+
+	results := make([]WaitResult, len(ops))
+	first := -1
+	done := func(i int, op *Operation) {
+		o, err := checkOperationState(op, cfg.states...)
+		results[i] = WaitResult{Operation: o, Err: err}
+		if first < 0 {
+			first = i
+		}
+	}
+
+	startTime := time.Now()
+	var queue []*waitingOperation
+	for i, op := range ops {
+		switch {
+		case op == nil:
+			results[i] = WaitResult{Err: fmt.Errorf("operation is nil")}
+		case op.State != OperationStatePending:
+			done(i, op)
+		default:
+			queue = append(queue, &waitingOperation{index: i, next: startTime.Add(cfg.interval(0))})
+		}
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for len(queue) > 0 && (!untilAny || first < 0) {
+		next := slices.MinFunc(queue, func(a, b *waitingOperation) int { return a.next.Compare(b.next) }).next
+		timer.Reset(time.Until(next))
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			for _, w := range queue {
+				results[w.index] = WaitResult{Err: fmt.Errorf("operation: %q: %w", ops[w.index].ID, ctx.Err())}
+			}
+			return results, first
+		}
+
+		runTime := time.Since(startTime)
+		if cfg.timeout != 0 && runTime > cfg.timeout {
+			for _, w := range queue {
+				results[w.index] = WaitResult{Err: fmt.Errorf("operation: %q: %w", ops[w.index].ID, ErrWaitTimeout)}
+			}
+			return results, first
+		}
+
+		now := time.Now()
+		due := slices.DeleteFunc(slices.Clone(queue), func(w *waitingOperation) bool { return w.next.After(now) })
+		polled := c.pollOperations(ctx, ops, due)
+
+		for i, w := range due {
+			o, err := polled[i].Operation, polled[i].Err
+			if err != nil {
+				w.subsequentErrors++
+				if w.subsequentErrors >= cfg.maxErrors {
+					results[w.index] = WaitResult{Err: fmt.Errorf("operation: %q: %w", ops[w.index].ID, err)}
+					queue = slices.DeleteFunc(queue, func(q *waitingOperation) bool { return q == w })
+					continue
+				}
+
+				// Back off exponentially the polling of this operation when rate limited by the API.
+				interval := cfg.interval(runTime)
+				if errors.Is(err, ErrTooManyRequests) {
+					interval <<= w.subsequentErrors
+				}
+				w.next = now.Add(interval)
+				continue
+			}
+			w.subsequentErrors = 0
+
+			if cfg.onPoll != nil {
+				cfg.onPoll(o, time.Since(startTime))
+			}
+
+			if o.State == OperationStatePending {
+				w.next = now.Add(cfg.interval(runTime))
+				continue
+			}
+
+			done(w.index, o)
+			queue = slices.DeleteFunc(queue, func(q *waitingOperation) bool { return q == w })
+		}
+	}
+
+	return results, first
+}
+
+// pollOperations fetches concurrently the state of the given waiting operations.
+func (c Client) pollOperations(ctx context.Context, ops []*Operation, waiting []*waitingOperation) []WaitResult {
+	polled := make([]WaitResult, len(waiting))
+	sem := make(chan struct{}, waitConcurrency)
+
+	var wg sync.WaitGroup
+	for i, w := range waiting {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			o, err := c.GetOperation(ctx, ops[w.index].ID)
+			polled[i] = WaitResult{Operation: o, Err: err}
+		}()
+	}
+	wg.Wait()
+
+	return polled
+}
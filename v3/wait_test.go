@@ -0,0 +1,99 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWaitAll(t *testing.T) {
+	const (
+		succeeding = "9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e"
+		failing    = "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b"
+		erroring   = "5c2b7e1d-3a4f-4b6c-9d8e-7f6a5b4c3d2e"
+	)
+
+	var mu sync.Mutex
+	polls := map[string]int{}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		id := path.Base(r.URL.Path)
+
+		mu.Lock()
+		polls[id]++
+		n := polls[id]
+		mu.Unlock()
+
+		state := OperationStatePending
+		switch {
+		case id == erroring:
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		case id == succeeding && n == 3:
+			state = OperationStateSuccess
+		case id == failing && n == 2:
+			state = OperationStateFailure
+		}
+		_, _ = w.Write([]byte(`{"id":"` + id + `","state":"` + string(state) + `"}`))
+	})
+
+	ops := []*Operation{
+		{ID: succeeding, State: OperationStatePending},
+		{ID: failing, State: OperationStatePending},
+		{ID: erroring, State: OperationStatePending},
+		nil,
+	}
+	backoff := WaitOptWithBackoff(func(time.Duration) time.Duration { return time.Millisecond })
+
+	results, err := client.WaitAllWithOpts(context.Background(), ops, backoff, WaitOptWithMaxErrors(2))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if results[0].Err != nil || results[0].Operation.State != OperationStateSuccess {
+		t.Errorf("unexpected result %+v", results[0])
+	}
+	var opErr *OperationError
+	if !errors.As(results[1].Err, &opErr) || opErr.Operation.ID != failing {
+		t.Errorf("expected an OperationError, got %v", results[1].Err)
+	}
+	if !errors.Is(results[2].Err, ErrInternalServerError) || polls[erroring] != 2 {
+		t.Errorf("expected a polling error after 2 attempts, got %v", results[2].Err)
+	}
+	if results[3].Err == nil {
+		t.Error("expected an error for a nil operation")
+	}
+	if !errors.Is(err, ErrInternalServerError) || !errors.As(err, &opErr) {
+		t.Errorf("the returned error must join the operations errors, got %v", err)
+	}
+
+	clear(polls)
+	i, op, err := client.WaitAnyWithOpts(context.Background(), ops[:2], backoff)
+	if i != 1 || op != nil || !errors.As(err, &opErr) {
+		t.Errorf("unexpected WaitAny result %d %+v %v", i, op, err)
+	}
+}
+
+func TestWaitAllStates(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("final operations must not be polled, got %s %s", r.Method, r.URL.Path)
+	})
+
+	ops := []*Operation{
+		{ID: "9a1c9b1a-8b6e-4a8b-9b1f-4a0e6a2c3d4e", State: OperationStateSuccess},
+		{ID: "0f8e4c2a-1b3d-4e5f-8a9b-0c1d2e3f4a5b", State: OperationStateFailure},
+	}
+
+	results, _ := client.WaitAllWithOpts(context.Background(), ops, WaitOptWithStates(OperationStateFailure))
+	var opErr *OperationError
+	if !errors.As(results[0].Err, &opErr) || results[1].Err != nil {
+		t.Errorf("the expected states must replace the default ones, got %+v", results)
+	}
+
+	if _, err := client.WaitAllWithOpts(context.Background(), ops, WaitOptWithStates()); err != nil {
+		t.Errorf("no expected state must accept any final state, got %v", err)
+	}
+}
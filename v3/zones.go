@@ -0,0 +1,115 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ZoneRegistry resolves zone names to API endpoints.
+// It is seeded with the zones known when this library was released, and refreshed with ListZones
+// so zones opened since then are supported.
+// A ZoneRegistry is safe for concurrent use.
+type ZoneRegistry struct {
+	mu          sync.RWMutex
+	endpoints   map[ZoneName]Endpoint
+	refreshedAt time.Time
+	ttl         time.Duration
+}
+
+// ZoneRegistryOpt represents a function setting a ZoneRegistry option.
+type ZoneRegistryOpt func(*ZoneRegistry)
+
+// ZoneRegistryOptWithTTL returns a ZoneRegistryOpt setting the duration after which
+// the zones returned by ListZones are refreshed (1 hour by default).
+func ZoneRegistryOptWithTTL(ttl time.Duration) ZoneRegistryOpt {
+	return func(r *ZoneRegistry) {
+		r.ttl = ttl
+	}
+}
+
+// NewZoneRegistry returns a ZoneRegistry seeded with the built-in zones.
+func NewZoneRegistry(opts ...ZoneRegistryOpt) *ZoneRegistry {
+	r := &ZoneRegistry{
+		endpoints: maps.Clone(defaultZoneEndpoints),
+		ttl:       time.Hour,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Zones returns the zones known by the registry, sorted by name.
+func (r *ZoneRegistry) Zones() []ZoneName {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Sorted(maps.Keys(r.endpoints))
+}
+
+// Refresh replaces the zones known by the registry with the ones returned by ListZones.
+func (r *ZoneRegistry) Refresh(ctx context.Context, client *Client) error {
+	resp, err := client.ListZones(ctx)
+	if err != nil {
+		return fmt.Errorf("refresh zones: list zones: %w", err)
+	}
+
+	endpoints := make(map[ZoneName]Endpoint, len(resp.Zones))
+	for _, zone := range resp.Zones {
+		if zone.Name != "" && zone.APIEndpoint != "" {
+			endpoints[zone.Name] = zone.APIEndpoint
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.endpoints = endpoints
+	r.refreshedAt = time.Now()
+
+	return nil
+}
+
+// Endpoint returns the API Endpoint of a zone.
+// The registry is refreshed with ListZones if the zone is unknown or the registry is stale,
+// in which case the stale Endpoint is returned if the refresh fails.
+func (r *ZoneRegistry) Endpoint(ctx context.Context, client *Client, zone ZoneName) (Endpoint, error) {
+	r.mu.RLock()
+	endpoint, ok := r.endpoints[zone]
+	stale := !r.refreshedAt.IsZero() && time.Since(r.refreshedAt) > r.ttl
+	r.mu.RUnlock()
+
+	if ok && !stale {
+		return endpoint, nil
+	}
+
+	if err := r.Refresh(ctx, client); err != nil {
+		if ok {
+			return endpoint, nil
+		}
+		return "", err
+	}
+
+	endpoint, ok = r.lookup(zone)
+	if !ok {
+		return "", fmt.Errorf("zone %q: %w", zone, ErrNotFound)
+	}
+
+	return endpoint, nil
+}
+
+// lookup returns the API Endpoint of a zone known by the registry.
+func (r *ZoneRegistry) lookup(zone ZoneName) (Endpoint, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	endpoint, ok := r.endpoints[zone]
+
+	return endpoint, ok
+}
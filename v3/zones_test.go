@@ -0,0 +1,60 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestZoneRegistry(t *testing.T) {
+	var listZones int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		listZones++
+		_, _ = w.Write([]byte(`{"zones":[` +
+			`{"name":"ch-gva-2","api-endpoint":"https://api-ch-gva-2.exoscale.com/v2"},` +
+			`{"name":"xx-new-1","api-endpoint":"https://api-xx-new-1.exoscale.com/v2"}]}`))
+	})
+
+	ctx := context.Background()
+
+	if _, err := client.WithZone(ctx, ZoneNameDEFra1); err != nil || listZones != 0 {
+		t.Errorf("built-in zones must be resolved without network requests, got %v after %d requests", err, listZones)
+	}
+
+	zoned, err := client.WithZone(ctx, "xx-new-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zoned.serverEndpoint != "https://api-xx-new-1.exoscale.com/v2" || listZones != 1 {
+		t.Errorf("unexpected endpoint %q after %d requests", zoned.serverEndpoint, listZones)
+	}
+
+	if _, err := client.WithZone(ctx, "xx-new-1"); err != nil || listZones != 1 {
+		t.Errorf("the zone must be cached, got %v after %d requests", err, listZones)
+	}
+	// Refreshed zones replace the built-in ones.
+	if _, err := client.WithZone(ctx, ZoneNameDEFra1); !errors.Is(err, ErrNotFound) || listZones != 2 {
+		t.Errorf("expected ErrNotFound after a refresh, got %v after %d requests", err, listZones)
+	}
+
+	stale := NewZoneRegistry(ZoneRegistryOptWithTTL(time.Nanosecond))
+	if err := stale.Refresh(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WithZoneRegistry(stale).GetZoneAPIEndpoint(ctx, ZoneNameCHGva2); err != nil || listZones != 4 {
+		t.Errorf("a stale registry must be refreshed, got %v after %d requests", err, listZones)
+	}
+}
+
+func TestClientOptWithZone(t *testing.T) {
+	client := newTestClient(t, nil, ClientOptWithZone(ZoneNameATVie2))
+	if client.serverEndpoint != string(ATVie2) {
+		t.Errorf("unexpected endpoint %q", client.serverEndpoint)
+	}
+
+	if err := ClientOptWithZone("xx-new-1")(client); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound for an unknown zone, got %v", err)
+	}
+}